@@ -0,0 +1,92 @@
+// Package civil implements types for dates without a time of day or time zone, and for durations, as used by ESI for
+// properties of format date and duration.
+package civil
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date, e.g. 2025-08-26. It encodes as "YYYY-MM-DD" in JSON, query parameters and headers.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ParseDate parses a date in the "YYYY-MM-DD" format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("civil: parse date %q: %w", s, err)
+	}
+	return DateOf(t), nil
+}
+
+// DateOf returns the date of t in its location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// String returns the date in the "YYYY-MM-DD" format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether d is an existing date, e.g. not February 30th.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In returns the start of the date in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d, or before it when n is negative.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Compare returns -1 if d is before other, +1 if it is after, and 0 if they are the same date.
+func (d Date) Compare(other Date) int {
+	if c := cmp.Compare(d.Year, other.Year); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(d.Month, other.Month); c != 0 {
+		return c
+	}
+	return cmp.Compare(d.Day, other.Day)
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether d is after other.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// MarshalText implements encoding.TextMarshaler, and thereby JSON encoding.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, and thereby JSON decoding.
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
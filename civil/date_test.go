@@ -0,0 +1,130 @@
+package civil_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/civil"
+)
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		input   string
+		want    civil.Date
+		wantErr bool
+	}{
+		{name: "date", input: "2025-08-26", want: civil.Date{Year: 2025, Month: time.August, Day: 26}},
+		{name: "leap day", input: "2024-02-29", want: civil.Date{Year: 2024, Month: time.February, Day: 29}},
+		{name: "error: date-time", input: "2025-08-26T11:00:00Z", wantErr: true},
+		{name: "error: invalid day", input: "2025-02-30", wantErr: true},
+		{name: "error: empty", input: "", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := civil.ParseDate(testCase.input)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("ParseDate(%q) error = %v, wantErr %v", testCase.input, err, testCase.wantErr)
+			}
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("ParseDate(%q) mismatch (-want +got):\n%s", testCase.input, diff)
+			}
+			if !testCase.wantErr && got.String() != testCase.input {
+				t.Errorf("String() = %q, want %q", got.String(), testCase.input)
+			}
+		})
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	t.Parallel()
+
+	type history struct {
+		Date    civil.Date  `json:"date"`
+		Expires *civil.Date `json:"expires"`
+	}
+
+	input := `{"date":"2025-08-26","expires":null}`
+	var v history
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(history{Date: civil.Date{Year: 2025, Month: time.August, Day: 26}}, v); diff != "" {
+		t.Errorf("unmarshal mismatch (-want +got):\n%s", diff)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != input {
+		t.Errorf("round-trip: got %s want %s", out, input)
+	}
+
+	if err := json.Unmarshal([]byte(`{"date":"yesterday"}`), &v); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
+
+func TestDate_time(t *testing.T) {
+	t.Parallel()
+
+	d := civil.Date{Year: 2024, Month: time.December, Day: 31}
+
+	if got := d.In(time.UTC); !got.Equal(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("In(UTC) = %v", got)
+	}
+	// The date of a time depends on its location.
+	utc := time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC)
+	if got := civil.DateOf(utc.In(time.FixedZone("UTC-2", -2*60*60))); got != d {
+		t.Errorf("DateOf = %v, want %v", got, d)
+	}
+
+	if got := d.AddDays(1); got != (civil.Date{Year: 2025, Month: time.January, Day: 1}) {
+		t.Errorf("AddDays(1) = %v", got)
+	}
+	if got := d.AddDays(-366); got != (civil.Date{Year: 2023, Month: time.December, Day: 31}) {
+		t.Errorf("AddDays(-366) = %v", got)
+	}
+
+	if !d.IsValid() || (civil.Date{Year: 2025, Month: time.February, Day: 29}).IsValid() || !(civil.Date{}).IsZero() {
+		t.Error("unexpected validity")
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		a, b civil.Date
+		want int
+	}{
+		{name: "equal", a: civil.Date{Year: 2025, Month: 8, Day: 26}, b: civil.Date{Year: 2025, Month: 8, Day: 26}, want: 0},
+		{name: "day", a: civil.Date{Year: 2025, Month: 8, Day: 25}, b: civil.Date{Year: 2025, Month: 8, Day: 26}, want: -1},
+		{name: "month", a: civil.Date{Year: 2025, Month: 9, Day: 1}, b: civil.Date{Year: 2025, Month: 8, Day: 31}, want: 1},
+		{name: "year", a: civil.Date{Year: 2024, Month: 12, Day: 31}, b: civil.Date{Year: 2025, Month: 1, Day: 1}, want: -1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.a.Compare(testCase.b); got != testCase.want {
+				t.Errorf("Compare = %d, want %d", got, testCase.want)
+			}
+			if got := testCase.a.Before(testCase.b); got != (testCase.want < 0) {
+				t.Errorf("Before = %v", got)
+			}
+			if got := testCase.a.After(testCase.b); got != (testCase.want > 0) {
+				t.Errorf("After = %v", got)
+			}
+		})
+	}
+}
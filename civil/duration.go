@@ -0,0 +1,142 @@
+package civil
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is an elapsed time, as used by ESI for properties of format duration.
+// It encodes as an ISO 8601 duration, e.g. "P1DT2H30M", in JSON, query parameters and headers.
+//
+// Years and months have no fixed length, so durations using them cannot be parsed.
+type Duration time.Duration
+
+// ParseDuration parses an ISO 8601 duration made of weeks, days, hours, minutes and seconds, e.g. "PT1H30M".
+func ParseDuration(s string) (Duration, error) {
+	d, err := parseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("civil: parse duration %q: %w", s, err)
+	}
+	return d, nil
+}
+
+func parseDuration(s string) (Duration, error) {
+	rest, negative := strings.CutPrefix(s, "-")
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok {
+		return 0, fmt.Errorf("missing P designator")
+	}
+	if rest == "" || rest == "T" {
+		return 0, fmt.Errorf("missing components")
+	}
+
+	var total float64
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("duplicate T designator")
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if end <= 0 {
+			return 0, fmt.Errorf("missing number before %q", rest)
+		}
+		value, err := strconv.ParseFloat(strings.ReplaceAll(rest[:end], ",", "."), 64)
+		if err != nil {
+			return 0, err
+		}
+
+		var unit time.Duration
+		switch designator := rest[end]; {
+		case !inTime && designator == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && designator == 'D':
+			unit = 24 * time.Hour
+		case inTime && designator == 'H':
+			unit = time.Hour
+		case inTime && designator == 'M':
+			unit = time.Minute
+		case inTime && designator == 'S':
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("unsupported designator %q", designator)
+		}
+		total += value * float64(unit)
+		rest = rest[end+1:]
+	}
+
+	if total >= math.MaxInt64 {
+		return 0, fmt.Errorf("duration out of range")
+	}
+	if negative {
+		total = -total
+	}
+	return Duration(math.Round(total)), nil
+}
+
+// String returns the duration in the ISO 8601 format, using days, hours, minutes and seconds, e.g. "P1DT2H".
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	// The magnitude of the minimum duration does not fit in a time.Duration.
+	magnitude := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		magnitude = uint64(-(d + 1)) + 1
+	}
+	b.WriteByte('P')
+
+	const day = uint64(24 * time.Hour)
+	if days := magnitude / day; days > 0 {
+		b.WriteString(strconv.FormatUint(days, 10) + "D")
+	}
+	remaining := time.Duration(magnitude % day)
+	if remaining == 0 {
+		return b.String()
+	}
+
+	b.WriteByte('T')
+	if hours := remaining / time.Hour; hours > 0 {
+		b.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+	if minutes := remaining % time.Hour / time.Minute; minutes > 0 {
+		b.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+	if seconds := remaining % time.Minute; seconds > 0 {
+		b.WriteString(strconv.FormatFloat(seconds.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
+
+// Duration returns d as a time.Duration.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// MarshalText implements encoding.TextMarshaler, and thereby JSON encoding.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, and thereby JSON decoding.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
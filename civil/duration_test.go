@@ -0,0 +1,103 @@
+package civil_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/civil"
+)
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		format  string
+		wantErr bool
+	}{
+		{name: "hours and minutes", input: "PT1H30M", want: 90 * time.Minute},
+		{name: "days", input: "P1DT2H", want: 26 * time.Hour},
+		{name: "weeks", input: "P2W", want: 14 * 24 * time.Hour, format: "P14D"},
+		{name: "fractional seconds", input: "PT1.5S", want: 1500 * time.Millisecond},
+		{name: "comma fraction", input: "PT0,25S", want: 250 * time.Millisecond, format: "PT0.25S"},
+		{name: "overflowing minutes", input: "PT90M", want: 90 * time.Minute, format: "PT1H30M"},
+		{name: "zero", input: "PT0S", want: 0},
+		{name: "negative", input: "-PT10S", want: -10 * time.Second},
+		{name: "error: months", input: "P1M", wantErr: true},
+		{name: "error: years", input: "P1Y", wantErr: true},
+		{name: "error: go duration", input: "1h30m", wantErr: true},
+		{name: "error: no components", input: "PT", wantErr: true},
+		{name: "error: missing number", input: "PTH", wantErr: true},
+		{name: "error: out of range", input: "P1000000D", wantErr: true},
+		{name: "error: empty", input: "", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := civil.ParseDuration(testCase.input)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("ParseDuration(%q) error = %v, wantErr %v", testCase.input, err, testCase.wantErr)
+			}
+			if testCase.wantErr {
+				return
+			}
+			if got.Duration() != testCase.want {
+				t.Errorf("ParseDuration(%q) = %s, want %s", testCase.input, got.Duration(), testCase.want)
+			}
+			format := testCase.format
+			if format == "" {
+				format = testCase.input
+			}
+			if got.String() != format {
+				t.Errorf("String() = %q, want %q", got.String(), format)
+			}
+		})
+	}
+}
+
+func TestDuration_String(t *testing.T) {
+	t.Parallel()
+
+	// The minimum duration has no positive counterpart.
+	if got, want := civil.Duration(math.MinInt64).String(), "-P106751DT23H47M16.854775808S"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got, want := civil.Duration(time.Nanosecond).String(), "PT0.000000001S"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	t.Parallel()
+
+	type timer struct {
+		Duration civil.Duration  `json:"duration"`
+		Timeout  *civil.Duration `json:"timeout"`
+	}
+
+	input := `{"duration":"PT45M","timeout":null}`
+	var v timer
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Duration.Duration() != 45*time.Minute || v.Timeout != nil {
+		t.Errorf("unexpected unmarshal result %+v", v)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != input {
+		t.Errorf("round-trip: got %s want %s", out, input)
+	}
+
+	if err := json.Unmarshal([]byte(`{"duration":2700000000000}`), &v); err == nil {
+		t.Error("expected an error for a duration in nanoseconds")
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/cmdutil"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/specdiff"
+)
+
+// inputFlags collects the repeated -input flag.
+type inputFlags []string
+
+func (f *inputFlags) String() string { return strings.Join(*f, ",") }
+
+func (f *inputFlags) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	var inputs inputFlags
+	flagURL := flag.String("url", openapi.DefaultSpecURL, "OpenAPI spec URL")
+	flag.Var(&inputs, "input", "local OpenAPI JSON file (instead of -url); pass twice, old spec first")
+	flagFormat := flag.String("format", "text", "report format: text or json")
+	flagFailOnBreaking := flag.Bool("fail-on-breaking", false, "exit 1 if any change is breaking")
+	flag.Parse()
+
+	usage := "usage: esi-spec-diff [-format text|json] <old-compatibility-date|LIBRARY> <new-compatibility-date|LIBRARY>\n" +
+		"       esi-spec-diff [-format text|json] -input old.json -input new.json"
+	if *flagFormat != "text" && *flagFormat != "json" {
+		fmt.Fprintf(os.Stderr, "invalid format %q\n%s\n", *flagFormat, usage)
+		return 1
+	}
+
+	// Each spec is either a local file or a compatibility date fetched from -url.
+	type source struct{ date, input string }
+	var sources []source
+	switch {
+	case len(inputs) == 2 && flag.NArg() == 0:
+		sources = []source{{input: inputs[0]}, {input: inputs[1]}}
+	case len(inputs) == 0 && flag.NArg() == 2:
+		for _, arg := range flag.Args() {
+			date, _ := cmdutil.CompatDateFromArgs([]string{arg}, false)
+			if err := cmdutil.ValidateAndLoadCompatDate(date); err != nil {
+				fmt.Fprintf(os.Stderr, "invalid compatibility date %q: %v\n", date, err)
+				return 1
+			}
+			sources = append(sources, source{date: date})
+		}
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cmdutil.RequestSpecTimeout)
+	defer cancel()
+
+	specs := make([]*openapi.Spec, len(sources))
+	for n, src := range sources {
+		spec, err := openapi.LoadSpec(ctx, src.date, *flagURL, src.input)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "load spec: %v\n", err)
+			return 1
+		}
+		specs[n] = spec
+	}
+
+	report, err := specdiff.Diff(specs[0], specs[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "diff: %v\n", err)
+		return 1
+	}
+
+	if *flagFormat == "json" {
+		err = report.WriteJSON(os.Stdout)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "write: %v\n", err)
+		return 1
+	}
+
+	if *flagFailOnBreaking && report.Breaking() > 0 {
+		return 1
+	}
+	return 0
+}
@@ -19,6 +19,11 @@ func run() int {
 	specFlags := cmdutil.RegisterSpecFlags(flag.CommandLine, openapi.DefaultSpecURL, ".")
 	flagLib := flag.String("lib", "github.com/xaroth/lib-esi-go", "module path for request and common model imports")
 	flagCommon := flag.String("common", "common", "path suffix after -lib for common model imports")
+	flagAliases := flag.Bool("struct-aliases", true, "keep the names of deduplicated nested structs as type aliases")
+	flagShared := flag.Bool("shared-structs", false, "promote nested structs recurring across packages into the shared package under -common")
+	flagFakeServer := flag.Bool("esitest", false, "also generate the esitest package, a fake server of every operation for integration tests")
+	flagClient := flag.Bool("client", false, "also generate the client package, which groups the operations by domain behind mockable interfaces")
+	flagPrune := flag.Bool("prune", false, "delete generated operation packages that are no longer selected")
 	flag.Parse()
 
 	compatDate, selectors := cmdutil.CompatDateFromArgs(flag.Args(), true)
@@ -27,7 +32,7 @@ func run() int {
 		return 1
 	}
 	if len(selectors) == 0 {
-		fmt.Fprintln(os.Stderr, "usage: generate-request [compatibility-date|LIBRARY] <operation|ALL_PATHS>...")
+		fmt.Fprintln(os.Stderr, "usage: generate-request [compatibility-date|LIBRARY] <operation|route|glob|tag:name|re:regexp|ALL_PATHS>... [!selector]...")
 		return 1
 	}
 
@@ -53,8 +58,12 @@ func run() int {
 	}
 
 	cfg := requestgen.Config{
-		LibModule:    *flagLib,
-		CommonSuffix: *flagCommon,
+		LibModule:     *flagLib,
+		CommonSuffix:  *flagCommon,
+		StructAliases: *flagAliases,
+		SharedStructs: *flagShared,
+		FakeServer:    *flagFakeServer,
+		Client:        *flagClient,
 	}
 
 	written, err := requestgen.BuildAndWrite(spec, ops, outDir, cfg, *specFlags.Check)
@@ -63,10 +72,21 @@ func run() int {
 		return 1
 	}
 
+	var pruned []string
+	if *flagPrune {
+		if pruned, err = requestgen.Prune(outDir, ops, *specFlags.Check); err != nil {
+			fmt.Fprintf(os.Stderr, "prune: %v\n", err)
+			return 1
+		}
+	}
+
 	if *specFlags.Check {
 		fmt.Printf("check ok: %d operations in %s\n", len(ops), outDir)
 	} else {
 		fmt.Printf("wrote %d files for %d operations to %s (compatibility-date: %s)\n", written, len(ops), outDir, compatDate)
+		for _, name := range pruned {
+			fmt.Printf("pruned %s\n", name)
+		}
 	}
 	return 0
 }
@@ -2,8 +2,50 @@
 
 package compatibilitydate
 
-type CompatibilityDate string
+import (
+	"time"
+
+	"github.com/xaroth/lib-esi-go/civil"
+)
+
+type CompatibilityDate civil.Date
+
+// Parse parses a CompatibilityDate in the "YYYY-MM-DD" format.
+func Parse(s string) (CompatibilityDate, error) {
+	d, err := civil.ParseDate(s)
+	return CompatibilityDate(d), err
+}
+
+// Date returns the value as a civil.Date.
+func (v CompatibilityDate) Date() civil.Date {
+	return civil.Date(v)
+}
+
+// Time returns the start of the date in UTC.
+func (v CompatibilityDate) Time() time.Time {
+	return civil.Date(v).In(time.UTC)
+}
 
 func (v CompatibilityDate) String() string {
-	return string(v)
+	return civil.Date(v).String()
+}
+
+func (v CompatibilityDate) Compare(other CompatibilityDate) int {
+	return civil.Date(v).Compare(civil.Date(other))
+}
+
+func (v CompatibilityDate) Before(other CompatibilityDate) bool {
+	return v.Compare(other) < 0
+}
+
+func (v CompatibilityDate) After(other CompatibilityDate) bool {
+	return v.Compare(other) > 0
+}
+
+func (v CompatibilityDate) MarshalText() ([]byte, error) {
+	return civil.Date(v).MarshalText()
+}
+
+func (v *CompatibilityDate) UnmarshalText(data []byte) error {
+	return (*civil.Date)(v).UnmarshalText(data)
 }
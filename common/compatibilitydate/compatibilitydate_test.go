@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/xaroth/lib-esi-go/civil"
 	"github.com/xaroth/lib-esi-go/common/compatibilitydate"
 )
 
@@ -13,8 +14,8 @@ func TestCompatibilityDate_JSONRoundTrip_0(t *testing.T) {
 	if err := json.Unmarshal([]byte(inputJSON), &v); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if v != compatibilitydate.CompatibilityDate("2025-08-26") {
-		t.Fatalf("got %v want %v", v, compatibilitydate.CompatibilityDate("2025-08-26"))
+	if v != compatibilitydate.CompatibilityDate(civil.Date{Year: 2025, Month: 8, Day: 26}) {
+		t.Fatalf("got %v want %v", v, compatibilitydate.CompatibilityDate(civil.Date{Year: 2025, Month: 8, Day: 26}))
 	}
 	out, err := json.Marshal(v)
 	if err != nil {
@@ -0,0 +1,5209 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/xaroth/lib-esi-go/esi/client (interfaces: Alliances,Characters,Contracts,Corporations,Dogma,Fleets,FreelanceJobs,Fw,Incursions,Industry,Insurance,Killmails,Loyalty,Markets,Meta,Route,Skyhooks,Sovereignty,Status,Ui,Universe,Wars)
+//
+// Generated by this command:
+//
+//	mockgen -build_flags=--mod=mod -destination=mock/mock_client.go -package=mock github.com/xaroth/lib-esi-go/esi/client Alliances,Characters,Contracts,Corporations,Dogma,Fleets,FreelanceJobs,Fw,Incursions,Industry,Insurance,Killmails,Loyalty,Markets,Meta,Route,Skyhooks,Sovereignty,Status,Ui,Universe,Wars
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	accesslist "github.com/xaroth/lib-esi-go/common/accesslist"
+	alliance "github.com/xaroth/lib-esi-go/common/alliance"
+	character "github.com/xaroth/lib-esi-go/common/character"
+	corporation "github.com/xaroth/lib-esi-go/common/corporation"
+	item "github.com/xaroth/lib-esi-go/common/item"
+	solarsystem "github.com/xaroth/lib-esi-go/common/solarsystem"
+	uuid "github.com/xaroth/lib-esi-go/common/uuid"
+	deletecharacterscharacteridcontacts "github.com/xaroth/lib-esi-go/esi/deletecharacterscharacteridcontacts"
+	getalliances "github.com/xaroth/lib-esi-go/esi/getalliances"
+	getalliancesallianceid "github.com/xaroth/lib-esi-go/esi/getalliancesallianceid"
+	getalliancesallianceidcontacts "github.com/xaroth/lib-esi-go/esi/getalliancesallianceidcontacts"
+	getalliancesallianceidcontactslabels "github.com/xaroth/lib-esi-go/esi/getalliancesallianceidcontactslabels"
+	getalliancesallianceidcorporations "github.com/xaroth/lib-esi-go/esi/getalliancesallianceidcorporations"
+	getalliancesallianceidicons "github.com/xaroth/lib-esi-go/esi/getalliancesallianceidicons"
+	getcharactersaccesslistsdetail "github.com/xaroth/lib-esi-go/esi/getcharactersaccesslistsdetail"
+	getcharactersaccesslistslisting "github.com/xaroth/lib-esi-go/esi/getcharactersaccesslistslisting"
+	getcharacterscharacterid "github.com/xaroth/lib-esi-go/esi/getcharacterscharacterid"
+	getcharacterscharacteridagentsresearch "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridagentsresearch"
+	getcharacterscharacteridassets "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridassets"
+	getcharacterscharacteridattributes "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridattributes"
+	getcharacterscharacteridblueprints "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridblueprints"
+	getcharacterscharacteridcalendar "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcalendar"
+	getcharacterscharacteridcalendareventid "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcalendareventid"
+	getcharacterscharacteridcalendareventidattendees "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcalendareventidattendees"
+	getcharacterscharacteridclones "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridclones"
+	getcharacterscharacteridcontacts "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontacts"
+	getcharacterscharacteridcontactslabels "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontactslabels"
+	getcharacterscharacteridcontracts "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontracts"
+	getcharacterscharacteridcontractscontractidbids "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontractscontractidbids"
+	getcharacterscharacteridcontractscontractiditems "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontractscontractiditems"
+	getcharacterscharacteridcorporationhistory "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcorporationhistory"
+	getcharacterscharacteridfatigue "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfatigue"
+	getcharacterscharacteridfittings "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfittings"
+	getcharacterscharacteridfleet "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfleet"
+	getcharacterscharacteridfwstats "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfwstats"
+	getcharacterscharacteridimplants "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridimplants"
+	getcharacterscharacteridindustryjobs "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridindustryjobs"
+	getcharacterscharacteridkillmailsrecent "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridkillmailsrecent"
+	getcharacterscharacteridlocation "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridlocation"
+	getcharacterscharacteridloyaltypoints "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridloyaltypoints"
+	getcharacterscharacteridmail "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmail"
+	getcharacterscharacteridmaillabels "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmaillabels"
+	getcharacterscharacteridmaillists "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmaillists"
+	getcharacterscharacteridmailmailid "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmailmailid"
+	getcharacterscharacteridmedals "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmedals"
+	getcharacterscharacteridmining "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmining"
+	getcharacterscharacteridnotifications "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridnotifications"
+	getcharacterscharacteridnotificationscontacts "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridnotificationscontacts"
+	getcharacterscharacteridonline "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridonline"
+	getcharacterscharacteridorders "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridorders"
+	getcharacterscharacteridordershistory "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridordershistory"
+	getcharacterscharacteridplanets "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridplanets"
+	getcharacterscharacteridplanetsplanetid "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridplanetsplanetid"
+	getcharacterscharacteridportrait "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridportrait"
+	getcharacterscharacteridroles "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridroles"
+	getcharacterscharacteridsearch "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridsearch"
+	getcharacterscharacteridship "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridship"
+	getcharacterscharacteridskillqueue "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridskillqueue"
+	getcharacterscharacteridskills "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridskills"
+	getcharacterscharacteridstandings "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridstandings"
+	getcharacterscharacteridtitles "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridtitles"
+	getcharacterscharacteridwallet "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwallet"
+	getcharacterscharacteridwalletjournal "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwalletjournal"
+	getcharacterscharacteridwallettransactions "github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwallettransactions"
+	getcharactersdetail "github.com/xaroth/lib-esi-go/esi/getcharactersdetail"
+	getcharactersfreelancejobslisting "github.com/xaroth/lib-esi-go/esi/getcharactersfreelancejobslisting"
+	getcharactersfreelancejobsparticipation "github.com/xaroth/lib-esi-go/esi/getcharactersfreelancejobsparticipation"
+	getcharactersmercenarytacticaloperationsdetail "github.com/xaroth/lib-esi-go/esi/getcharactersmercenarytacticaloperationsdetail"
+	getcharactersmercenarytacticaloperationslisting "github.com/xaroth/lib-esi-go/esi/getcharactersmercenarytacticaloperationslisting"
+	getcharactersstructuresmercenarydensdetail "github.com/xaroth/lib-esi-go/esi/getcharactersstructuresmercenarydensdetail"
+	getcharactersstructuresmercenarydenslisting "github.com/xaroth/lib-esi-go/esi/getcharactersstructuresmercenarydenslisting"
+	getcontractspublicbidscontractid "github.com/xaroth/lib-esi-go/esi/getcontractspublicbidscontractid"
+	getcontractspublicitemscontractid "github.com/xaroth/lib-esi-go/esi/getcontractspublicitemscontractid"
+	getcontractspublicregionid "github.com/xaroth/lib-esi-go/esi/getcontractspublicregionid"
+	getcorporationcorporationidminingextractions "github.com/xaroth/lib-esi-go/esi/getcorporationcorporationidminingextractions"
+	getcorporationcorporationidminingobservers "github.com/xaroth/lib-esi-go/esi/getcorporationcorporationidminingobservers"
+	getcorporationcorporationidminingobserversobserverid "github.com/xaroth/lib-esi-go/esi/getcorporationcorporationidminingobserversobserverid"
+	getcorporationscorporationid "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationid"
+	getcorporationscorporationidalliancehistory "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidalliancehistory"
+	getcorporationscorporationidassets "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidassets"
+	getcorporationscorporationidblueprints "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidblueprints"
+	getcorporationscorporationidcontacts "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontacts"
+	getcorporationscorporationidcontactslabels "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontactslabels"
+	getcorporationscorporationidcontainerslogs "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontainerslogs"
+	getcorporationscorporationidcontracts "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontracts"
+	getcorporationscorporationidcontractscontractidbids "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontractscontractidbids"
+	getcorporationscorporationidcontractscontractiditems "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontractscontractiditems"
+	getcorporationscorporationidcustomsoffices "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcustomsoffices"
+	getcorporationscorporationiddivisions "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationiddivisions"
+	getcorporationscorporationidfacilities "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidfacilities"
+	getcorporationscorporationidfwstats "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidfwstats"
+	getcorporationscorporationidicons "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidicons"
+	getcorporationscorporationidindustryjobs "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidindustryjobs"
+	getcorporationscorporationidkillmailsrecent "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidkillmailsrecent"
+	getcorporationscorporationidmedals "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmedals"
+	getcorporationscorporationidmedalsissued "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmedalsissued"
+	getcorporationscorporationidmembers "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmembers"
+	getcorporationscorporationidmemberslimit "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmemberslimit"
+	getcorporationscorporationidmemberstitles "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmemberstitles"
+	getcorporationscorporationidmembertracking "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmembertracking"
+	getcorporationscorporationidorders "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidorders"
+	getcorporationscorporationidordershistory "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidordershistory"
+	getcorporationscorporationidroles "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidroles"
+	getcorporationscorporationidroleshistory "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidroleshistory"
+	getcorporationscorporationidshareholders "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidshareholders"
+	getcorporationscorporationidstandings "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstandings"
+	getcorporationscorporationidstarbases "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstarbases"
+	getcorporationscorporationidstarbasesstarbaseid "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstarbasesstarbaseid"
+	getcorporationscorporationidstructures "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstructures"
+	getcorporationscorporationidtitles "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidtitles"
+	getcorporationscorporationidwallets "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidwallets"
+	getcorporationscorporationidwalletsdivisionjournal "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidwalletsdivisionjournal"
+	getcorporationscorporationidwalletsdivisiontransactions "github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidwalletsdivisiontransactions"
+	getcorporationsfreelancejobslisting "github.com/xaroth/lib-esi-go/esi/getcorporationsfreelancejobslisting"
+	getcorporationsfreelancejobsparticipants "github.com/xaroth/lib-esi-go/esi/getcorporationsfreelancejobsparticipants"
+	getcorporationsnpccorps "github.com/xaroth/lib-esi-go/esi/getcorporationsnpccorps"
+	getcorporationsprojectscontribution "github.com/xaroth/lib-esi-go/esi/getcorporationsprojectscontribution"
+	getcorporationsprojectscontributors "github.com/xaroth/lib-esi-go/esi/getcorporationsprojectscontributors"
+	getcorporationsprojectsdetail "github.com/xaroth/lib-esi-go/esi/getcorporationsprojectsdetail"
+	getcorporationsprojectslisting "github.com/xaroth/lib-esi-go/esi/getcorporationsprojectslisting"
+	getcorporationsstructuresskyhooksdetail "github.com/xaroth/lib-esi-go/esi/getcorporationsstructuresskyhooksdetail"
+	getcorporationsstructuresskyhookslisting "github.com/xaroth/lib-esi-go/esi/getcorporationsstructuresskyhookslisting"
+	getcorporationsstructuressovereigntyhubsdetail "github.com/xaroth/lib-esi-go/esi/getcorporationsstructuressovereigntyhubsdetail"
+	getcorporationsstructuressovereigntyhubslisting "github.com/xaroth/lib-esi-go/esi/getcorporationsstructuressovereigntyhubslisting"
+	getdogmaattributes "github.com/xaroth/lib-esi-go/esi/getdogmaattributes"
+	getdogmaattributesattributeid "github.com/xaroth/lib-esi-go/esi/getdogmaattributesattributeid"
+	getdogmadynamicitemstypeiditemid "github.com/xaroth/lib-esi-go/esi/getdogmadynamicitemstypeiditemid"
+	getdogmaeffects "github.com/xaroth/lib-esi-go/esi/getdogmaeffects"
+	getdogmaeffectseffectid "github.com/xaroth/lib-esi-go/esi/getdogmaeffectseffectid"
+	getfleetsfleetid "github.com/xaroth/lib-esi-go/esi/getfleetsfleetid"
+	getfleetsfleetidmembers "github.com/xaroth/lib-esi-go/esi/getfleetsfleetidmembers"
+	getfleetsfleetidwings "github.com/xaroth/lib-esi-go/esi/getfleetsfleetidwings"
+	getfreelancejobsdetail "github.com/xaroth/lib-esi-go/esi/getfreelancejobsdetail"
+	getfreelancejobslisting "github.com/xaroth/lib-esi-go/esi/getfreelancejobslisting"
+	getfwleaderboards "github.com/xaroth/lib-esi-go/esi/getfwleaderboards"
+	getfwleaderboardscharacters "github.com/xaroth/lib-esi-go/esi/getfwleaderboardscharacters"
+	getfwleaderboardscorporations "github.com/xaroth/lib-esi-go/esi/getfwleaderboardscorporations"
+	getfwstats "github.com/xaroth/lib-esi-go/esi/getfwstats"
+	getfwsystems "github.com/xaroth/lib-esi-go/esi/getfwsystems"
+	getfwwars "github.com/xaroth/lib-esi-go/esi/getfwwars"
+	getincursions "github.com/xaroth/lib-esi-go/esi/getincursions"
+	getindustryfacilities "github.com/xaroth/lib-esi-go/esi/getindustryfacilities"
+	getindustrysystems "github.com/xaroth/lib-esi-go/esi/getindustrysystems"
+	getinsuranceprices "github.com/xaroth/lib-esi-go/esi/getinsuranceprices"
+	getkillmailskillmailidkillmailhash "github.com/xaroth/lib-esi-go/esi/getkillmailskillmailidkillmailhash"
+	getloyaltystorescorporationidoffers "github.com/xaroth/lib-esi-go/esi/getloyaltystorescorporationidoffers"
+	getmarketsgroups "github.com/xaroth/lib-esi-go/esi/getmarketsgroups"
+	getmarketsgroupsmarketgroupid "github.com/xaroth/lib-esi-go/esi/getmarketsgroupsmarketgroupid"
+	getmarketsprices "github.com/xaroth/lib-esi-go/esi/getmarketsprices"
+	getmarketsregionidhistory "github.com/xaroth/lib-esi-go/esi/getmarketsregionidhistory"
+	getmarketsregionidorders "github.com/xaroth/lib-esi-go/esi/getmarketsregionidorders"
+	getmarketsregionidtypes "github.com/xaroth/lib-esi-go/esi/getmarketsregionidtypes"
+	getmarketsstructuresstructureid "github.com/xaroth/lib-esi-go/esi/getmarketsstructuresstructureid"
+	getmetachangelog "github.com/xaroth/lib-esi-go/esi/getmetachangelog"
+	getmetacompatibilitydates "github.com/xaroth/lib-esi-go/esi/getmetacompatibilitydates"
+	getmetaname "github.com/xaroth/lib-esi-go/esi/getmetaname"
+	getmetastatus "github.com/xaroth/lib-esi-go/esi/getmetastatus"
+	getskyhooksraidable "github.com/xaroth/lib-esi-go/esi/getskyhooksraidable"
+	getsovereigntycampaigns "github.com/xaroth/lib-esi-go/esi/getsovereigntycampaigns"
+	getsovereigntymap "github.com/xaroth/lib-esi-go/esi/getsovereigntymap"
+	getsovereigntystructures "github.com/xaroth/lib-esi-go/esi/getsovereigntystructures"
+	getsovereigntysystems "github.com/xaroth/lib-esi-go/esi/getsovereigntysystems"
+	getstatus "github.com/xaroth/lib-esi-go/esi/getstatus"
+	getuniverseancestries "github.com/xaroth/lib-esi-go/esi/getuniverseancestries"
+	getuniverseasteroidbeltsasteroidbeltid "github.com/xaroth/lib-esi-go/esi/getuniverseasteroidbeltsasteroidbeltid"
+	getuniversebloodlines "github.com/xaroth/lib-esi-go/esi/getuniversebloodlines"
+	getuniversecategories "github.com/xaroth/lib-esi-go/esi/getuniversecategories"
+	getuniversecategoriescategoryid "github.com/xaroth/lib-esi-go/esi/getuniversecategoriescategoryid"
+	getuniverseconstellations "github.com/xaroth/lib-esi-go/esi/getuniverseconstellations"
+	getuniverseconstellationsconstellationid "github.com/xaroth/lib-esi-go/esi/getuniverseconstellationsconstellationid"
+	getuniversefactions "github.com/xaroth/lib-esi-go/esi/getuniversefactions"
+	getuniversegraphics "github.com/xaroth/lib-esi-go/esi/getuniversegraphics"
+	getuniversegraphicsgraphicid "github.com/xaroth/lib-esi-go/esi/getuniversegraphicsgraphicid"
+	getuniversegroups "github.com/xaroth/lib-esi-go/esi/getuniversegroups"
+	getuniversegroupsgroupid "github.com/xaroth/lib-esi-go/esi/getuniversegroupsgroupid"
+	getuniversemoonsmoonid "github.com/xaroth/lib-esi-go/esi/getuniversemoonsmoonid"
+	getuniverseplanetsplanetid "github.com/xaroth/lib-esi-go/esi/getuniverseplanetsplanetid"
+	getuniverseraces "github.com/xaroth/lib-esi-go/esi/getuniverseraces"
+	getuniverseregions "github.com/xaroth/lib-esi-go/esi/getuniverseregions"
+	getuniverseregionsregionid "github.com/xaroth/lib-esi-go/esi/getuniverseregionsregionid"
+	getuniverseschematicsschematicid "github.com/xaroth/lib-esi-go/esi/getuniverseschematicsschematicid"
+	getuniversestargatesstargateid "github.com/xaroth/lib-esi-go/esi/getuniversestargatesstargateid"
+	getuniversestarsstarid "github.com/xaroth/lib-esi-go/esi/getuniversestarsstarid"
+	getuniversestationsstationid "github.com/xaroth/lib-esi-go/esi/getuniversestationsstationid"
+	getuniversestructures "github.com/xaroth/lib-esi-go/esi/getuniversestructures"
+	getuniversestructuresstructureid "github.com/xaroth/lib-esi-go/esi/getuniversestructuresstructureid"
+	getuniversesystemjumps "github.com/xaroth/lib-esi-go/esi/getuniversesystemjumps"
+	getuniversesystemkills "github.com/xaroth/lib-esi-go/esi/getuniversesystemkills"
+	getuniversesystems "github.com/xaroth/lib-esi-go/esi/getuniversesystems"
+	getuniversesystemssystemid "github.com/xaroth/lib-esi-go/esi/getuniversesystemssystemid"
+	getuniversetypes "github.com/xaroth/lib-esi-go/esi/getuniversetypes"
+	getuniversetypestypeid "github.com/xaroth/lib-esi-go/esi/getuniversetypestypeid"
+	getwars "github.com/xaroth/lib-esi-go/esi/getwars"
+	getwarswarid "github.com/xaroth/lib-esi-go/esi/getwarswarid"
+	getwarswaridkillmails "github.com/xaroth/lib-esi-go/esi/getwarswaridkillmails"
+	postcharactersaffiliation "github.com/xaroth/lib-esi-go/esi/postcharactersaffiliation"
+	postcharacterscharacteridassetslocations "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridassetslocations"
+	postcharacterscharacteridassetsnames "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridassetsnames"
+	postcharacterscharacteridcontacts "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridcontacts"
+	postcharacterscharacteridcspa "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridcspa"
+	postcharacterscharacteridfittings "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridfittings"
+	postcharacterscharacteridmail "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridmail"
+	postcharacterscharacteridmaillabels "github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridmaillabels"
+	postcorporationscorporationidassetslocations "github.com/xaroth/lib-esi-go/esi/postcorporationscorporationidassetslocations"
+	postcorporationscorporationidassetsnames "github.com/xaroth/lib-esi-go/esi/postcorporationscorporationidassetsnames"
+	postfleetsfleetidmembers "github.com/xaroth/lib-esi-go/esi/postfleetsfleetidmembers"
+	postfleetsfleetidwings "github.com/xaroth/lib-esi-go/esi/postfleetsfleetidwings"
+	postfleetsfleetidwingswingidsquads "github.com/xaroth/lib-esi-go/esi/postfleetsfleetidwingswingidsquads"
+	postroute "github.com/xaroth/lib-esi-go/esi/postroute"
+	postuiautopilotwaypoint "github.com/xaroth/lib-esi-go/esi/postuiautopilotwaypoint"
+	postuiopenwindowcontract "github.com/xaroth/lib-esi-go/esi/postuiopenwindowcontract"
+	postuiopenwindowinformation "github.com/xaroth/lib-esi-go/esi/postuiopenwindowinformation"
+	postuiopenwindowmarketdetails "github.com/xaroth/lib-esi-go/esi/postuiopenwindowmarketdetails"
+	postuiopenwindownewmail "github.com/xaroth/lib-esi-go/esi/postuiopenwindownewmail"
+	postuniverseids "github.com/xaroth/lib-esi-go/esi/postuniverseids"
+	postuniversenames "github.com/xaroth/lib-esi-go/esi/postuniversenames"
+	putcharacterscharacteridcalendareventid "github.com/xaroth/lib-esi-go/esi/putcharacterscharacteridcalendareventid"
+	putcharacterscharacteridcontacts "github.com/xaroth/lib-esi-go/esi/putcharacterscharacteridcontacts"
+	putcharacterscharacteridmailmailid "github.com/xaroth/lib-esi-go/esi/putcharacterscharacteridmailmailid"
+	putfleetsfleetid "github.com/xaroth/lib-esi-go/esi/putfleetsfleetid"
+	putfleetsfleetidmembersmemberid "github.com/xaroth/lib-esi-go/esi/putfleetsfleetidmembersmemberid"
+	putfleetsfleetidsquadssquadid "github.com/xaroth/lib-esi-go/esi/putfleetsfleetidsquadssquadid"
+	putfleetsfleetidwingswingid "github.com/xaroth/lib-esi-go/esi/putfleetsfleetidwingswingid"
+	request "github.com/xaroth/lib-esi-go/request"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAlliances is a mock of Alliances interface.
+type MockAlliances struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlliancesMockRecorder
+	isgomock struct{}
+}
+
+// MockAlliancesMockRecorder is the mock recorder for MockAlliances.
+type MockAlliancesMockRecorder struct {
+	mock *MockAlliances
+}
+
+// NewMockAlliances creates a new mock instance.
+func NewMockAlliances(ctrl *gomock.Controller) *MockAlliances {
+	mock := &MockAlliances{ctrl: ctrl}
+	mock.recorder = &MockAlliancesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlliances) EXPECT() *MockAlliancesMockRecorder {
+	return m.recorder
+}
+
+// Alliance mocks base method.
+func (m *MockAlliances) Alliance(ctx context.Context, allianceID alliance.Identifier, opts ...request.RequestOption) (*request.Response[*getalliancesallianceid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, allianceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Alliance", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getalliancesallianceid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Alliance indicates an expected call of Alliance.
+func (mr *MockAlliancesMockRecorder) Alliance(ctx, allianceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, allianceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alliance", reflect.TypeOf((*MockAlliances)(nil).Alliance), varargs...)
+}
+
+// Alliances mocks base method.
+func (m *MockAlliances) Alliances(ctx context.Context, opts ...request.RequestOption) (*request.Response[getalliances.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Alliances", varargs...)
+	ret0, _ := ret[0].(*request.Response[getalliances.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Alliances indicates an expected call of Alliances.
+func (mr *MockAlliancesMockRecorder) Alliances(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alliances", reflect.TypeOf((*MockAlliances)(nil).Alliances), varargs...)
+}
+
+// Contacts mocks base method.
+func (m *MockAlliances) Contacts(ctx context.Context, allianceID alliance.Identifier, input *getalliancesallianceidcontacts.Input, opts ...request.RequestOption) (*request.Response[[]*getalliancesallianceidcontacts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, allianceID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Contacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getalliancesallianceidcontacts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contacts indicates an expected call of Contacts.
+func (mr *MockAlliancesMockRecorder) Contacts(ctx, allianceID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, allianceID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contacts", reflect.TypeOf((*MockAlliances)(nil).Contacts), varargs...)
+}
+
+// ContactsLabels mocks base method.
+func (m *MockAlliances) ContactsLabels(ctx context.Context, allianceID alliance.Identifier, opts ...request.RequestOption) (*request.Response[[]*getalliancesallianceidcontactslabels.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, allianceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContactsLabels", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getalliancesallianceidcontactslabels.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContactsLabels indicates an expected call of ContactsLabels.
+func (mr *MockAlliancesMockRecorder) ContactsLabels(ctx, allianceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, allianceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactsLabels", reflect.TypeOf((*MockAlliances)(nil).ContactsLabels), varargs...)
+}
+
+// Corporations mocks base method.
+func (m *MockAlliances) Corporations(ctx context.Context, allianceID alliance.Identifier, opts ...request.RequestOption) (*request.Response[getalliancesallianceidcorporations.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, allianceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Corporations", varargs...)
+	ret0, _ := ret[0].(*request.Response[getalliancesallianceidcorporations.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Corporations indicates an expected call of Corporations.
+func (mr *MockAlliancesMockRecorder) Corporations(ctx, allianceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, allianceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Corporations", reflect.TypeOf((*MockAlliances)(nil).Corporations), varargs...)
+}
+
+// Icons mocks base method.
+func (m *MockAlliances) Icons(ctx context.Context, allianceID alliance.Identifier, opts ...request.RequestOption) (*request.Response[*getalliancesallianceidicons.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, allianceID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Icons", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getalliancesallianceidicons.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Icons indicates an expected call of Icons.
+func (mr *MockAlliancesMockRecorder) Icons(ctx, allianceID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, allianceID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Icons", reflect.TypeOf((*MockAlliances)(nil).Icons), varargs...)
+}
+
+// MockCharacters is a mock of Characters interface.
+type MockCharacters struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharactersMockRecorder
+	isgomock struct{}
+}
+
+// MockCharactersMockRecorder is the mock recorder for MockCharacters.
+type MockCharactersMockRecorder struct {
+	mock *MockCharacters
+}
+
+// NewMockCharacters creates a new mock instance.
+func NewMockCharacters(ctrl *gomock.Controller) *MockCharacters {
+	mock := &MockCharacters{ctrl: ctrl}
+	mock.recorder = &MockCharactersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharacters) EXPECT() *MockCharactersMockRecorder {
+	return m.recorder
+}
+
+// AccessList mocks base method.
+func (m *MockCharacters) AccessList(ctx context.Context, characterID character.Identifier, accessList accesslist.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersaccesslistsdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, accessList}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AccessList", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersaccesslistsdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccessList indicates an expected call of AccessList.
+func (mr *MockCharactersMockRecorder) AccessList(ctx, characterID, accessList any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, accessList}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessList", reflect.TypeOf((*MockCharacters)(nil).AccessList), varargs...)
+}
+
+// AccessLists mocks base method.
+func (m *MockCharacters) AccessLists(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersaccesslistslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AccessLists", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersaccesslistslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccessLists indicates an expected call of AccessLists.
+func (mr *MockCharactersMockRecorder) AccessLists(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessLists", reflect.TypeOf((*MockCharacters)(nil).AccessLists), varargs...)
+}
+
+// AgentsResearch mocks base method.
+func (m *MockCharacters) AgentsResearch(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridagentsresearch.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AgentsResearch", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridagentsresearch.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgentsResearch indicates an expected call of AgentsResearch.
+func (mr *MockCharactersMockRecorder) AgentsResearch(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentsResearch", reflect.TypeOf((*MockCharacters)(nil).AgentsResearch), varargs...)
+}
+
+// Assets mocks base method.
+func (m *MockCharacters) Assets(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridassets.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridassets.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Assets", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridassets.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assets indicates an expected call of Assets.
+func (mr *MockCharactersMockRecorder) Assets(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assets", reflect.TypeOf((*MockCharacters)(nil).Assets), varargs...)
+}
+
+// Attributes mocks base method.
+func (m *MockCharacters) Attributes(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridattributes.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Attributes", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridattributes.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attributes indicates an expected call of Attributes.
+func (mr *MockCharactersMockRecorder) Attributes(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attributes", reflect.TypeOf((*MockCharacters)(nil).Attributes), varargs...)
+}
+
+// Blueprints mocks base method.
+func (m *MockCharacters) Blueprints(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridblueprints.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridblueprints.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Blueprints", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridblueprints.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blueprints indicates an expected call of Blueprints.
+func (mr *MockCharactersMockRecorder) Blueprints(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blueprints", reflect.TypeOf((*MockCharacters)(nil).Blueprints), varargs...)
+}
+
+// Calendar mocks base method.
+func (m *MockCharacters) Calendar(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridcalendar.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcalendar.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Calendar", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcalendar.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calendar indicates an expected call of Calendar.
+func (mr *MockCharactersMockRecorder) Calendar(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calendar", reflect.TypeOf((*MockCharacters)(nil).Calendar), varargs...)
+}
+
+// CalendarEvent mocks base method.
+func (m *MockCharacters) CalendarEvent(ctx context.Context, characterID character.Identifier, eventId int64, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridcalendareventid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, eventId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CalendarEvent", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridcalendareventid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalendarEvent indicates an expected call of CalendarEvent.
+func (mr *MockCharactersMockRecorder) CalendarEvent(ctx, characterID, eventId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, eventId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalendarEvent", reflect.TypeOf((*MockCharacters)(nil).CalendarEvent), varargs...)
+}
+
+// CalendarEventAttendees mocks base method.
+func (m *MockCharacters) CalendarEventAttendees(ctx context.Context, characterID character.Identifier, eventId int64, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcalendareventidattendees.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, eventId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CalendarEventAttendees", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcalendareventidattendees.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalendarEventAttendees indicates an expected call of CalendarEventAttendees.
+func (mr *MockCharactersMockRecorder) CalendarEventAttendees(ctx, characterID, eventId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, eventId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalendarEventAttendees", reflect.TypeOf((*MockCharacters)(nil).CalendarEventAttendees), varargs...)
+}
+
+// Character mocks base method.
+func (m *MockCharacters) Character(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacterid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Character", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacterid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Character indicates an expected call of Character.
+func (mr *MockCharactersMockRecorder) Character(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Character", reflect.TypeOf((*MockCharacters)(nil).Character), varargs...)
+}
+
+// Clones mocks base method.
+func (m *MockCharacters) Clones(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridclones.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Clones", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridclones.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clones indicates an expected call of Clones.
+func (mr *MockCharactersMockRecorder) Clones(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clones", reflect.TypeOf((*MockCharacters)(nil).Clones), varargs...)
+}
+
+// Contacts mocks base method.
+func (m *MockCharacters) Contacts(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridcontacts.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcontacts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Contacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcontacts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contacts indicates an expected call of Contacts.
+func (mr *MockCharactersMockRecorder) Contacts(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contacts", reflect.TypeOf((*MockCharacters)(nil).Contacts), varargs...)
+}
+
+// ContactsLabels mocks base method.
+func (m *MockCharacters) ContactsLabels(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcontactslabels.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContactsLabels", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcontactslabels.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContactsLabels indicates an expected call of ContactsLabels.
+func (mr *MockCharactersMockRecorder) ContactsLabels(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactsLabels", reflect.TypeOf((*MockCharacters)(nil).ContactsLabels), varargs...)
+}
+
+// ContractBids mocks base method.
+func (m *MockCharacters) ContractBids(ctx context.Context, characterID character.Identifier, contractId int64, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcontractscontractidbids.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, contractId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContractBids", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcontractscontractidbids.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContractBids indicates an expected call of ContractBids.
+func (mr *MockCharactersMockRecorder) ContractBids(ctx, characterID, contractId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, contractId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractBids", reflect.TypeOf((*MockCharacters)(nil).ContractBids), varargs...)
+}
+
+// ContractItems mocks base method.
+func (m *MockCharacters) ContractItems(ctx context.Context, characterID character.Identifier, contractId int64, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcontractscontractiditems.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, contractId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContractItems", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcontractscontractiditems.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContractItems indicates an expected call of ContractItems.
+func (mr *MockCharactersMockRecorder) ContractItems(ctx, characterID, contractId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, contractId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractItems", reflect.TypeOf((*MockCharacters)(nil).ContractItems), varargs...)
+}
+
+// Contracts mocks base method.
+func (m *MockCharacters) Contracts(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridcontracts.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcontracts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Contracts", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcontracts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contracts indicates an expected call of Contracts.
+func (mr *MockCharactersMockRecorder) Contracts(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contracts", reflect.TypeOf((*MockCharacters)(nil).Contracts), varargs...)
+}
+
+// Corporationhistory mocks base method.
+func (m *MockCharacters) Corporationhistory(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridcorporationhistory.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Corporationhistory", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridcorporationhistory.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Corporationhistory indicates an expected call of Corporationhistory.
+func (mr *MockCharactersMockRecorder) Corporationhistory(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Corporationhistory", reflect.TypeOf((*MockCharacters)(nil).Corporationhistory), varargs...)
+}
+
+// DeleteContacts mocks base method.
+func (m *MockCharacters) DeleteContacts(ctx context.Context, characterID character.Identifier, input *deletecharacterscharacteridcontacts.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteContacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteContacts indicates an expected call of DeleteContacts.
+func (mr *MockCharactersMockRecorder) DeleteContacts(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContacts", reflect.TypeOf((*MockCharacters)(nil).DeleteContacts), varargs...)
+}
+
+// DeleteFitting mocks base method.
+func (m *MockCharacters) DeleteFitting(ctx context.Context, characterID character.Identifier, fittingId int64, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, fittingId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFitting", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFitting indicates an expected call of DeleteFitting.
+func (mr *MockCharactersMockRecorder) DeleteFitting(ctx, characterID, fittingId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, fittingId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFitting", reflect.TypeOf((*MockCharacters)(nil).DeleteFitting), varargs...)
+}
+
+// DeleteMail mocks base method.
+func (m *MockCharacters) DeleteMail(ctx context.Context, characterID character.Identifier, mailId int64, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, mailId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMail", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMail indicates an expected call of DeleteMail.
+func (mr *MockCharactersMockRecorder) DeleteMail(ctx, characterID, mailId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, mailId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMail", reflect.TypeOf((*MockCharacters)(nil).DeleteMail), varargs...)
+}
+
+// DeleteMailLabel mocks base method.
+func (m *MockCharacters) DeleteMailLabel(ctx context.Context, characterID character.Identifier, labelId int64, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, labelId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMailLabel", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailLabel indicates an expected call of DeleteMailLabel.
+func (mr *MockCharactersMockRecorder) DeleteMailLabel(ctx, characterID, labelId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, labelId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailLabel", reflect.TypeOf((*MockCharacters)(nil).DeleteMailLabel), varargs...)
+}
+
+// Detail mocks base method.
+func (m *MockCharacters) Detail(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Detail", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detail indicates an expected call of Detail.
+func (mr *MockCharactersMockRecorder) Detail(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockCharacters)(nil).Detail), varargs...)
+}
+
+// Fatigue mocks base method.
+func (m *MockCharacters) Fatigue(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridfatigue.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Fatigue", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridfatigue.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fatigue indicates an expected call of Fatigue.
+func (mr *MockCharactersMockRecorder) Fatigue(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fatigue", reflect.TypeOf((*MockCharacters)(nil).Fatigue), varargs...)
+}
+
+// Fittings mocks base method.
+func (m *MockCharacters) Fittings(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridfittings.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Fittings", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridfittings.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fittings indicates an expected call of Fittings.
+func (mr *MockCharactersMockRecorder) Fittings(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fittings", reflect.TypeOf((*MockCharacters)(nil).Fittings), varargs...)
+}
+
+// Fleet mocks base method.
+func (m *MockCharacters) Fleet(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridfleet.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Fleet", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridfleet.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fleet indicates an expected call of Fleet.
+func (mr *MockCharactersMockRecorder) Fleet(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fleet", reflect.TypeOf((*MockCharacters)(nil).Fleet), varargs...)
+}
+
+// FreelanceJobParticipation mocks base method.
+func (m *MockCharacters) FreelanceJobParticipation(ctx context.Context, characterID character.Identifier, jobId uuid.UUID, opts ...request.RequestOption) (*request.Response[*getcharactersfreelancejobsparticipation.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, jobId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreelanceJobParticipation", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersfreelancejobsparticipation.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreelanceJobParticipation indicates an expected call of FreelanceJobParticipation.
+func (mr *MockCharactersMockRecorder) FreelanceJobParticipation(ctx, characterID, jobId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, jobId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreelanceJobParticipation", reflect.TypeOf((*MockCharacters)(nil).FreelanceJobParticipation), varargs...)
+}
+
+// FreelanceJobs mocks base method.
+func (m *MockCharacters) FreelanceJobs(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersfreelancejobslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreelanceJobs", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersfreelancejobslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreelanceJobs indicates an expected call of FreelanceJobs.
+func (mr *MockCharactersMockRecorder) FreelanceJobs(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreelanceJobs", reflect.TypeOf((*MockCharacters)(nil).FreelanceJobs), varargs...)
+}
+
+// FwStats mocks base method.
+func (m *MockCharacters) FwStats(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridfwstats.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FwStats", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridfwstats.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FwStats indicates an expected call of FwStats.
+func (mr *MockCharactersMockRecorder) FwStats(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FwStats", reflect.TypeOf((*MockCharacters)(nil).FwStats), varargs...)
+}
+
+// Implants mocks base method.
+func (m *MockCharacters) Implants(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[getcharacterscharacteridimplants.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Implants", varargs...)
+	ret0, _ := ret[0].(*request.Response[getcharacterscharacteridimplants.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Implants indicates an expected call of Implants.
+func (mr *MockCharactersMockRecorder) Implants(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Implants", reflect.TypeOf((*MockCharacters)(nil).Implants), varargs...)
+}
+
+// IndustryJobs mocks base method.
+func (m *MockCharacters) IndustryJobs(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridindustryjobs.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridindustryjobs.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IndustryJobs", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridindustryjobs.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndustryJobs indicates an expected call of IndustryJobs.
+func (mr *MockCharactersMockRecorder) IndustryJobs(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndustryJobs", reflect.TypeOf((*MockCharacters)(nil).IndustryJobs), varargs...)
+}
+
+// KillmailsRecent mocks base method.
+func (m *MockCharacters) KillmailsRecent(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridkillmailsrecent.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridkillmailsrecent.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "KillmailsRecent", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridkillmailsrecent.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KillmailsRecent indicates an expected call of KillmailsRecent.
+func (mr *MockCharactersMockRecorder) KillmailsRecent(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillmailsRecent", reflect.TypeOf((*MockCharacters)(nil).KillmailsRecent), varargs...)
+}
+
+// Location mocks base method.
+func (m *MockCharacters) Location(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridlocation.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Location", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridlocation.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Location indicates an expected call of Location.
+func (mr *MockCharactersMockRecorder) Location(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Location", reflect.TypeOf((*MockCharacters)(nil).Location), varargs...)
+}
+
+// LoyaltyPoints mocks base method.
+func (m *MockCharacters) LoyaltyPoints(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridloyaltypoints.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LoyaltyPoints", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridloyaltypoints.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoyaltyPoints indicates an expected call of LoyaltyPoints.
+func (mr *MockCharactersMockRecorder) LoyaltyPoints(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoyaltyPoints", reflect.TypeOf((*MockCharacters)(nil).LoyaltyPoints), varargs...)
+}
+
+// Mail mocks base method.
+func (m *MockCharacters) Mail(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridmail.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridmail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Mail", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridmail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mail indicates an expected call of Mail.
+func (mr *MockCharactersMockRecorder) Mail(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mail", reflect.TypeOf((*MockCharacters)(nil).Mail), varargs...)
+}
+
+// MailLabels mocks base method.
+func (m *MockCharacters) MailLabels(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridmaillabels.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MailLabels", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridmaillabels.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MailLabels indicates an expected call of MailLabels.
+func (mr *MockCharactersMockRecorder) MailLabels(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailLabels", reflect.TypeOf((*MockCharacters)(nil).MailLabels), varargs...)
+}
+
+// MailLists mocks base method.
+func (m *MockCharacters) MailLists(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridmaillists.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MailLists", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridmaillists.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MailLists indicates an expected call of MailLists.
+func (mr *MockCharactersMockRecorder) MailLists(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailLists", reflect.TypeOf((*MockCharacters)(nil).MailLists), varargs...)
+}
+
+// MailMail mocks base method.
+func (m *MockCharacters) MailMail(ctx context.Context, characterID character.Identifier, mailId int64, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridmailmailid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, mailId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MailMail", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridmailmailid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MailMail indicates an expected call of MailMail.
+func (mr *MockCharactersMockRecorder) MailMail(ctx, characterID, mailId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, mailId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailMail", reflect.TypeOf((*MockCharacters)(nil).MailMail), varargs...)
+}
+
+// Medals mocks base method.
+func (m *MockCharacters) Medals(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridmedals.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Medals", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridmedals.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Medals indicates an expected call of Medals.
+func (mr *MockCharactersMockRecorder) Medals(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Medals", reflect.TypeOf((*MockCharacters)(nil).Medals), varargs...)
+}
+
+// MercenaryTacticalOperation mocks base method.
+func (m *MockCharacters) MercenaryTacticalOperation(ctx context.Context, characterID character.Identifier, operationId uuid.UUID, opts ...request.RequestOption) (*request.Response[*getcharactersmercenarytacticaloperationsdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, operationId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MercenaryTacticalOperation", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersmercenarytacticaloperationsdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MercenaryTacticalOperation indicates an expected call of MercenaryTacticalOperation.
+func (mr *MockCharactersMockRecorder) MercenaryTacticalOperation(ctx, characterID, operationId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, operationId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MercenaryTacticalOperation", reflect.TypeOf((*MockCharacters)(nil).MercenaryTacticalOperation), varargs...)
+}
+
+// MercenaryTacticalOperations mocks base method.
+func (m *MockCharacters) MercenaryTacticalOperations(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersmercenarytacticaloperationslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MercenaryTacticalOperations", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersmercenarytacticaloperationslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MercenaryTacticalOperations indicates an expected call of MercenaryTacticalOperations.
+func (mr *MockCharactersMockRecorder) MercenaryTacticalOperations(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MercenaryTacticalOperations", reflect.TypeOf((*MockCharacters)(nil).MercenaryTacticalOperations), varargs...)
+}
+
+// Mining mocks base method.
+func (m *MockCharacters) Mining(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridmining.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridmining.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Mining", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridmining.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mining indicates an expected call of Mining.
+func (mr *MockCharactersMockRecorder) Mining(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mining", reflect.TypeOf((*MockCharacters)(nil).Mining), varargs...)
+}
+
+// Notifications mocks base method.
+func (m *MockCharacters) Notifications(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridnotifications.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Notifications", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridnotifications.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockCharactersMockRecorder) Notifications(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockCharacters)(nil).Notifications), varargs...)
+}
+
+// NotificationsContacts mocks base method.
+func (m *MockCharacters) NotificationsContacts(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridnotificationscontacts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NotificationsContacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridnotificationscontacts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationsContacts indicates an expected call of NotificationsContacts.
+func (mr *MockCharactersMockRecorder) NotificationsContacts(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationsContacts", reflect.TypeOf((*MockCharacters)(nil).NotificationsContacts), varargs...)
+}
+
+// Online mocks base method.
+func (m *MockCharacters) Online(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridonline.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Online", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridonline.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Online indicates an expected call of Online.
+func (mr *MockCharactersMockRecorder) Online(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Online", reflect.TypeOf((*MockCharacters)(nil).Online), varargs...)
+}
+
+// Orders mocks base method.
+func (m *MockCharacters) Orders(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridorders.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Orders", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridorders.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Orders indicates an expected call of Orders.
+func (mr *MockCharactersMockRecorder) Orders(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orders", reflect.TypeOf((*MockCharacters)(nil).Orders), varargs...)
+}
+
+// OrdersHistory mocks base method.
+func (m *MockCharacters) OrdersHistory(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridordershistory.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridordershistory.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OrdersHistory", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridordershistory.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrdersHistory indicates an expected call of OrdersHistory.
+func (mr *MockCharactersMockRecorder) OrdersHistory(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersHistory", reflect.TypeOf((*MockCharacters)(nil).OrdersHistory), varargs...)
+}
+
+// Planet mocks base method.
+func (m *MockCharacters) Planet(ctx context.Context, characterID character.Identifier, planetId int64, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridplanetsplanetid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, planetId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Planet", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridplanetsplanetid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Planet indicates an expected call of Planet.
+func (mr *MockCharactersMockRecorder) Planet(ctx, characterID, planetId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, planetId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Planet", reflect.TypeOf((*MockCharacters)(nil).Planet), varargs...)
+}
+
+// Planets mocks base method.
+func (m *MockCharacters) Planets(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridplanets.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Planets", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridplanets.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Planets indicates an expected call of Planets.
+func (mr *MockCharactersMockRecorder) Planets(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Planets", reflect.TypeOf((*MockCharacters)(nil).Planets), varargs...)
+}
+
+// Portrait mocks base method.
+func (m *MockCharacters) Portrait(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridportrait.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Portrait", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridportrait.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Portrait indicates an expected call of Portrait.
+func (mr *MockCharactersMockRecorder) Portrait(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Portrait", reflect.TypeOf((*MockCharacters)(nil).Portrait), varargs...)
+}
+
+// PostAffiliation mocks base method.
+func (m *MockCharacters) PostAffiliation(ctx context.Context, input *postcharactersaffiliation.Input, opts ...request.RequestOption) (*request.Response[[]*postcharactersaffiliation.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostAffiliation", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*postcharactersaffiliation.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostAffiliation indicates an expected call of PostAffiliation.
+func (mr *MockCharactersMockRecorder) PostAffiliation(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAffiliation", reflect.TypeOf((*MockCharacters)(nil).PostAffiliation), varargs...)
+}
+
+// PostAssetsLocations mocks base method.
+func (m *MockCharacters) PostAssetsLocations(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridassetslocations.Input, opts ...request.RequestOption) (*request.Response[[]*postcharacterscharacteridassetslocations.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostAssetsLocations", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*postcharacterscharacteridassetslocations.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostAssetsLocations indicates an expected call of PostAssetsLocations.
+func (mr *MockCharactersMockRecorder) PostAssetsLocations(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAssetsLocations", reflect.TypeOf((*MockCharacters)(nil).PostAssetsLocations), varargs...)
+}
+
+// PostAssetsNames mocks base method.
+func (m *MockCharacters) PostAssetsNames(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridassetsnames.Input, opts ...request.RequestOption) (*request.Response[[]*postcharacterscharacteridassetsnames.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostAssetsNames", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*postcharacterscharacteridassetsnames.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostAssetsNames indicates an expected call of PostAssetsNames.
+func (mr *MockCharactersMockRecorder) PostAssetsNames(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAssetsNames", reflect.TypeOf((*MockCharacters)(nil).PostAssetsNames), varargs...)
+}
+
+// PostContacts mocks base method.
+func (m *MockCharacters) PostContacts(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridcontacts.Input, opts ...request.RequestOption) (*request.Response[postcharacterscharacteridcontacts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostContacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[postcharacterscharacteridcontacts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostContacts indicates an expected call of PostContacts.
+func (mr *MockCharactersMockRecorder) PostContacts(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostContacts", reflect.TypeOf((*MockCharacters)(nil).PostContacts), varargs...)
+}
+
+// PostCspa mocks base method.
+func (m *MockCharacters) PostCspa(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridcspa.Input, opts ...request.RequestOption) (*request.Response[postcharacterscharacteridcspa.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostCspa", varargs...)
+	ret0, _ := ret[0].(*request.Response[postcharacterscharacteridcspa.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostCspa indicates an expected call of PostCspa.
+func (mr *MockCharactersMockRecorder) PostCspa(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCspa", reflect.TypeOf((*MockCharacters)(nil).PostCspa), varargs...)
+}
+
+// PostFittings mocks base method.
+func (m *MockCharacters) PostFittings(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridfittings.Input, opts ...request.RequestOption) (*request.Response[*postcharacterscharacteridfittings.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostFittings", varargs...)
+	ret0, _ := ret[0].(*request.Response[*postcharacterscharacteridfittings.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostFittings indicates an expected call of PostFittings.
+func (mr *MockCharactersMockRecorder) PostFittings(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostFittings", reflect.TypeOf((*MockCharacters)(nil).PostFittings), varargs...)
+}
+
+// PostMail mocks base method.
+func (m *MockCharacters) PostMail(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridmail.Input, opts ...request.RequestOption) (*request.Response[postcharacterscharacteridmail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostMail", varargs...)
+	ret0, _ := ret[0].(*request.Response[postcharacterscharacteridmail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMail indicates an expected call of PostMail.
+func (mr *MockCharactersMockRecorder) PostMail(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMail", reflect.TypeOf((*MockCharacters)(nil).PostMail), varargs...)
+}
+
+// PostMailLabels mocks base method.
+func (m *MockCharacters) PostMailLabels(ctx context.Context, characterID character.Identifier, input *postcharacterscharacteridmaillabels.Input, opts ...request.RequestOption) (*request.Response[postcharacterscharacteridmaillabels.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostMailLabels", varargs...)
+	ret0, _ := ret[0].(*request.Response[postcharacterscharacteridmaillabels.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMailLabels indicates an expected call of PostMailLabels.
+func (mr *MockCharactersMockRecorder) PostMailLabels(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMailLabels", reflect.TypeOf((*MockCharacters)(nil).PostMailLabels), varargs...)
+}
+
+// PutCalendarEvent mocks base method.
+func (m *MockCharacters) PutCalendarEvent(ctx context.Context, characterID character.Identifier, eventId int64, input *putcharacterscharacteridcalendareventid.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, eventId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutCalendarEvent", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutCalendarEvent indicates an expected call of PutCalendarEvent.
+func (mr *MockCharactersMockRecorder) PutCalendarEvent(ctx, characterID, eventId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, eventId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCalendarEvent", reflect.TypeOf((*MockCharacters)(nil).PutCalendarEvent), varargs...)
+}
+
+// PutContacts mocks base method.
+func (m *MockCharacters) PutContacts(ctx context.Context, characterID character.Identifier, input *putcharacterscharacteridcontacts.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutContacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutContacts indicates an expected call of PutContacts.
+func (mr *MockCharactersMockRecorder) PutContacts(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutContacts", reflect.TypeOf((*MockCharacters)(nil).PutContacts), varargs...)
+}
+
+// PutMail mocks base method.
+func (m *MockCharacters) PutMail(ctx context.Context, characterID character.Identifier, mailId int64, input *putcharacterscharacteridmailmailid.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, mailId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutMail", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMail indicates an expected call of PutMail.
+func (mr *MockCharactersMockRecorder) PutMail(ctx, characterID, mailId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, mailId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMail", reflect.TypeOf((*MockCharacters)(nil).PutMail), varargs...)
+}
+
+// Roles mocks base method.
+func (m *MockCharacters) Roles(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridroles.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Roles", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridroles.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Roles indicates an expected call of Roles.
+func (mr *MockCharactersMockRecorder) Roles(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockCharacters)(nil).Roles), varargs...)
+}
+
+// Search mocks base method.
+func (m *MockCharacters) Search(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridsearch.Input, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridsearch.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Search", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridsearch.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockCharactersMockRecorder) Search(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCharacters)(nil).Search), varargs...)
+}
+
+// Ship mocks base method.
+func (m *MockCharacters) Ship(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridship.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Ship", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridship.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ship indicates an expected call of Ship.
+func (mr *MockCharactersMockRecorder) Ship(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ship", reflect.TypeOf((*MockCharacters)(nil).Ship), varargs...)
+}
+
+// Skillqueue mocks base method.
+func (m *MockCharacters) Skillqueue(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridskillqueue.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Skillqueue", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridskillqueue.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Skillqueue indicates an expected call of Skillqueue.
+func (mr *MockCharactersMockRecorder) Skillqueue(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Skillqueue", reflect.TypeOf((*MockCharacters)(nil).Skillqueue), varargs...)
+}
+
+// Skills mocks base method.
+func (m *MockCharacters) Skills(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharacterscharacteridskills.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Skills", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharacterscharacteridskills.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Skills indicates an expected call of Skills.
+func (mr *MockCharactersMockRecorder) Skills(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Skills", reflect.TypeOf((*MockCharacters)(nil).Skills), varargs...)
+}
+
+// Standings mocks base method.
+func (m *MockCharacters) Standings(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridstandings.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Standings", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridstandings.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Standings indicates an expected call of Standings.
+func (mr *MockCharactersMockRecorder) Standings(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Standings", reflect.TypeOf((*MockCharacters)(nil).Standings), varargs...)
+}
+
+// StructuresMercenaryDen mocks base method.
+func (m *MockCharacters) StructuresMercenaryDen(ctx context.Context, characterID character.Identifier, mercenaryDen item.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersstructuresmercenarydensdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, mercenaryDen}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructuresMercenaryDen", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersstructuresmercenarydensdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StructuresMercenaryDen indicates an expected call of StructuresMercenaryDen.
+func (mr *MockCharactersMockRecorder) StructuresMercenaryDen(ctx, characterID, mercenaryDen any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, mercenaryDen}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructuresMercenaryDen", reflect.TypeOf((*MockCharacters)(nil).StructuresMercenaryDen), varargs...)
+}
+
+// StructuresMercenaryDens mocks base method.
+func (m *MockCharacters) StructuresMercenaryDens(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcharactersstructuresmercenarydenslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructuresMercenaryDens", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcharactersstructuresmercenarydenslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StructuresMercenaryDens indicates an expected call of StructuresMercenaryDens.
+func (mr *MockCharactersMockRecorder) StructuresMercenaryDens(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructuresMercenaryDens", reflect.TypeOf((*MockCharacters)(nil).StructuresMercenaryDens), varargs...)
+}
+
+// Titles mocks base method.
+func (m *MockCharacters) Titles(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridtitles.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Titles", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridtitles.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Titles indicates an expected call of Titles.
+func (mr *MockCharactersMockRecorder) Titles(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Titles", reflect.TypeOf((*MockCharacters)(nil).Titles), varargs...)
+}
+
+// Wallet mocks base method.
+func (m *MockCharacters) Wallet(ctx context.Context, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[getcharacterscharacteridwallet.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Wallet", varargs...)
+	ret0, _ := ret[0].(*request.Response[getcharacterscharacteridwallet.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wallet indicates an expected call of Wallet.
+func (mr *MockCharactersMockRecorder) Wallet(ctx, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wallet", reflect.TypeOf((*MockCharacters)(nil).Wallet), varargs...)
+}
+
+// WalletJournal mocks base method.
+func (m *MockCharacters) WalletJournal(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridwalletjournal.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridwalletjournal.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WalletJournal", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridwalletjournal.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WalletJournal indicates an expected call of WalletJournal.
+func (mr *MockCharactersMockRecorder) WalletJournal(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletJournal", reflect.TypeOf((*MockCharacters)(nil).WalletJournal), varargs...)
+}
+
+// WalletTransactions mocks base method.
+func (m *MockCharacters) WalletTransactions(ctx context.Context, characterID character.Identifier, input *getcharacterscharacteridwallettransactions.Input, opts ...request.RequestOption) (*request.Response[[]*getcharacterscharacteridwallettransactions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, characterID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WalletTransactions", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcharacterscharacteridwallettransactions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WalletTransactions indicates an expected call of WalletTransactions.
+func (mr *MockCharactersMockRecorder) WalletTransactions(ctx, characterID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, characterID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletTransactions", reflect.TypeOf((*MockCharacters)(nil).WalletTransactions), varargs...)
+}
+
+// MockContracts is a mock of Contracts interface.
+type MockContracts struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractsMockRecorder
+	isgomock struct{}
+}
+
+// MockContractsMockRecorder is the mock recorder for MockContracts.
+type MockContractsMockRecorder struct {
+	mock *MockContracts
+}
+
+// NewMockContracts creates a new mock instance.
+func NewMockContracts(ctrl *gomock.Controller) *MockContracts {
+	mock := &MockContracts{ctrl: ctrl}
+	mock.recorder = &MockContractsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContracts) EXPECT() *MockContractsMockRecorder {
+	return m.recorder
+}
+
+// PublicBidsContract mocks base method.
+func (m *MockContracts) PublicBidsContract(ctx context.Context, contractId int64, input *getcontractspublicbidscontractid.Input, opts ...request.RequestOption) (*request.Response[[]*getcontractspublicbidscontractid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contractId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublicBidsContract", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcontractspublicbidscontractid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicBidsContract indicates an expected call of PublicBidsContract.
+func (mr *MockContractsMockRecorder) PublicBidsContract(ctx, contractId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contractId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicBidsContract", reflect.TypeOf((*MockContracts)(nil).PublicBidsContract), varargs...)
+}
+
+// PublicItemsContract mocks base method.
+func (m *MockContracts) PublicItemsContract(ctx context.Context, contractId int64, input *getcontractspublicitemscontractid.Input, opts ...request.RequestOption) (*request.Response[[]*getcontractspublicitemscontractid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contractId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublicItemsContract", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcontractspublicitemscontractid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicItemsContract indicates an expected call of PublicItemsContract.
+func (mr *MockContractsMockRecorder) PublicItemsContract(ctx, contractId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contractId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicItemsContract", reflect.TypeOf((*MockContracts)(nil).PublicItemsContract), varargs...)
+}
+
+// PublicRegion mocks base method.
+func (m *MockContracts) PublicRegion(ctx context.Context, regionId int64, input *getcontractspublicregionid.Input, opts ...request.RequestOption) (*request.Response[[]*getcontractspublicregionid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, regionId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublicRegion", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcontractspublicregionid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicRegion indicates an expected call of PublicRegion.
+func (mr *MockContractsMockRecorder) PublicRegion(ctx, regionId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, regionId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicRegion", reflect.TypeOf((*MockContracts)(nil).PublicRegion), varargs...)
+}
+
+// MockCorporations is a mock of Corporations interface.
+type MockCorporations struct {
+	ctrl     *gomock.Controller
+	recorder *MockCorporationsMockRecorder
+	isgomock struct{}
+}
+
+// MockCorporationsMockRecorder is the mock recorder for MockCorporations.
+type MockCorporationsMockRecorder struct {
+	mock *MockCorporations
+}
+
+// NewMockCorporations creates a new mock instance.
+func NewMockCorporations(ctrl *gomock.Controller) *MockCorporations {
+	mock := &MockCorporations{ctrl: ctrl}
+	mock.recorder = &MockCorporationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCorporations) EXPECT() *MockCorporationsMockRecorder {
+	return m.recorder
+}
+
+// Alliancehistory mocks base method.
+func (m *MockCorporations) Alliancehistory(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidalliancehistory.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Alliancehistory", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidalliancehistory.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Alliancehistory indicates an expected call of Alliancehistory.
+func (mr *MockCorporationsMockRecorder) Alliancehistory(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alliancehistory", reflect.TypeOf((*MockCorporations)(nil).Alliancehistory), varargs...)
+}
+
+// Assets mocks base method.
+func (m *MockCorporations) Assets(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidassets.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidassets.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Assets", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidassets.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assets indicates an expected call of Assets.
+func (mr *MockCorporationsMockRecorder) Assets(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assets", reflect.TypeOf((*MockCorporations)(nil).Assets), varargs...)
+}
+
+// Blueprints mocks base method.
+func (m *MockCorporations) Blueprints(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidblueprints.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidblueprints.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Blueprints", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidblueprints.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blueprints indicates an expected call of Blueprints.
+func (mr *MockCorporationsMockRecorder) Blueprints(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blueprints", reflect.TypeOf((*MockCorporations)(nil).Blueprints), varargs...)
+}
+
+// Contacts mocks base method.
+func (m *MockCorporations) Contacts(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidcontacts.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcontacts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Contacts", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcontacts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contacts indicates an expected call of Contacts.
+func (mr *MockCorporationsMockRecorder) Contacts(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contacts", reflect.TypeOf((*MockCorporations)(nil).Contacts), varargs...)
+}
+
+// ContactsLabels mocks base method.
+func (m *MockCorporations) ContactsLabels(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcontactslabels.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContactsLabels", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcontactslabels.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContactsLabels indicates an expected call of ContactsLabels.
+func (mr *MockCorporationsMockRecorder) ContactsLabels(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactsLabels", reflect.TypeOf((*MockCorporations)(nil).ContactsLabels), varargs...)
+}
+
+// ContainersLogs mocks base method.
+func (m *MockCorporations) ContainersLogs(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidcontainerslogs.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcontainerslogs.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContainersLogs", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcontainerslogs.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainersLogs indicates an expected call of ContainersLogs.
+func (mr *MockCorporationsMockRecorder) ContainersLogs(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainersLogs", reflect.TypeOf((*MockCorporations)(nil).ContainersLogs), varargs...)
+}
+
+// ContractBids mocks base method.
+func (m *MockCorporations) ContractBids(ctx context.Context, corporationID corporation.Identifier, contractId int64, input *getcorporationscorporationidcontractscontractidbids.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcontractscontractidbids.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, contractId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContractBids", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcontractscontractidbids.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContractBids indicates an expected call of ContractBids.
+func (mr *MockCorporationsMockRecorder) ContractBids(ctx, corporationID, contractId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, contractId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractBids", reflect.TypeOf((*MockCorporations)(nil).ContractBids), varargs...)
+}
+
+// ContractItems mocks base method.
+func (m *MockCorporations) ContractItems(ctx context.Context, corporationID corporation.Identifier, contractId int64, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcontractscontractiditems.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, contractId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContractItems", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcontractscontractiditems.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContractItems indicates an expected call of ContractItems.
+func (mr *MockCorporationsMockRecorder) ContractItems(ctx, corporationID, contractId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, contractId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractItems", reflect.TypeOf((*MockCorporations)(nil).ContractItems), varargs...)
+}
+
+// Contracts mocks base method.
+func (m *MockCorporations) Contracts(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidcontracts.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcontracts.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Contracts", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcontracts.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contracts indicates an expected call of Contracts.
+func (mr *MockCorporationsMockRecorder) Contracts(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contracts", reflect.TypeOf((*MockCorporations)(nil).Contracts), varargs...)
+}
+
+// Corporation mocks base method.
+func (m *MockCorporations) Corporation(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationscorporationid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Corporation", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationscorporationid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Corporation indicates an expected call of Corporation.
+func (mr *MockCorporationsMockRecorder) Corporation(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Corporation", reflect.TypeOf((*MockCorporations)(nil).Corporation), varargs...)
+}
+
+// CustomsOffices mocks base method.
+func (m *MockCorporations) CustomsOffices(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidcustomsoffices.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidcustomsoffices.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CustomsOffices", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidcustomsoffices.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomsOffices indicates an expected call of CustomsOffices.
+func (mr *MockCorporationsMockRecorder) CustomsOffices(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomsOffices", reflect.TypeOf((*MockCorporations)(nil).CustomsOffices), varargs...)
+}
+
+// Divisions mocks base method.
+func (m *MockCorporations) Divisions(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationscorporationiddivisions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Divisions", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationscorporationiddivisions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Divisions indicates an expected call of Divisions.
+func (mr *MockCorporationsMockRecorder) Divisions(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Divisions", reflect.TypeOf((*MockCorporations)(nil).Divisions), varargs...)
+}
+
+// Facilities mocks base method.
+func (m *MockCorporations) Facilities(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidfacilities.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Facilities", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidfacilities.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Facilities indicates an expected call of Facilities.
+func (mr *MockCorporationsMockRecorder) Facilities(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Facilities", reflect.TypeOf((*MockCorporations)(nil).Facilities), varargs...)
+}
+
+// FreelanceJobParticipants mocks base method.
+func (m *MockCorporations) FreelanceJobParticipants(ctx context.Context, corporationID corporation.Identifier, jobId uuid.UUID, input *getcorporationsfreelancejobsparticipants.Input, opts ...request.RequestOption) (*request.Response[*getcorporationsfreelancejobsparticipants.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, jobId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreelanceJobParticipants", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsfreelancejobsparticipants.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreelanceJobParticipants indicates an expected call of FreelanceJobParticipants.
+func (mr *MockCorporationsMockRecorder) FreelanceJobParticipants(ctx, corporationID, jobId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, jobId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreelanceJobParticipants", reflect.TypeOf((*MockCorporations)(nil).FreelanceJobParticipants), varargs...)
+}
+
+// FreelanceJobs mocks base method.
+func (m *MockCorporations) FreelanceJobs(ctx context.Context, corporationID corporation.Identifier, input *getcorporationsfreelancejobslisting.Input, opts ...request.RequestOption) (*request.Response[*getcorporationsfreelancejobslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreelanceJobs", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsfreelancejobslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreelanceJobs indicates an expected call of FreelanceJobs.
+func (mr *MockCorporationsMockRecorder) FreelanceJobs(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreelanceJobs", reflect.TypeOf((*MockCorporations)(nil).FreelanceJobs), varargs...)
+}
+
+// FwStats mocks base method.
+func (m *MockCorporations) FwStats(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationscorporationidfwstats.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FwStats", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationscorporationidfwstats.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FwStats indicates an expected call of FwStats.
+func (mr *MockCorporationsMockRecorder) FwStats(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FwStats", reflect.TypeOf((*MockCorporations)(nil).FwStats), varargs...)
+}
+
+// Icons mocks base method.
+func (m *MockCorporations) Icons(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationscorporationidicons.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Icons", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationscorporationidicons.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Icons indicates an expected call of Icons.
+func (mr *MockCorporationsMockRecorder) Icons(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Icons", reflect.TypeOf((*MockCorporations)(nil).Icons), varargs...)
+}
+
+// IndustryJobs mocks base method.
+func (m *MockCorporations) IndustryJobs(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidindustryjobs.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidindustryjobs.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IndustryJobs", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidindustryjobs.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndustryJobs indicates an expected call of IndustryJobs.
+func (mr *MockCorporationsMockRecorder) IndustryJobs(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndustryJobs", reflect.TypeOf((*MockCorporations)(nil).IndustryJobs), varargs...)
+}
+
+// KillmailsRecent mocks base method.
+func (m *MockCorporations) KillmailsRecent(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidkillmailsrecent.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidkillmailsrecent.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "KillmailsRecent", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidkillmailsrecent.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KillmailsRecent indicates an expected call of KillmailsRecent.
+func (mr *MockCorporationsMockRecorder) KillmailsRecent(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillmailsRecent", reflect.TypeOf((*MockCorporations)(nil).KillmailsRecent), varargs...)
+}
+
+// Medals mocks base method.
+func (m *MockCorporations) Medals(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidmedals.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidmedals.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Medals", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidmedals.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Medals indicates an expected call of Medals.
+func (mr *MockCorporationsMockRecorder) Medals(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Medals", reflect.TypeOf((*MockCorporations)(nil).Medals), varargs...)
+}
+
+// MedalsIssued mocks base method.
+func (m *MockCorporations) MedalsIssued(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidmedalsissued.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidmedalsissued.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MedalsIssued", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidmedalsissued.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MedalsIssued indicates an expected call of MedalsIssued.
+func (mr *MockCorporationsMockRecorder) MedalsIssued(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MedalsIssued", reflect.TypeOf((*MockCorporations)(nil).MedalsIssued), varargs...)
+}
+
+// Members mocks base method.
+func (m *MockCorporations) Members(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[getcorporationscorporationidmembers.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Members", varargs...)
+	ret0, _ := ret[0].(*request.Response[getcorporationscorporationidmembers.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockCorporationsMockRecorder) Members(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockCorporations)(nil).Members), varargs...)
+}
+
+// MembersLimit mocks base method.
+func (m *MockCorporations) MembersLimit(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[getcorporationscorporationidmemberslimit.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MembersLimit", varargs...)
+	ret0, _ := ret[0].(*request.Response[getcorporationscorporationidmemberslimit.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MembersLimit indicates an expected call of MembersLimit.
+func (mr *MockCorporationsMockRecorder) MembersLimit(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MembersLimit", reflect.TypeOf((*MockCorporations)(nil).MembersLimit), varargs...)
+}
+
+// MembersTitles mocks base method.
+func (m *MockCorporations) MembersTitles(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidmemberstitles.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MembersTitles", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidmemberstitles.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MembersTitles indicates an expected call of MembersTitles.
+func (mr *MockCorporationsMockRecorder) MembersTitles(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MembersTitles", reflect.TypeOf((*MockCorporations)(nil).MembersTitles), varargs...)
+}
+
+// Membertracking mocks base method.
+func (m *MockCorporations) Membertracking(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidmembertracking.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Membertracking", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidmembertracking.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Membertracking indicates an expected call of Membertracking.
+func (mr *MockCorporationsMockRecorder) Membertracking(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Membertracking", reflect.TypeOf((*MockCorporations)(nil).Membertracking), varargs...)
+}
+
+// MiningExtractions mocks base method.
+func (m *MockCorporations) MiningExtractions(ctx context.Context, corporationID corporation.Identifier, input *getcorporationcorporationidminingextractions.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationcorporationidminingextractions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MiningExtractions", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationcorporationidminingextractions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MiningExtractions indicates an expected call of MiningExtractions.
+func (mr *MockCorporationsMockRecorder) MiningExtractions(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MiningExtractions", reflect.TypeOf((*MockCorporations)(nil).MiningExtractions), varargs...)
+}
+
+// MiningObserver mocks base method.
+func (m *MockCorporations) MiningObserver(ctx context.Context, corporationID corporation.Identifier, observerId int64, input *getcorporationcorporationidminingobserversobserverid.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationcorporationidminingobserversobserverid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, observerId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MiningObserver", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationcorporationidminingobserversobserverid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MiningObserver indicates an expected call of MiningObserver.
+func (mr *MockCorporationsMockRecorder) MiningObserver(ctx, corporationID, observerId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, observerId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MiningObserver", reflect.TypeOf((*MockCorporations)(nil).MiningObserver), varargs...)
+}
+
+// MiningObservers mocks base method.
+func (m *MockCorporations) MiningObservers(ctx context.Context, corporationID corporation.Identifier, input *getcorporationcorporationidminingobservers.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationcorporationidminingobservers.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MiningObservers", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationcorporationidminingobservers.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MiningObservers indicates an expected call of MiningObservers.
+func (mr *MockCorporationsMockRecorder) MiningObservers(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MiningObservers", reflect.TypeOf((*MockCorporations)(nil).MiningObservers), varargs...)
+}
+
+// Npccorps mocks base method.
+func (m *MockCorporations) Npccorps(ctx context.Context, opts ...request.RequestOption) (*request.Response[getcorporationsnpccorps.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Npccorps", varargs...)
+	ret0, _ := ret[0].(*request.Response[getcorporationsnpccorps.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Npccorps indicates an expected call of Npccorps.
+func (mr *MockCorporationsMockRecorder) Npccorps(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Npccorps", reflect.TypeOf((*MockCorporations)(nil).Npccorps), varargs...)
+}
+
+// Orders mocks base method.
+func (m *MockCorporations) Orders(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidorders.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidorders.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Orders", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidorders.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Orders indicates an expected call of Orders.
+func (mr *MockCorporationsMockRecorder) Orders(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orders", reflect.TypeOf((*MockCorporations)(nil).Orders), varargs...)
+}
+
+// OrdersHistory mocks base method.
+func (m *MockCorporations) OrdersHistory(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidordershistory.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidordershistory.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OrdersHistory", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidordershistory.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrdersHistory indicates an expected call of OrdersHistory.
+func (mr *MockCorporationsMockRecorder) OrdersHistory(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersHistory", reflect.TypeOf((*MockCorporations)(nil).OrdersHistory), varargs...)
+}
+
+// PostAssetsLocations mocks base method.
+func (m *MockCorporations) PostAssetsLocations(ctx context.Context, corporationID corporation.Identifier, input *postcorporationscorporationidassetslocations.Input, opts ...request.RequestOption) (*request.Response[[]*postcorporationscorporationidassetslocations.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostAssetsLocations", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*postcorporationscorporationidassetslocations.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostAssetsLocations indicates an expected call of PostAssetsLocations.
+func (mr *MockCorporationsMockRecorder) PostAssetsLocations(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAssetsLocations", reflect.TypeOf((*MockCorporations)(nil).PostAssetsLocations), varargs...)
+}
+
+// PostAssetsNames mocks base method.
+func (m *MockCorporations) PostAssetsNames(ctx context.Context, corporationID corporation.Identifier, input *postcorporationscorporationidassetsnames.Input, opts ...request.RequestOption) (*request.Response[[]*postcorporationscorporationidassetsnames.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostAssetsNames", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*postcorporationscorporationidassetsnames.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostAssetsNames indicates an expected call of PostAssetsNames.
+func (mr *MockCorporationsMockRecorder) PostAssetsNames(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAssetsNames", reflect.TypeOf((*MockCorporations)(nil).PostAssetsNames), varargs...)
+}
+
+// Project mocks base method.
+func (m *MockCorporations) Project(ctx context.Context, corporationID corporation.Identifier, projectId uuid.UUID, opts ...request.RequestOption) (*request.Response[*getcorporationsprojectsdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, projectId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Project", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsprojectsdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Project indicates an expected call of Project.
+func (mr *MockCorporationsMockRecorder) Project(ctx, corporationID, projectId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, projectId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Project", reflect.TypeOf((*MockCorporations)(nil).Project), varargs...)
+}
+
+// ProjectContributionCharacter mocks base method.
+func (m *MockCorporations) ProjectContributionCharacter(ctx context.Context, corporationID corporation.Identifier, projectId uuid.UUID, characterID character.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationsprojectscontribution.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, projectId, characterID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProjectContributionCharacter", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsprojectscontribution.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectContributionCharacter indicates an expected call of ProjectContributionCharacter.
+func (mr *MockCorporationsMockRecorder) ProjectContributionCharacter(ctx, corporationID, projectId, characterID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, projectId, characterID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectContributionCharacter", reflect.TypeOf((*MockCorporations)(nil).ProjectContributionCharacter), varargs...)
+}
+
+// ProjectContributors mocks base method.
+func (m *MockCorporations) ProjectContributors(ctx context.Context, corporationID corporation.Identifier, projectId uuid.UUID, input *getcorporationsprojectscontributors.Input, opts ...request.RequestOption) (*request.Response[*getcorporationsprojectscontributors.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, projectId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProjectContributors", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsprojectscontributors.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectContributors indicates an expected call of ProjectContributors.
+func (mr *MockCorporationsMockRecorder) ProjectContributors(ctx, corporationID, projectId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, projectId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectContributors", reflect.TypeOf((*MockCorporations)(nil).ProjectContributors), varargs...)
+}
+
+// Projects mocks base method.
+func (m *MockCorporations) Projects(ctx context.Context, corporationID corporation.Identifier, input *getcorporationsprojectslisting.Input, opts ...request.RequestOption) (*request.Response[*getcorporationsprojectslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Projects", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsprojectslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Projects indicates an expected call of Projects.
+func (mr *MockCorporationsMockRecorder) Projects(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Projects", reflect.TypeOf((*MockCorporations)(nil).Projects), varargs...)
+}
+
+// Roles mocks base method.
+func (m *MockCorporations) Roles(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidroles.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Roles", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidroles.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Roles indicates an expected call of Roles.
+func (mr *MockCorporationsMockRecorder) Roles(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockCorporations)(nil).Roles), varargs...)
+}
+
+// RolesHistory mocks base method.
+func (m *MockCorporations) RolesHistory(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidroleshistory.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidroleshistory.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RolesHistory", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidroleshistory.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RolesHistory indicates an expected call of RolesHistory.
+func (mr *MockCorporationsMockRecorder) RolesHistory(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolesHistory", reflect.TypeOf((*MockCorporations)(nil).RolesHistory), varargs...)
+}
+
+// Shareholders mocks base method.
+func (m *MockCorporations) Shareholders(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidshareholders.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidshareholders.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Shareholders", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidshareholders.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Shareholders indicates an expected call of Shareholders.
+func (mr *MockCorporationsMockRecorder) Shareholders(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shareholders", reflect.TypeOf((*MockCorporations)(nil).Shareholders), varargs...)
+}
+
+// Standings mocks base method.
+func (m *MockCorporations) Standings(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidstandings.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidstandings.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Standings", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidstandings.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Standings indicates an expected call of Standings.
+func (mr *MockCorporationsMockRecorder) Standings(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Standings", reflect.TypeOf((*MockCorporations)(nil).Standings), varargs...)
+}
+
+// Starbase mocks base method.
+func (m *MockCorporations) Starbase(ctx context.Context, corporationID corporation.Identifier, starbaseId int64, input *getcorporationscorporationidstarbasesstarbaseid.Input, opts ...request.RequestOption) (*request.Response[*getcorporationscorporationidstarbasesstarbaseid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, starbaseId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Starbase", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationscorporationidstarbasesstarbaseid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Starbase indicates an expected call of Starbase.
+func (mr *MockCorporationsMockRecorder) Starbase(ctx, corporationID, starbaseId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, starbaseId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Starbase", reflect.TypeOf((*MockCorporations)(nil).Starbase), varargs...)
+}
+
+// Starbases mocks base method.
+func (m *MockCorporations) Starbases(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidstarbases.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidstarbases.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Starbases", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidstarbases.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Starbases indicates an expected call of Starbases.
+func (mr *MockCorporationsMockRecorder) Starbases(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Starbases", reflect.TypeOf((*MockCorporations)(nil).Starbases), varargs...)
+}
+
+// Structures mocks base method.
+func (m *MockCorporations) Structures(ctx context.Context, corporationID corporation.Identifier, input *getcorporationscorporationidstructures.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidstructures.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Structures", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidstructures.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Structures indicates an expected call of Structures.
+func (mr *MockCorporationsMockRecorder) Structures(ctx, corporationID, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Structures", reflect.TypeOf((*MockCorporations)(nil).Structures), varargs...)
+}
+
+// StructuresSkyhook mocks base method.
+func (m *MockCorporations) StructuresSkyhook(ctx context.Context, corporationID corporation.Identifier, skyhook item.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationsstructuresskyhooksdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, skyhook}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructuresSkyhook", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsstructuresskyhooksdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StructuresSkyhook indicates an expected call of StructuresSkyhook.
+func (mr *MockCorporationsMockRecorder) StructuresSkyhook(ctx, corporationID, skyhook any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, skyhook}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructuresSkyhook", reflect.TypeOf((*MockCorporations)(nil).StructuresSkyhook), varargs...)
+}
+
+// StructuresSkyhooks mocks base method.
+func (m *MockCorporations) StructuresSkyhooks(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationsstructuresskyhookslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructuresSkyhooks", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsstructuresskyhookslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StructuresSkyhooks indicates an expected call of StructuresSkyhooks.
+func (mr *MockCorporationsMockRecorder) StructuresSkyhooks(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructuresSkyhooks", reflect.TypeOf((*MockCorporations)(nil).StructuresSkyhooks), varargs...)
+}
+
+// StructuresSovereigntyHub mocks base method.
+func (m *MockCorporations) StructuresSovereigntyHub(ctx context.Context, corporationID corporation.Identifier, sovereigntyHub item.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationsstructuressovereigntyhubsdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, sovereigntyHub}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructuresSovereigntyHub", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsstructuressovereigntyhubsdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StructuresSovereigntyHub indicates an expected call of StructuresSovereigntyHub.
+func (mr *MockCorporationsMockRecorder) StructuresSovereigntyHub(ctx, corporationID, sovereigntyHub any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, sovereigntyHub}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructuresSovereigntyHub", reflect.TypeOf((*MockCorporations)(nil).StructuresSovereigntyHub), varargs...)
+}
+
+// StructuresSovereigntyHubs mocks base method.
+func (m *MockCorporations) StructuresSovereigntyHubs(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[*getcorporationsstructuressovereigntyhubslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructuresSovereigntyHubs", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getcorporationsstructuressovereigntyhubslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StructuresSovereigntyHubs indicates an expected call of StructuresSovereigntyHubs.
+func (mr *MockCorporationsMockRecorder) StructuresSovereigntyHubs(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructuresSovereigntyHubs", reflect.TypeOf((*MockCorporations)(nil).StructuresSovereigntyHubs), varargs...)
+}
+
+// Titles mocks base method.
+func (m *MockCorporations) Titles(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidtitles.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Titles", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidtitles.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Titles indicates an expected call of Titles.
+func (mr *MockCorporationsMockRecorder) Titles(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Titles", reflect.TypeOf((*MockCorporations)(nil).Titles), varargs...)
+}
+
+// Wallets mocks base method.
+func (m *MockCorporations) Wallets(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidwallets.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Wallets", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidwallets.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wallets indicates an expected call of Wallets.
+func (mr *MockCorporationsMockRecorder) Wallets(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wallets", reflect.TypeOf((*MockCorporations)(nil).Wallets), varargs...)
+}
+
+// WalletsDivisionJournal mocks base method.
+func (m *MockCorporations) WalletsDivisionJournal(ctx context.Context, corporationID corporation.Identifier, division int64, input *getcorporationscorporationidwalletsdivisionjournal.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidwalletsdivisionjournal.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, division, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WalletsDivisionJournal", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidwalletsdivisionjournal.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WalletsDivisionJournal indicates an expected call of WalletsDivisionJournal.
+func (mr *MockCorporationsMockRecorder) WalletsDivisionJournal(ctx, corporationID, division, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, division, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletsDivisionJournal", reflect.TypeOf((*MockCorporations)(nil).WalletsDivisionJournal), varargs...)
+}
+
+// WalletsDivisionTransactions mocks base method.
+func (m *MockCorporations) WalletsDivisionTransactions(ctx context.Context, corporationID corporation.Identifier, division int64, input *getcorporationscorporationidwalletsdivisiontransactions.Input, opts ...request.RequestOption) (*request.Response[[]*getcorporationscorporationidwalletsdivisiontransactions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID, division, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WalletsDivisionTransactions", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getcorporationscorporationidwalletsdivisiontransactions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WalletsDivisionTransactions indicates an expected call of WalletsDivisionTransactions.
+func (mr *MockCorporationsMockRecorder) WalletsDivisionTransactions(ctx, corporationID, division, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID, division, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletsDivisionTransactions", reflect.TypeOf((*MockCorporations)(nil).WalletsDivisionTransactions), varargs...)
+}
+
+// MockDogma is a mock of Dogma interface.
+type MockDogma struct {
+	ctrl     *gomock.Controller
+	recorder *MockDogmaMockRecorder
+	isgomock struct{}
+}
+
+// MockDogmaMockRecorder is the mock recorder for MockDogma.
+type MockDogmaMockRecorder struct {
+	mock *MockDogma
+}
+
+// NewMockDogma creates a new mock instance.
+func NewMockDogma(ctrl *gomock.Controller) *MockDogma {
+	mock := &MockDogma{ctrl: ctrl}
+	mock.recorder = &MockDogmaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDogma) EXPECT() *MockDogmaMockRecorder {
+	return m.recorder
+}
+
+// Attribute mocks base method.
+func (m *MockDogma) Attribute(ctx context.Context, attributeId int64, opts ...request.RequestOption) (*request.Response[*getdogmaattributesattributeid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, attributeId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Attribute", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getdogmaattributesattributeid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attribute indicates an expected call of Attribute.
+func (mr *MockDogmaMockRecorder) Attribute(ctx, attributeId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, attributeId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attribute", reflect.TypeOf((*MockDogma)(nil).Attribute), varargs...)
+}
+
+// Attributes mocks base method.
+func (m *MockDogma) Attributes(ctx context.Context, opts ...request.RequestOption) (*request.Response[getdogmaattributes.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Attributes", varargs...)
+	ret0, _ := ret[0].(*request.Response[getdogmaattributes.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attributes indicates an expected call of Attributes.
+func (mr *MockDogmaMockRecorder) Attributes(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attributes", reflect.TypeOf((*MockDogma)(nil).Attributes), varargs...)
+}
+
+// DynamicItemsTypeItem mocks base method.
+func (m *MockDogma) DynamicItemsTypeItem(ctx context.Context, typeId, itemId int64, opts ...request.RequestOption) (*request.Response[*getdogmadynamicitemstypeiditemid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, typeId, itemId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DynamicItemsTypeItem", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getdogmadynamicitemstypeiditemid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DynamicItemsTypeItem indicates an expected call of DynamicItemsTypeItem.
+func (mr *MockDogmaMockRecorder) DynamicItemsTypeItem(ctx, typeId, itemId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, typeId, itemId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DynamicItemsTypeItem", reflect.TypeOf((*MockDogma)(nil).DynamicItemsTypeItem), varargs...)
+}
+
+// Effect mocks base method.
+func (m *MockDogma) Effect(ctx context.Context, effectId int64, opts ...request.RequestOption) (*request.Response[*getdogmaeffectseffectid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, effectId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Effect", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getdogmaeffectseffectid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Effect indicates an expected call of Effect.
+func (mr *MockDogmaMockRecorder) Effect(ctx, effectId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, effectId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Effect", reflect.TypeOf((*MockDogma)(nil).Effect), varargs...)
+}
+
+// Effects mocks base method.
+func (m *MockDogma) Effects(ctx context.Context, opts ...request.RequestOption) (*request.Response[getdogmaeffects.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Effects", varargs...)
+	ret0, _ := ret[0].(*request.Response[getdogmaeffects.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Effects indicates an expected call of Effects.
+func (mr *MockDogmaMockRecorder) Effects(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Effects", reflect.TypeOf((*MockDogma)(nil).Effects), varargs...)
+}
+
+// MockFleets is a mock of Fleets interface.
+type MockFleets struct {
+	ctrl     *gomock.Controller
+	recorder *MockFleetsMockRecorder
+	isgomock struct{}
+}
+
+// MockFleetsMockRecorder is the mock recorder for MockFleets.
+type MockFleetsMockRecorder struct {
+	mock *MockFleets
+}
+
+// NewMockFleets creates a new mock instance.
+func NewMockFleets(ctrl *gomock.Controller) *MockFleets {
+	mock := &MockFleets{ctrl: ctrl}
+	mock.recorder = &MockFleetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFleets) EXPECT() *MockFleetsMockRecorder {
+	return m.recorder
+}
+
+// DeleteMember mocks base method.
+func (m *MockFleets) DeleteMember(ctx context.Context, fleetId, memberId int64, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, memberId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMember", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockFleetsMockRecorder) DeleteMember(ctx, fleetId, memberId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, memberId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockFleets)(nil).DeleteMember), varargs...)
+}
+
+// DeleteSquad mocks base method.
+func (m *MockFleets) DeleteSquad(ctx context.Context, fleetId, squadId int64, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, squadId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSquad", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSquad indicates an expected call of DeleteSquad.
+func (mr *MockFleetsMockRecorder) DeleteSquad(ctx, fleetId, squadId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, squadId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSquad", reflect.TypeOf((*MockFleets)(nil).DeleteSquad), varargs...)
+}
+
+// DeleteWing mocks base method.
+func (m *MockFleets) DeleteWing(ctx context.Context, fleetId, wingId int64, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, wingId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWing", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWing indicates an expected call of DeleteWing.
+func (mr *MockFleetsMockRecorder) DeleteWing(ctx, fleetId, wingId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, wingId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWing", reflect.TypeOf((*MockFleets)(nil).DeleteWing), varargs...)
+}
+
+// Fleet mocks base method.
+func (m *MockFleets) Fleet(ctx context.Context, fleetId int64, opts ...request.RequestOption) (*request.Response[*getfleetsfleetid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Fleet", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getfleetsfleetid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fleet indicates an expected call of Fleet.
+func (mr *MockFleetsMockRecorder) Fleet(ctx, fleetId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fleet", reflect.TypeOf((*MockFleets)(nil).Fleet), varargs...)
+}
+
+// Members mocks base method.
+func (m *MockFleets) Members(ctx context.Context, fleetId int64, opts ...request.RequestOption) (*request.Response[[]*getfleetsfleetidmembers.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Members", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getfleetsfleetidmembers.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockFleetsMockRecorder) Members(ctx, fleetId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockFleets)(nil).Members), varargs...)
+}
+
+// PostMembers mocks base method.
+func (m *MockFleets) PostMembers(ctx context.Context, fleetId int64, input *postfleetsfleetidmembers.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostMembers", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMembers indicates an expected call of PostMembers.
+func (mr *MockFleetsMockRecorder) PostMembers(ctx, fleetId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMembers", reflect.TypeOf((*MockFleets)(nil).PostMembers), varargs...)
+}
+
+// PostWingSquads mocks base method.
+func (m *MockFleets) PostWingSquads(ctx context.Context, fleetId, wingId int64, opts ...request.RequestOption) (*request.Response[*postfleetsfleetidwingswingidsquads.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, wingId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostWingSquads", varargs...)
+	ret0, _ := ret[0].(*request.Response[*postfleetsfleetidwingswingidsquads.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostWingSquads indicates an expected call of PostWingSquads.
+func (mr *MockFleetsMockRecorder) PostWingSquads(ctx, fleetId, wingId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, wingId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWingSquads", reflect.TypeOf((*MockFleets)(nil).PostWingSquads), varargs...)
+}
+
+// PostWings mocks base method.
+func (m *MockFleets) PostWings(ctx context.Context, fleetId int64, opts ...request.RequestOption) (*request.Response[*postfleetsfleetidwings.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostWings", varargs...)
+	ret0, _ := ret[0].(*request.Response[*postfleetsfleetidwings.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostWings indicates an expected call of PostWings.
+func (mr *MockFleetsMockRecorder) PostWings(ctx, fleetId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWings", reflect.TypeOf((*MockFleets)(nil).PostWings), varargs...)
+}
+
+// PutFleet mocks base method.
+func (m *MockFleets) PutFleet(ctx context.Context, fleetId int64, input *putfleetsfleetid.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutFleet", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutFleet indicates an expected call of PutFleet.
+func (mr *MockFleetsMockRecorder) PutFleet(ctx, fleetId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFleet", reflect.TypeOf((*MockFleets)(nil).PutFleet), varargs...)
+}
+
+// PutMember mocks base method.
+func (m *MockFleets) PutMember(ctx context.Context, fleetId, memberId int64, input *putfleetsfleetidmembersmemberid.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, memberId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutMember", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMember indicates an expected call of PutMember.
+func (mr *MockFleetsMockRecorder) PutMember(ctx, fleetId, memberId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, memberId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMember", reflect.TypeOf((*MockFleets)(nil).PutMember), varargs...)
+}
+
+// PutSquad mocks base method.
+func (m *MockFleets) PutSquad(ctx context.Context, fleetId, squadId int64, input *putfleetsfleetidsquadssquadid.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, squadId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutSquad", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSquad indicates an expected call of PutSquad.
+func (mr *MockFleetsMockRecorder) PutSquad(ctx, fleetId, squadId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, squadId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSquad", reflect.TypeOf((*MockFleets)(nil).PutSquad), varargs...)
+}
+
+// PutWing mocks base method.
+func (m *MockFleets) PutWing(ctx context.Context, fleetId, wingId int64, input *putfleetsfleetidwingswingid.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId, wingId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutWing", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWing indicates an expected call of PutWing.
+func (mr *MockFleetsMockRecorder) PutWing(ctx, fleetId, wingId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId, wingId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWing", reflect.TypeOf((*MockFleets)(nil).PutWing), varargs...)
+}
+
+// Wings mocks base method.
+func (m *MockFleets) Wings(ctx context.Context, fleetId int64, opts ...request.RequestOption) (*request.Response[[]*getfleetsfleetidwings.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fleetId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Wings", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getfleetsfleetidwings.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wings indicates an expected call of Wings.
+func (mr *MockFleetsMockRecorder) Wings(ctx, fleetId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fleetId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wings", reflect.TypeOf((*MockFleets)(nil).Wings), varargs...)
+}
+
+// MockFreelanceJobs is a mock of FreelanceJobs interface.
+type MockFreelanceJobs struct {
+	ctrl     *gomock.Controller
+	recorder *MockFreelanceJobsMockRecorder
+	isgomock struct{}
+}
+
+// MockFreelanceJobsMockRecorder is the mock recorder for MockFreelanceJobs.
+type MockFreelanceJobsMockRecorder struct {
+	mock *MockFreelanceJobs
+}
+
+// NewMockFreelanceJobs creates a new mock instance.
+func NewMockFreelanceJobs(ctrl *gomock.Controller) *MockFreelanceJobs {
+	mock := &MockFreelanceJobs{ctrl: ctrl}
+	mock.recorder = &MockFreelanceJobsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreelanceJobs) EXPECT() *MockFreelanceJobsMockRecorder {
+	return m.recorder
+}
+
+// FreelanceJob mocks base method.
+func (m *MockFreelanceJobs) FreelanceJob(ctx context.Context, jobId uuid.UUID, opts ...request.RequestOption) (*request.Response[*getfreelancejobsdetail.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, jobId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreelanceJob", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getfreelancejobsdetail.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreelanceJob indicates an expected call of FreelanceJob.
+func (mr *MockFreelanceJobsMockRecorder) FreelanceJob(ctx, jobId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, jobId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreelanceJob", reflect.TypeOf((*MockFreelanceJobs)(nil).FreelanceJob), varargs...)
+}
+
+// FreelanceJobs mocks base method.
+func (m *MockFreelanceJobs) FreelanceJobs(ctx context.Context, input *getfreelancejobslisting.Input, opts ...request.RequestOption) (*request.Response[*getfreelancejobslisting.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreelanceJobs", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getfreelancejobslisting.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreelanceJobs indicates an expected call of FreelanceJobs.
+func (mr *MockFreelanceJobsMockRecorder) FreelanceJobs(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreelanceJobs", reflect.TypeOf((*MockFreelanceJobs)(nil).FreelanceJobs), varargs...)
+}
+
+// MockFw is a mock of Fw interface.
+type MockFw struct {
+	ctrl     *gomock.Controller
+	recorder *MockFwMockRecorder
+	isgomock struct{}
+}
+
+// MockFwMockRecorder is the mock recorder for MockFw.
+type MockFwMockRecorder struct {
+	mock *MockFw
+}
+
+// NewMockFw creates a new mock instance.
+func NewMockFw(ctrl *gomock.Controller) *MockFw {
+	mock := &MockFw{ctrl: ctrl}
+	mock.recorder = &MockFwMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFw) EXPECT() *MockFwMockRecorder {
+	return m.recorder
+}
+
+// Leaderboards mocks base method.
+func (m *MockFw) Leaderboards(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getfwleaderboards.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Leaderboards", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getfwleaderboards.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Leaderboards indicates an expected call of Leaderboards.
+func (mr *MockFwMockRecorder) Leaderboards(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leaderboards", reflect.TypeOf((*MockFw)(nil).Leaderboards), varargs...)
+}
+
+// LeaderboardsCharacters mocks base method.
+func (m *MockFw) LeaderboardsCharacters(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getfwleaderboardscharacters.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaderboardsCharacters", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getfwleaderboardscharacters.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaderboardsCharacters indicates an expected call of LeaderboardsCharacters.
+func (mr *MockFwMockRecorder) LeaderboardsCharacters(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaderboardsCharacters", reflect.TypeOf((*MockFw)(nil).LeaderboardsCharacters), varargs...)
+}
+
+// LeaderboardsCorporations mocks base method.
+func (m *MockFw) LeaderboardsCorporations(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getfwleaderboardscorporations.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaderboardsCorporations", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getfwleaderboardscorporations.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaderboardsCorporations indicates an expected call of LeaderboardsCorporations.
+func (mr *MockFwMockRecorder) LeaderboardsCorporations(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaderboardsCorporations", reflect.TypeOf((*MockFw)(nil).LeaderboardsCorporations), varargs...)
+}
+
+// Stats mocks base method.
+func (m *MockFw) Stats(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getfwstats.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stats", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getfwstats.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockFwMockRecorder) Stats(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockFw)(nil).Stats), varargs...)
+}
+
+// Systems mocks base method.
+func (m *MockFw) Systems(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getfwsystems.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Systems", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getfwsystems.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Systems indicates an expected call of Systems.
+func (mr *MockFwMockRecorder) Systems(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Systems", reflect.TypeOf((*MockFw)(nil).Systems), varargs...)
+}
+
+// Wars mocks base method.
+func (m *MockFw) Wars(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getfwwars.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Wars", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getfwwars.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wars indicates an expected call of Wars.
+func (mr *MockFwMockRecorder) Wars(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wars", reflect.TypeOf((*MockFw)(nil).Wars), varargs...)
+}
+
+// MockIncursions is a mock of Incursions interface.
+type MockIncursions struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncursionsMockRecorder
+	isgomock struct{}
+}
+
+// MockIncursionsMockRecorder is the mock recorder for MockIncursions.
+type MockIncursionsMockRecorder struct {
+	mock *MockIncursions
+}
+
+// NewMockIncursions creates a new mock instance.
+func NewMockIncursions(ctrl *gomock.Controller) *MockIncursions {
+	mock := &MockIncursions{ctrl: ctrl}
+	mock.recorder = &MockIncursionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncursions) EXPECT() *MockIncursionsMockRecorder {
+	return m.recorder
+}
+
+// Incursions mocks base method.
+func (m *MockIncursions) Incursions(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getincursions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Incursions", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getincursions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incursions indicates an expected call of Incursions.
+func (mr *MockIncursionsMockRecorder) Incursions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incursions", reflect.TypeOf((*MockIncursions)(nil).Incursions), varargs...)
+}
+
+// MockIndustry is a mock of Industry interface.
+type MockIndustry struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndustryMockRecorder
+	isgomock struct{}
+}
+
+// MockIndustryMockRecorder is the mock recorder for MockIndustry.
+type MockIndustryMockRecorder struct {
+	mock *MockIndustry
+}
+
+// NewMockIndustry creates a new mock instance.
+func NewMockIndustry(ctrl *gomock.Controller) *MockIndustry {
+	mock := &MockIndustry{ctrl: ctrl}
+	mock.recorder = &MockIndustryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndustry) EXPECT() *MockIndustryMockRecorder {
+	return m.recorder
+}
+
+// Facilities mocks base method.
+func (m *MockIndustry) Facilities(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getindustryfacilities.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Facilities", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getindustryfacilities.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Facilities indicates an expected call of Facilities.
+func (mr *MockIndustryMockRecorder) Facilities(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Facilities", reflect.TypeOf((*MockIndustry)(nil).Facilities), varargs...)
+}
+
+// Systems mocks base method.
+func (m *MockIndustry) Systems(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getindustrysystems.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Systems", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getindustrysystems.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Systems indicates an expected call of Systems.
+func (mr *MockIndustryMockRecorder) Systems(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Systems", reflect.TypeOf((*MockIndustry)(nil).Systems), varargs...)
+}
+
+// MockInsurance is a mock of Insurance interface.
+type MockInsurance struct {
+	ctrl     *gomock.Controller
+	recorder *MockInsuranceMockRecorder
+	isgomock struct{}
+}
+
+// MockInsuranceMockRecorder is the mock recorder for MockInsurance.
+type MockInsuranceMockRecorder struct {
+	mock *MockInsurance
+}
+
+// NewMockInsurance creates a new mock instance.
+func NewMockInsurance(ctrl *gomock.Controller) *MockInsurance {
+	mock := &MockInsurance{ctrl: ctrl}
+	mock.recorder = &MockInsuranceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInsurance) EXPECT() *MockInsuranceMockRecorder {
+	return m.recorder
+}
+
+// Prices mocks base method.
+func (m *MockInsurance) Prices(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getinsuranceprices.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Prices", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getinsuranceprices.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prices indicates an expected call of Prices.
+func (mr *MockInsuranceMockRecorder) Prices(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prices", reflect.TypeOf((*MockInsurance)(nil).Prices), varargs...)
+}
+
+// MockKillmails is a mock of Killmails interface.
+type MockKillmails struct {
+	ctrl     *gomock.Controller
+	recorder *MockKillmailsMockRecorder
+	isgomock struct{}
+}
+
+// MockKillmailsMockRecorder is the mock recorder for MockKillmails.
+type MockKillmailsMockRecorder struct {
+	mock *MockKillmails
+}
+
+// NewMockKillmails creates a new mock instance.
+func NewMockKillmails(ctrl *gomock.Controller) *MockKillmails {
+	mock := &MockKillmails{ctrl: ctrl}
+	mock.recorder = &MockKillmailsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKillmails) EXPECT() *MockKillmailsMockRecorder {
+	return m.recorder
+}
+
+// Killmail mocks base method.
+func (m *MockKillmails) Killmail(ctx context.Context, killmailId int64, killmailHash string, opts ...request.RequestOption) (*request.Response[*getkillmailskillmailidkillmailhash.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, killmailId, killmailHash}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Killmail", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getkillmailskillmailidkillmailhash.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Killmail indicates an expected call of Killmail.
+func (mr *MockKillmailsMockRecorder) Killmail(ctx, killmailId, killmailHash any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, killmailId, killmailHash}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Killmail", reflect.TypeOf((*MockKillmails)(nil).Killmail), varargs...)
+}
+
+// MockLoyalty is a mock of Loyalty interface.
+type MockLoyalty struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyMockRecorder is the mock recorder for MockLoyalty.
+type MockLoyaltyMockRecorder struct {
+	mock *MockLoyalty
+}
+
+// NewMockLoyalty creates a new mock instance.
+func NewMockLoyalty(ctrl *gomock.Controller) *MockLoyalty {
+	mock := &MockLoyalty{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyalty) EXPECT() *MockLoyaltyMockRecorder {
+	return m.recorder
+}
+
+// StoresCorporationOffers mocks base method.
+func (m *MockLoyalty) StoresCorporationOffers(ctx context.Context, corporationID corporation.Identifier, opts ...request.RequestOption) (*request.Response[[]*getloyaltystorescorporationidoffers.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, corporationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StoresCorporationOffers", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getloyaltystorescorporationidoffers.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoresCorporationOffers indicates an expected call of StoresCorporationOffers.
+func (mr *MockLoyaltyMockRecorder) StoresCorporationOffers(ctx, corporationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, corporationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoresCorporationOffers", reflect.TypeOf((*MockLoyalty)(nil).StoresCorporationOffers), varargs...)
+}
+
+// MockMarkets is a mock of Markets interface.
+type MockMarkets struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarketsMockRecorder
+	isgomock struct{}
+}
+
+// MockMarketsMockRecorder is the mock recorder for MockMarkets.
+type MockMarketsMockRecorder struct {
+	mock *MockMarkets
+}
+
+// NewMockMarkets creates a new mock instance.
+func NewMockMarkets(ctrl *gomock.Controller) *MockMarkets {
+	mock := &MockMarkets{ctrl: ctrl}
+	mock.recorder = &MockMarketsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkets) EXPECT() *MockMarketsMockRecorder {
+	return m.recorder
+}
+
+// Groups mocks base method.
+func (m *MockMarkets) Groups(ctx context.Context, opts ...request.RequestOption) (*request.Response[getmarketsgroups.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Groups", varargs...)
+	ret0, _ := ret[0].(*request.Response[getmarketsgroups.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Groups indicates an expected call of Groups.
+func (mr *MockMarketsMockRecorder) Groups(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockMarkets)(nil).Groups), varargs...)
+}
+
+// MarketGroup mocks base method.
+func (m *MockMarkets) MarketGroup(ctx context.Context, marketGroupId int64, opts ...request.RequestOption) (*request.Response[*getmarketsgroupsmarketgroupid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, marketGroupId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarketGroup", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getmarketsgroupsmarketgroupid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarketGroup indicates an expected call of MarketGroup.
+func (mr *MockMarketsMockRecorder) MarketGroup(ctx, marketGroupId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, marketGroupId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarketGroup", reflect.TypeOf((*MockMarkets)(nil).MarketGroup), varargs...)
+}
+
+// Prices mocks base method.
+func (m *MockMarkets) Prices(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getmarketsprices.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Prices", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getmarketsprices.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prices indicates an expected call of Prices.
+func (mr *MockMarketsMockRecorder) Prices(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prices", reflect.TypeOf((*MockMarkets)(nil).Prices), varargs...)
+}
+
+// RegionHistory mocks base method.
+func (m *MockMarkets) RegionHistory(ctx context.Context, regionId int64, input *getmarketsregionidhistory.Input, opts ...request.RequestOption) (*request.Response[[]*getmarketsregionidhistory.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, regionId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegionHistory", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getmarketsregionidhistory.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionHistory indicates an expected call of RegionHistory.
+func (mr *MockMarketsMockRecorder) RegionHistory(ctx, regionId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, regionId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionHistory", reflect.TypeOf((*MockMarkets)(nil).RegionHistory), varargs...)
+}
+
+// RegionOrders mocks base method.
+func (m *MockMarkets) RegionOrders(ctx context.Context, regionId int64, input *getmarketsregionidorders.Input, opts ...request.RequestOption) (*request.Response[[]*getmarketsregionidorders.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, regionId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegionOrders", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getmarketsregionidorders.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionOrders indicates an expected call of RegionOrders.
+func (mr *MockMarketsMockRecorder) RegionOrders(ctx, regionId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, regionId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionOrders", reflect.TypeOf((*MockMarkets)(nil).RegionOrders), varargs...)
+}
+
+// RegionTypes mocks base method.
+func (m *MockMarkets) RegionTypes(ctx context.Context, regionId int64, input *getmarketsregionidtypes.Input, opts ...request.RequestOption) (*request.Response[getmarketsregionidtypes.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, regionId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegionTypes", varargs...)
+	ret0, _ := ret[0].(*request.Response[getmarketsregionidtypes.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionTypes indicates an expected call of RegionTypes.
+func (mr *MockMarketsMockRecorder) RegionTypes(ctx, regionId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, regionId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionTypes", reflect.TypeOf((*MockMarkets)(nil).RegionTypes), varargs...)
+}
+
+// Structure mocks base method.
+func (m *MockMarkets) Structure(ctx context.Context, structureId int64, input *getmarketsstructuresstructureid.Input, opts ...request.RequestOption) (*request.Response[[]*getmarketsstructuresstructureid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, structureId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Structure", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getmarketsstructuresstructureid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Structure indicates an expected call of Structure.
+func (mr *MockMarketsMockRecorder) Structure(ctx, structureId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, structureId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Structure", reflect.TypeOf((*MockMarkets)(nil).Structure), varargs...)
+}
+
+// MockMeta is a mock of Meta interface.
+type MockMeta struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetaMockRecorder
+	isgomock struct{}
+}
+
+// MockMetaMockRecorder is the mock recorder for MockMeta.
+type MockMetaMockRecorder struct {
+	mock *MockMeta
+}
+
+// NewMockMeta creates a new mock instance.
+func NewMockMeta(ctrl *gomock.Controller) *MockMeta {
+	mock := &MockMeta{ctrl: ctrl}
+	mock.recorder = &MockMetaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMeta) EXPECT() *MockMetaMockRecorder {
+	return m.recorder
+}
+
+// Changelog mocks base method.
+func (m *MockMeta) Changelog(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getmetachangelog.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Changelog", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getmetachangelog.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Changelog indicates an expected call of Changelog.
+func (mr *MockMetaMockRecorder) Changelog(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Changelog", reflect.TypeOf((*MockMeta)(nil).Changelog), varargs...)
+}
+
+// CompatibilityDates mocks base method.
+func (m *MockMeta) CompatibilityDates(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getmetacompatibilitydates.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompatibilityDates", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getmetacompatibilitydates.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompatibilityDates indicates an expected call of CompatibilityDates.
+func (mr *MockMetaMockRecorder) CompatibilityDates(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompatibilityDates", reflect.TypeOf((*MockMeta)(nil).CompatibilityDates), varargs...)
+}
+
+// Name mocks base method.
+func (m *MockMeta) Name(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getmetaname.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Name", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getmetaname.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockMetaMockRecorder) Name(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockMeta)(nil).Name), varargs...)
+}
+
+// Status mocks base method.
+func (m *MockMeta) Status(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getmetastatus.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Status", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getmetastatus.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockMetaMockRecorder) Status(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockMeta)(nil).Status), varargs...)
+}
+
+// MockRoute is a mock of Route interface.
+type MockRoute struct {
+	ctrl     *gomock.Controller
+	recorder *MockRouteMockRecorder
+	isgomock struct{}
+}
+
+// MockRouteMockRecorder is the mock recorder for MockRoute.
+type MockRouteMockRecorder struct {
+	mock *MockRoute
+}
+
+// NewMockRoute creates a new mock instance.
+func NewMockRoute(ctrl *gomock.Controller) *MockRoute {
+	mock := &MockRoute{ctrl: ctrl}
+	mock.recorder = &MockRouteMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoute) EXPECT() *MockRouteMockRecorder {
+	return m.recorder
+}
+
+// PostRoute mocks base method.
+func (m *MockRoute) PostRoute(ctx context.Context, originSystem, destinationSystem solarsystem.Identifier, input *postroute.Input, opts ...request.RequestOption) (*request.Response[*postroute.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, originSystem, destinationSystem, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostRoute", varargs...)
+	ret0, _ := ret[0].(*request.Response[*postroute.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRoute indicates an expected call of PostRoute.
+func (mr *MockRouteMockRecorder) PostRoute(ctx, originSystem, destinationSystem, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, originSystem, destinationSystem, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRoute", reflect.TypeOf((*MockRoute)(nil).PostRoute), varargs...)
+}
+
+// MockSkyhooks is a mock of Skyhooks interface.
+type MockSkyhooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkyhooksMockRecorder
+	isgomock struct{}
+}
+
+// MockSkyhooksMockRecorder is the mock recorder for MockSkyhooks.
+type MockSkyhooksMockRecorder struct {
+	mock *MockSkyhooks
+}
+
+// NewMockSkyhooks creates a new mock instance.
+func NewMockSkyhooks(ctrl *gomock.Controller) *MockSkyhooks {
+	mock := &MockSkyhooks{ctrl: ctrl}
+	mock.recorder = &MockSkyhooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkyhooks) EXPECT() *MockSkyhooksMockRecorder {
+	return m.recorder
+}
+
+// Raidable mocks base method.
+func (m *MockSkyhooks) Raidable(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getskyhooksraidable.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Raidable", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getskyhooksraidable.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Raidable indicates an expected call of Raidable.
+func (mr *MockSkyhooksMockRecorder) Raidable(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Raidable", reflect.TypeOf((*MockSkyhooks)(nil).Raidable), varargs...)
+}
+
+// MockSovereignty is a mock of Sovereignty interface.
+type MockSovereignty struct {
+	ctrl     *gomock.Controller
+	recorder *MockSovereigntyMockRecorder
+	isgomock struct{}
+}
+
+// MockSovereigntyMockRecorder is the mock recorder for MockSovereignty.
+type MockSovereigntyMockRecorder struct {
+	mock *MockSovereignty
+}
+
+// NewMockSovereignty creates a new mock instance.
+func NewMockSovereignty(ctrl *gomock.Controller) *MockSovereignty {
+	mock := &MockSovereignty{ctrl: ctrl}
+	mock.recorder = &MockSovereigntyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSovereignty) EXPECT() *MockSovereigntyMockRecorder {
+	return m.recorder
+}
+
+// Campaigns mocks base method.
+func (m *MockSovereignty) Campaigns(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getsovereigntycampaigns.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Campaigns", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getsovereigntycampaigns.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Campaigns indicates an expected call of Campaigns.
+func (mr *MockSovereigntyMockRecorder) Campaigns(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Campaigns", reflect.TypeOf((*MockSovereignty)(nil).Campaigns), varargs...)
+}
+
+// Map mocks base method.
+func (m *MockSovereignty) Map(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getsovereigntymap.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Map", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getsovereigntymap.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Map indicates an expected call of Map.
+func (mr *MockSovereigntyMockRecorder) Map(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockSovereignty)(nil).Map), varargs...)
+}
+
+// Structures mocks base method.
+func (m *MockSovereignty) Structures(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getsovereigntystructures.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Structures", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getsovereigntystructures.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Structures indicates an expected call of Structures.
+func (mr *MockSovereigntyMockRecorder) Structures(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Structures", reflect.TypeOf((*MockSovereignty)(nil).Structures), varargs...)
+}
+
+// Systems mocks base method.
+func (m *MockSovereignty) Systems(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getsovereigntysystems.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Systems", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getsovereigntysystems.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Systems indicates an expected call of Systems.
+func (mr *MockSovereigntyMockRecorder) Systems(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Systems", reflect.TypeOf((*MockSovereignty)(nil).Systems), varargs...)
+}
+
+// MockStatus is a mock of Status interface.
+type MockStatus struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusMockRecorder is the mock recorder for MockStatus.
+type MockStatusMockRecorder struct {
+	mock *MockStatus
+}
+
+// NewMockStatus creates a new mock instance.
+func NewMockStatus(ctrl *gomock.Controller) *MockStatus {
+	mock := &MockStatus{ctrl: ctrl}
+	mock.recorder = &MockStatusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatus) EXPECT() *MockStatusMockRecorder {
+	return m.recorder
+}
+
+// Status mocks base method.
+func (m *MockStatus) Status(ctx context.Context, opts ...request.RequestOption) (*request.Response[*getstatus.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Status", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getstatus.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockStatusMockRecorder) Status(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockStatus)(nil).Status), varargs...)
+}
+
+// MockUi is a mock of Ui interface.
+type MockUi struct {
+	ctrl     *gomock.Controller
+	recorder *MockUiMockRecorder
+	isgomock struct{}
+}
+
+// MockUiMockRecorder is the mock recorder for MockUi.
+type MockUiMockRecorder struct {
+	mock *MockUi
+}
+
+// NewMockUi creates a new mock instance.
+func NewMockUi(ctrl *gomock.Controller) *MockUi {
+	mock := &MockUi{ctrl: ctrl}
+	mock.recorder = &MockUiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUi) EXPECT() *MockUiMockRecorder {
+	return m.recorder
+}
+
+// PostAutopilotWaypoint mocks base method.
+func (m *MockUi) PostAutopilotWaypoint(ctx context.Context, input *postuiautopilotwaypoint.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostAutopilotWaypoint", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostAutopilotWaypoint indicates an expected call of PostAutopilotWaypoint.
+func (mr *MockUiMockRecorder) PostAutopilotWaypoint(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAutopilotWaypoint", reflect.TypeOf((*MockUi)(nil).PostAutopilotWaypoint), varargs...)
+}
+
+// PostOpenwindowContract mocks base method.
+func (m *MockUi) PostOpenwindowContract(ctx context.Context, input *postuiopenwindowcontract.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostOpenwindowContract", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostOpenwindowContract indicates an expected call of PostOpenwindowContract.
+func (mr *MockUiMockRecorder) PostOpenwindowContract(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOpenwindowContract", reflect.TypeOf((*MockUi)(nil).PostOpenwindowContract), varargs...)
+}
+
+// PostOpenwindowInformation mocks base method.
+func (m *MockUi) PostOpenwindowInformation(ctx context.Context, input *postuiopenwindowinformation.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostOpenwindowInformation", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostOpenwindowInformation indicates an expected call of PostOpenwindowInformation.
+func (mr *MockUiMockRecorder) PostOpenwindowInformation(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOpenwindowInformation", reflect.TypeOf((*MockUi)(nil).PostOpenwindowInformation), varargs...)
+}
+
+// PostOpenwindowMarketdetails mocks base method.
+func (m *MockUi) PostOpenwindowMarketdetails(ctx context.Context, input *postuiopenwindowmarketdetails.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostOpenwindowMarketdetails", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostOpenwindowMarketdetails indicates an expected call of PostOpenwindowMarketdetails.
+func (mr *MockUiMockRecorder) PostOpenwindowMarketdetails(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOpenwindowMarketdetails", reflect.TypeOf((*MockUi)(nil).PostOpenwindowMarketdetails), varargs...)
+}
+
+// PostOpenwindowNewmail mocks base method.
+func (m *MockUi) PostOpenwindowNewmail(ctx context.Context, input *postuiopenwindownewmail.Input, opts ...request.RequestOption) (*request.Response[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostOpenwindowNewmail", varargs...)
+	ret0, _ := ret[0].(*request.Response[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostOpenwindowNewmail indicates an expected call of PostOpenwindowNewmail.
+func (mr *MockUiMockRecorder) PostOpenwindowNewmail(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOpenwindowNewmail", reflect.TypeOf((*MockUi)(nil).PostOpenwindowNewmail), varargs...)
+}
+
+// MockUniverse is a mock of Universe interface.
+type MockUniverse struct {
+	ctrl     *gomock.Controller
+	recorder *MockUniverseMockRecorder
+	isgomock struct{}
+}
+
+// MockUniverseMockRecorder is the mock recorder for MockUniverse.
+type MockUniverseMockRecorder struct {
+	mock *MockUniverse
+}
+
+// NewMockUniverse creates a new mock instance.
+func NewMockUniverse(ctrl *gomock.Controller) *MockUniverse {
+	mock := &MockUniverse{ctrl: ctrl}
+	mock.recorder = &MockUniverseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUniverse) EXPECT() *MockUniverseMockRecorder {
+	return m.recorder
+}
+
+// Ancestries mocks base method.
+func (m *MockUniverse) Ancestries(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getuniverseancestries.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Ancestries", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getuniverseancestries.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ancestries indicates an expected call of Ancestries.
+func (mr *MockUniverseMockRecorder) Ancestries(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ancestries", reflect.TypeOf((*MockUniverse)(nil).Ancestries), varargs...)
+}
+
+// AsteroidBelt mocks base method.
+func (m *MockUniverse) AsteroidBelt(ctx context.Context, asteroidBeltId int64, opts ...request.RequestOption) (*request.Response[*getuniverseasteroidbeltsasteroidbeltid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, asteroidBeltId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AsteroidBelt", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniverseasteroidbeltsasteroidbeltid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AsteroidBelt indicates an expected call of AsteroidBelt.
+func (mr *MockUniverseMockRecorder) AsteroidBelt(ctx, asteroidBeltId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, asteroidBeltId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsteroidBelt", reflect.TypeOf((*MockUniverse)(nil).AsteroidBelt), varargs...)
+}
+
+// Bloodlines mocks base method.
+func (m *MockUniverse) Bloodlines(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getuniversebloodlines.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Bloodlines", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getuniversebloodlines.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bloodlines indicates an expected call of Bloodlines.
+func (mr *MockUniverseMockRecorder) Bloodlines(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bloodlines", reflect.TypeOf((*MockUniverse)(nil).Bloodlines), varargs...)
+}
+
+// Categories mocks base method.
+func (m *MockUniverse) Categories(ctx context.Context, opts ...request.RequestOption) (*request.Response[getuniversecategories.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Categories", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniversecategories.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Categories indicates an expected call of Categories.
+func (mr *MockUniverseMockRecorder) Categories(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockUniverse)(nil).Categories), varargs...)
+}
+
+// Category mocks base method.
+func (m *MockUniverse) Category(ctx context.Context, categoryId int64, opts ...request.RequestOption) (*request.Response[*getuniversecategoriescategoryid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, categoryId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Category", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversecategoriescategoryid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Category indicates an expected call of Category.
+func (mr *MockUniverseMockRecorder) Category(ctx, categoryId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, categoryId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Category", reflect.TypeOf((*MockUniverse)(nil).Category), varargs...)
+}
+
+// Constellation mocks base method.
+func (m *MockUniverse) Constellation(ctx context.Context, constellationId int64, opts ...request.RequestOption) (*request.Response[*getuniverseconstellationsconstellationid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, constellationId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Constellation", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniverseconstellationsconstellationid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Constellation indicates an expected call of Constellation.
+func (mr *MockUniverseMockRecorder) Constellation(ctx, constellationId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, constellationId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Constellation", reflect.TypeOf((*MockUniverse)(nil).Constellation), varargs...)
+}
+
+// Constellations mocks base method.
+func (m *MockUniverse) Constellations(ctx context.Context, opts ...request.RequestOption) (*request.Response[getuniverseconstellations.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Constellations", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniverseconstellations.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Constellations indicates an expected call of Constellations.
+func (mr *MockUniverseMockRecorder) Constellations(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Constellations", reflect.TypeOf((*MockUniverse)(nil).Constellations), varargs...)
+}
+
+// Factions mocks base method.
+func (m *MockUniverse) Factions(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getuniversefactions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Factions", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getuniversefactions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Factions indicates an expected call of Factions.
+func (mr *MockUniverseMockRecorder) Factions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Factions", reflect.TypeOf((*MockUniverse)(nil).Factions), varargs...)
+}
+
+// Graphic mocks base method.
+func (m *MockUniverse) Graphic(ctx context.Context, graphicId int64, opts ...request.RequestOption) (*request.Response[*getuniversegraphicsgraphicid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, graphicId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Graphic", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversegraphicsgraphicid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Graphic indicates an expected call of Graphic.
+func (mr *MockUniverseMockRecorder) Graphic(ctx, graphicId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, graphicId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Graphic", reflect.TypeOf((*MockUniverse)(nil).Graphic), varargs...)
+}
+
+// Graphics mocks base method.
+func (m *MockUniverse) Graphics(ctx context.Context, opts ...request.RequestOption) (*request.Response[getuniversegraphics.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Graphics", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniversegraphics.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Graphics indicates an expected call of Graphics.
+func (mr *MockUniverseMockRecorder) Graphics(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Graphics", reflect.TypeOf((*MockUniverse)(nil).Graphics), varargs...)
+}
+
+// Group mocks base method.
+func (m *MockUniverse) Group(ctx context.Context, groupId int64, opts ...request.RequestOption) (*request.Response[*getuniversegroupsgroupid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, groupId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Group", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversegroupsgroupid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Group indicates an expected call of Group.
+func (mr *MockUniverseMockRecorder) Group(ctx, groupId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, groupId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockUniverse)(nil).Group), varargs...)
+}
+
+// Groups mocks base method.
+func (m *MockUniverse) Groups(ctx context.Context, input *getuniversegroups.Input, opts ...request.RequestOption) (*request.Response[getuniversegroups.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Groups", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniversegroups.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Groups indicates an expected call of Groups.
+func (mr *MockUniverseMockRecorder) Groups(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockUniverse)(nil).Groups), varargs...)
+}
+
+// Moon mocks base method.
+func (m *MockUniverse) Moon(ctx context.Context, moonId int64, opts ...request.RequestOption) (*request.Response[*getuniversemoonsmoonid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, moonId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Moon", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversemoonsmoonid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Moon indicates an expected call of Moon.
+func (mr *MockUniverseMockRecorder) Moon(ctx, moonId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, moonId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moon", reflect.TypeOf((*MockUniverse)(nil).Moon), varargs...)
+}
+
+// Planet mocks base method.
+func (m *MockUniverse) Planet(ctx context.Context, planetId int64, opts ...request.RequestOption) (*request.Response[*getuniverseplanetsplanetid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, planetId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Planet", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniverseplanetsplanetid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Planet indicates an expected call of Planet.
+func (mr *MockUniverseMockRecorder) Planet(ctx, planetId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, planetId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Planet", reflect.TypeOf((*MockUniverse)(nil).Planet), varargs...)
+}
+
+// PostIds mocks base method.
+func (m *MockUniverse) PostIds(ctx context.Context, input *postuniverseids.Input, opts ...request.RequestOption) (*request.Response[*postuniverseids.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostIds", varargs...)
+	ret0, _ := ret[0].(*request.Response[*postuniverseids.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostIds indicates an expected call of PostIds.
+func (mr *MockUniverseMockRecorder) PostIds(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostIds", reflect.TypeOf((*MockUniverse)(nil).PostIds), varargs...)
+}
+
+// PostNames mocks base method.
+func (m *MockUniverse) PostNames(ctx context.Context, input *postuniversenames.Input, opts ...request.RequestOption) (*request.Response[[]*postuniversenames.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostNames", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*postuniversenames.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostNames indicates an expected call of PostNames.
+func (mr *MockUniverseMockRecorder) PostNames(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostNames", reflect.TypeOf((*MockUniverse)(nil).PostNames), varargs...)
+}
+
+// Races mocks base method.
+func (m *MockUniverse) Races(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getuniverseraces.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Races", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getuniverseraces.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Races indicates an expected call of Races.
+func (mr *MockUniverseMockRecorder) Races(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Races", reflect.TypeOf((*MockUniverse)(nil).Races), varargs...)
+}
+
+// Region mocks base method.
+func (m *MockUniverse) Region(ctx context.Context, regionId int64, opts ...request.RequestOption) (*request.Response[*getuniverseregionsregionid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, regionId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Region", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniverseregionsregionid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Region indicates an expected call of Region.
+func (mr *MockUniverseMockRecorder) Region(ctx, regionId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, regionId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Region", reflect.TypeOf((*MockUniverse)(nil).Region), varargs...)
+}
+
+// Regions mocks base method.
+func (m *MockUniverse) Regions(ctx context.Context, opts ...request.RequestOption) (*request.Response[getuniverseregions.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Regions", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniverseregions.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Regions indicates an expected call of Regions.
+func (mr *MockUniverseMockRecorder) Regions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Regions", reflect.TypeOf((*MockUniverse)(nil).Regions), varargs...)
+}
+
+// Schematic mocks base method.
+func (m *MockUniverse) Schematic(ctx context.Context, schematicId int64, opts ...request.RequestOption) (*request.Response[*getuniverseschematicsschematicid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, schematicId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Schematic", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniverseschematicsschematicid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schematic indicates an expected call of Schematic.
+func (mr *MockUniverseMockRecorder) Schematic(ctx, schematicId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, schematicId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schematic", reflect.TypeOf((*MockUniverse)(nil).Schematic), varargs...)
+}
+
+// Star mocks base method.
+func (m *MockUniverse) Star(ctx context.Context, starId int64, opts ...request.RequestOption) (*request.Response[*getuniversestarsstarid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, starId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Star", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversestarsstarid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Star indicates an expected call of Star.
+func (mr *MockUniverseMockRecorder) Star(ctx, starId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, starId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Star", reflect.TypeOf((*MockUniverse)(nil).Star), varargs...)
+}
+
+// Stargate mocks base method.
+func (m *MockUniverse) Stargate(ctx context.Context, stargateId int64, opts ...request.RequestOption) (*request.Response[*getuniversestargatesstargateid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, stargateId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stargate", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversestargatesstargateid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stargate indicates an expected call of Stargate.
+func (mr *MockUniverseMockRecorder) Stargate(ctx, stargateId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, stargateId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stargate", reflect.TypeOf((*MockUniverse)(nil).Stargate), varargs...)
+}
+
+// Station mocks base method.
+func (m *MockUniverse) Station(ctx context.Context, stationId int64, opts ...request.RequestOption) (*request.Response[*getuniversestationsstationid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, stationId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Station", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversestationsstationid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Station indicates an expected call of Station.
+func (mr *MockUniverseMockRecorder) Station(ctx, stationId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, stationId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Station", reflect.TypeOf((*MockUniverse)(nil).Station), varargs...)
+}
+
+// Structure mocks base method.
+func (m *MockUniverse) Structure(ctx context.Context, structureId int64, opts ...request.RequestOption) (*request.Response[*getuniversestructuresstructureid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, structureId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Structure", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversestructuresstructureid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Structure indicates an expected call of Structure.
+func (mr *MockUniverseMockRecorder) Structure(ctx, structureId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, structureId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Structure", reflect.TypeOf((*MockUniverse)(nil).Structure), varargs...)
+}
+
+// Structures mocks base method.
+func (m *MockUniverse) Structures(ctx context.Context, input *getuniversestructures.Input, opts ...request.RequestOption) (*request.Response[getuniversestructures.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Structures", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniversestructures.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Structures indicates an expected call of Structures.
+func (mr *MockUniverseMockRecorder) Structures(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Structures", reflect.TypeOf((*MockUniverse)(nil).Structures), varargs...)
+}
+
+// System mocks base method.
+func (m *MockUniverse) System(ctx context.Context, systemId int64, opts ...request.RequestOption) (*request.Response[*getuniversesystemssystemid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, systemId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "System", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversesystemssystemid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// System indicates an expected call of System.
+func (mr *MockUniverseMockRecorder) System(ctx, systemId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, systemId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "System", reflect.TypeOf((*MockUniverse)(nil).System), varargs...)
+}
+
+// SystemJumps mocks base method.
+func (m *MockUniverse) SystemJumps(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getuniversesystemjumps.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SystemJumps", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getuniversesystemjumps.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SystemJumps indicates an expected call of SystemJumps.
+func (mr *MockUniverseMockRecorder) SystemJumps(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SystemJumps", reflect.TypeOf((*MockUniverse)(nil).SystemJumps), varargs...)
+}
+
+// SystemKills mocks base method.
+func (m *MockUniverse) SystemKills(ctx context.Context, opts ...request.RequestOption) (*request.Response[[]*getuniversesystemkills.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SystemKills", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getuniversesystemkills.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SystemKills indicates an expected call of SystemKills.
+func (mr *MockUniverseMockRecorder) SystemKills(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SystemKills", reflect.TypeOf((*MockUniverse)(nil).SystemKills), varargs...)
+}
+
+// Systems mocks base method.
+func (m *MockUniverse) Systems(ctx context.Context, opts ...request.RequestOption) (*request.Response[getuniversesystems.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Systems", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniversesystems.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Systems indicates an expected call of Systems.
+func (mr *MockUniverseMockRecorder) Systems(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Systems", reflect.TypeOf((*MockUniverse)(nil).Systems), varargs...)
+}
+
+// Type mocks base method.
+func (m *MockUniverse) Type(ctx context.Context, typeId int64, opts ...request.RequestOption) (*request.Response[*getuniversetypestypeid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, typeId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Type", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getuniversetypestypeid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockUniverseMockRecorder) Type(ctx, typeId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, typeId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockUniverse)(nil).Type), varargs...)
+}
+
+// Types mocks base method.
+func (m *MockUniverse) Types(ctx context.Context, input *getuniversetypes.Input, opts ...request.RequestOption) (*request.Response[getuniversetypes.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Types", varargs...)
+	ret0, _ := ret[0].(*request.Response[getuniversetypes.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Types indicates an expected call of Types.
+func (mr *MockUniverseMockRecorder) Types(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Types", reflect.TypeOf((*MockUniverse)(nil).Types), varargs...)
+}
+
+// MockWars is a mock of Wars interface.
+type MockWars struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarsMockRecorder
+	isgomock struct{}
+}
+
+// MockWarsMockRecorder is the mock recorder for MockWars.
+type MockWarsMockRecorder struct {
+	mock *MockWars
+}
+
+// NewMockWars creates a new mock instance.
+func NewMockWars(ctrl *gomock.Controller) *MockWars {
+	mock := &MockWars{ctrl: ctrl}
+	mock.recorder = &MockWarsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWars) EXPECT() *MockWarsMockRecorder {
+	return m.recorder
+}
+
+// Killmails mocks base method.
+func (m *MockWars) Killmails(ctx context.Context, warId int64, input *getwarswaridkillmails.Input, opts ...request.RequestOption) (*request.Response[[]*getwarswaridkillmails.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, warId, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Killmails", varargs...)
+	ret0, _ := ret[0].(*request.Response[[]*getwarswaridkillmails.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Killmails indicates an expected call of Killmails.
+func (mr *MockWarsMockRecorder) Killmails(ctx, warId, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, warId, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Killmails", reflect.TypeOf((*MockWars)(nil).Killmails), varargs...)
+}
+
+// War mocks base method.
+func (m *MockWars) War(ctx context.Context, warId int64, opts ...request.RequestOption) (*request.Response[*getwarswarid.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, warId}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "War", varargs...)
+	ret0, _ := ret[0].(*request.Response[*getwarswarid.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// War indicates an expected call of War.
+func (mr *MockWarsMockRecorder) War(ctx, warId any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, warId}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "War", reflect.TypeOf((*MockWars)(nil).War), varargs...)
+}
+
+// Wars mocks base method.
+func (m *MockWars) Wars(ctx context.Context, input *getwars.Input, opts ...request.RequestOption) (*request.Response[getwars.Output], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Wars", varargs...)
+	ret0, _ := ret[0].(*request.Response[getwars.Output])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wars indicates an expected call of Wars.
+func (mr *MockWarsMockRecorder) Wars(ctx, input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wars", reflect.TypeOf((*MockWars)(nil).Wars), varargs...)
+}
@@ -13,3 +13,9 @@ var Request = request.Create[Input, struct{}](
 	"/characters/{character_id}/fittings/{fitting_id}",
 	request.WithRequiredScope("esi-fittings.write_fittings.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, struct{}]{
+	Method: http.MethodDelete,
+	Path:   "/characters/{character_id}/fittings/{fitting_id}",
+}
@@ -1,3 +1,3 @@
 package esi
 
-//go:generate go run -mod=mod github.com/xaroth/lib-esi-go/cmd/generate-request -out . LIBRARY ALL_PATHS
+//go:generate go run -mod=mod github.com/xaroth/lib-esi-go/cmd/generate-request -out . -esitest -client -prune LIBRARY ALL_PATHS
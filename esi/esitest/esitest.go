@@ -0,0 +1,1134 @@
+// Code generated by cmd/generate-request; DO NOT EDIT.
+
+// Package esitest serves every generated ESI operation from a fake server, for tests that should not
+// reach the live API. Requests are validated against the spec, and answered with the responses registered
+// with On, or with examples synthesized from the spec.
+package esitest
+
+import (
+	"net/http"
+
+	"github.com/xaroth/lib-esi-go/esi"
+	"github.com/xaroth/lib-esi-go/fakeesi"
+
+	"github.com/xaroth/lib-esi-go/esi/deletecharacterscharacteridcontacts"
+	"github.com/xaroth/lib-esi-go/esi/deletecharacterscharacteridfittingsfittingid"
+	"github.com/xaroth/lib-esi-go/esi/deletecharacterscharacteridmaillabelslabelid"
+	"github.com/xaroth/lib-esi-go/esi/deletecharacterscharacteridmailmailid"
+	"github.com/xaroth/lib-esi-go/esi/deletefleetsfleetidmembersmemberid"
+	"github.com/xaroth/lib-esi-go/esi/deletefleetsfleetidsquadssquadid"
+	"github.com/xaroth/lib-esi-go/esi/deletefleetsfleetidwingswingid"
+	"github.com/xaroth/lib-esi-go/esi/getalliances"
+	"github.com/xaroth/lib-esi-go/esi/getalliancesallianceid"
+	"github.com/xaroth/lib-esi-go/esi/getalliancesallianceidcontacts"
+	"github.com/xaroth/lib-esi-go/esi/getalliancesallianceidcontactslabels"
+	"github.com/xaroth/lib-esi-go/esi/getalliancesallianceidcorporations"
+	"github.com/xaroth/lib-esi-go/esi/getalliancesallianceidicons"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersaccesslistsdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersaccesslistslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacterid"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridagentsresearch"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridassets"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridattributes"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridblueprints"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcalendar"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcalendareventid"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcalendareventidattendees"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridclones"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontacts"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontactslabels"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontracts"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontractscontractidbids"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcontractscontractiditems"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridcorporationhistory"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfatigue"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfittings"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfleet"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridfwstats"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridimplants"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridindustryjobs"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridkillmailsrecent"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridlocation"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridloyaltypoints"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmail"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmaillabels"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmaillists"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmailmailid"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmedals"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridmining"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridnotifications"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridnotificationscontacts"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridonline"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridorders"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridordershistory"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridplanets"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridplanetsplanetid"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridportrait"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridroles"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridsearch"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridship"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridskillqueue"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridskills"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridstandings"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridtitles"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwallet"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwalletjournal"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwallettransactions"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersfreelancejobslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersfreelancejobsparticipation"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersmercenarytacticaloperationsdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersmercenarytacticaloperationslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersstructuresmercenarydensdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcharactersstructuresmercenarydenslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcontractspublicbidscontractid"
+	"github.com/xaroth/lib-esi-go/esi/getcontractspublicitemscontractid"
+	"github.com/xaroth/lib-esi-go/esi/getcontractspublicregionid"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationcorporationidminingextractions"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationcorporationidminingobservers"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationcorporationidminingobserversobserverid"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationid"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidalliancehistory"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidassets"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidblueprints"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontacts"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontactslabels"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontainerslogs"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontracts"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontractscontractidbids"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcontractscontractiditems"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidcustomsoffices"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationiddivisions"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidfacilities"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidfwstats"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidicons"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidindustryjobs"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidkillmailsrecent"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmedals"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmedalsissued"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmembers"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmemberslimit"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmemberstitles"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidmembertracking"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidorders"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidordershistory"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidroles"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidroleshistory"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidshareholders"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstandings"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstarbases"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstarbasesstarbaseid"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidstructures"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidtitles"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidwallets"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidwalletsdivisionjournal"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationscorporationidwalletsdivisiontransactions"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsfreelancejobslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsfreelancejobsparticipants"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsnpccorps"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsprojectscontribution"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsprojectscontributors"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsprojectsdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsprojectslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsstructuresskyhooksdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsstructuresskyhookslisting"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsstructuressovereigntyhubsdetail"
+	"github.com/xaroth/lib-esi-go/esi/getcorporationsstructuressovereigntyhubslisting"
+	"github.com/xaroth/lib-esi-go/esi/getdogmaattributes"
+	"github.com/xaroth/lib-esi-go/esi/getdogmaattributesattributeid"
+	"github.com/xaroth/lib-esi-go/esi/getdogmadynamicitemstypeiditemid"
+	"github.com/xaroth/lib-esi-go/esi/getdogmaeffects"
+	"github.com/xaroth/lib-esi-go/esi/getdogmaeffectseffectid"
+	"github.com/xaroth/lib-esi-go/esi/getfleetsfleetid"
+	"github.com/xaroth/lib-esi-go/esi/getfleetsfleetidmembers"
+	"github.com/xaroth/lib-esi-go/esi/getfleetsfleetidwings"
+	"github.com/xaroth/lib-esi-go/esi/getfreelancejobsdetail"
+	"github.com/xaroth/lib-esi-go/esi/getfreelancejobslisting"
+	"github.com/xaroth/lib-esi-go/esi/getfwleaderboards"
+	"github.com/xaroth/lib-esi-go/esi/getfwleaderboardscharacters"
+	"github.com/xaroth/lib-esi-go/esi/getfwleaderboardscorporations"
+	"github.com/xaroth/lib-esi-go/esi/getfwstats"
+	"github.com/xaroth/lib-esi-go/esi/getfwsystems"
+	"github.com/xaroth/lib-esi-go/esi/getfwwars"
+	"github.com/xaroth/lib-esi-go/esi/getincursions"
+	"github.com/xaroth/lib-esi-go/esi/getindustryfacilities"
+	"github.com/xaroth/lib-esi-go/esi/getindustrysystems"
+	"github.com/xaroth/lib-esi-go/esi/getinsuranceprices"
+	"github.com/xaroth/lib-esi-go/esi/getkillmailskillmailidkillmailhash"
+	"github.com/xaroth/lib-esi-go/esi/getloyaltystorescorporationidoffers"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsgroups"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsgroupsmarketgroupid"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsprices"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsregionidhistory"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsregionidorders"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsregionidtypes"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsstructuresstructureid"
+	"github.com/xaroth/lib-esi-go/esi/getmetachangelog"
+	"github.com/xaroth/lib-esi-go/esi/getmetacompatibilitydates"
+	"github.com/xaroth/lib-esi-go/esi/getmetaname"
+	"github.com/xaroth/lib-esi-go/esi/getmetastatus"
+	"github.com/xaroth/lib-esi-go/esi/getskyhooksraidable"
+	"github.com/xaroth/lib-esi-go/esi/getsovereigntycampaigns"
+	"github.com/xaroth/lib-esi-go/esi/getsovereigntymap"
+	"github.com/xaroth/lib-esi-go/esi/getsovereigntystructures"
+	"github.com/xaroth/lib-esi-go/esi/getsovereigntysystems"
+	"github.com/xaroth/lib-esi-go/esi/getstatus"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseancestries"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseasteroidbeltsasteroidbeltid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversebloodlines"
+	"github.com/xaroth/lib-esi-go/esi/getuniversecategories"
+	"github.com/xaroth/lib-esi-go/esi/getuniversecategoriescategoryid"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseconstellations"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseconstellationsconstellationid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversefactions"
+	"github.com/xaroth/lib-esi-go/esi/getuniversegraphics"
+	"github.com/xaroth/lib-esi-go/esi/getuniversegraphicsgraphicid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversegroups"
+	"github.com/xaroth/lib-esi-go/esi/getuniversegroupsgroupid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversemoonsmoonid"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseplanetsplanetid"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseraces"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseregions"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseregionsregionid"
+	"github.com/xaroth/lib-esi-go/esi/getuniverseschematicsschematicid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversestargatesstargateid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversestarsstarid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversestationsstationid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversestructures"
+	"github.com/xaroth/lib-esi-go/esi/getuniversestructuresstructureid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversesystemjumps"
+	"github.com/xaroth/lib-esi-go/esi/getuniversesystemkills"
+	"github.com/xaroth/lib-esi-go/esi/getuniversesystems"
+	"github.com/xaroth/lib-esi-go/esi/getuniversesystemssystemid"
+	"github.com/xaroth/lib-esi-go/esi/getuniversetypes"
+	"github.com/xaroth/lib-esi-go/esi/getuniversetypestypeid"
+	"github.com/xaroth/lib-esi-go/esi/getwars"
+	"github.com/xaroth/lib-esi-go/esi/getwarswarid"
+	"github.com/xaroth/lib-esi-go/esi/getwarswaridkillmails"
+	"github.com/xaroth/lib-esi-go/esi/postcharactersaffiliation"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridassetslocations"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridassetsnames"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridcontacts"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridcspa"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridfittings"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridmail"
+	"github.com/xaroth/lib-esi-go/esi/postcharacterscharacteridmaillabels"
+	"github.com/xaroth/lib-esi-go/esi/postcorporationscorporationidassetslocations"
+	"github.com/xaroth/lib-esi-go/esi/postcorporationscorporationidassetsnames"
+	"github.com/xaroth/lib-esi-go/esi/postfleetsfleetidmembers"
+	"github.com/xaroth/lib-esi-go/esi/postfleetsfleetidwings"
+	"github.com/xaroth/lib-esi-go/esi/postfleetsfleetidwingswingidsquads"
+	"github.com/xaroth/lib-esi-go/esi/postroute"
+	"github.com/xaroth/lib-esi-go/esi/postuiautopilotwaypoint"
+	"github.com/xaroth/lib-esi-go/esi/postuiopenwindowcontract"
+	"github.com/xaroth/lib-esi-go/esi/postuiopenwindowinformation"
+	"github.com/xaroth/lib-esi-go/esi/postuiopenwindowmarketdetails"
+	"github.com/xaroth/lib-esi-go/esi/postuiopenwindownewmail"
+	"github.com/xaroth/lib-esi-go/esi/postuniverseids"
+	"github.com/xaroth/lib-esi-go/esi/postuniversenames"
+	"github.com/xaroth/lib-esi-go/esi/putcharacterscharacteridcalendareventid"
+	"github.com/xaroth/lib-esi-go/esi/putcharacterscharacteridcontacts"
+	"github.com/xaroth/lib-esi-go/esi/putcharacterscharacteridmailmailid"
+	"github.com/xaroth/lib-esi-go/esi/putfleetsfleetid"
+	"github.com/xaroth/lib-esi-go/esi/putfleetsfleetidmembersmemberid"
+	"github.com/xaroth/lib-esi-go/esi/putfleetsfleetidsquadssquadid"
+	"github.com/xaroth/lib-esi-go/esi/putfleetsfleetidwingswingid"
+)
+
+// Operations describes every generated operation to the fake server.
+var Operations = []fakeesi.Operation{
+	{
+		Route:  deletecharacterscharacteridcontacts.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  deletecharacterscharacteridfittingsfittingid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  deletecharacterscharacteridmaillabelslabelid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  deletecharacterscharacteridmailmailid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  deletefleetsfleetidmembersmemberid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  deletefleetsfleetidsquadssquadid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  deletefleetsfleetidwingswingid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  getalliances.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getalliancesallianceid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getalliancesallianceidcontacts.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getalliancesallianceidcontactslabels.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getalliancesallianceidcorporations.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getalliancesallianceidicons.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersaccesslistsdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersaccesslistslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacterid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridagentsresearch.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridassets.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridattributes.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridblueprints.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcalendar.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcalendareventid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcalendareventidattendees.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridclones.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcontacts.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcontactslabels.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcontracts.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcontractscontractidbids.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcontractscontractiditems.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridcorporationhistory.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridfatigue.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridfittings.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridfleet.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridfwstats.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridimplants.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridindustryjobs.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridkillmailsrecent.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridlocation.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridloyaltypoints.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridmail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridmaillabels.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridmaillists.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridmailmailid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridmedals.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridmining.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridnotifications.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridnotificationscontacts.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridonline.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridorders.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridordershistory.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridplanets.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridplanetsplanetid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridportrait.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridroles.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridsearch.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridship.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridskillqueue.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridskills.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridstandings.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridtitles.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridwallet.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridwalletjournal.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharacterscharacteridwallettransactions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersfreelancejobslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersfreelancejobsparticipation.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersmercenarytacticaloperationsdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersmercenarytacticaloperationslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersstructuresmercenarydensdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcharactersstructuresmercenarydenslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcontractspublicbidscontractid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcontractspublicitemscontractid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcontractspublicregionid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationcorporationidminingextractions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationcorporationidminingobservers.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationcorporationidminingobserversobserverid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidalliancehistory.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidassets.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidblueprints.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcontacts.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcontactslabels.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcontainerslogs.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcontracts.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcontractscontractidbids.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcontractscontractiditems.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidcustomsoffices.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationiddivisions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidfacilities.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidfwstats.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidicons.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidindustryjobs.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidkillmailsrecent.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidmedals.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidmedalsissued.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidmembers.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidmemberslimit.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidmemberstitles.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidmembertracking.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidorders.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidordershistory.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidroles.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidroleshistory.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidshareholders.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidstandings.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidstarbases.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidstarbasesstarbaseid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidstructures.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidtitles.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidwallets.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidwalletsdivisionjournal.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationscorporationidwalletsdivisiontransactions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsfreelancejobslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsfreelancejobsparticipants.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsnpccorps.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsprojectscontribution.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsprojectscontributors.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsprojectsdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsprojectslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsstructuresskyhooksdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsstructuresskyhookslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsstructuressovereigntyhubsdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getcorporationsstructuressovereigntyhubslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getdogmaattributes.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getdogmaattributesattributeid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getdogmadynamicitemstypeiditemid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getdogmaeffects.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getdogmaeffectseffectid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfleetsfleetid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfleetsfleetidmembers.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfleetsfleetidwings.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfreelancejobsdetail.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfreelancejobslisting.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfwleaderboards.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfwleaderboardscharacters.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfwleaderboardscorporations.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfwstats.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfwsystems.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getfwwars.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getincursions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getindustryfacilities.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getindustrysystems.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getinsuranceprices.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getkillmailskillmailidkillmailhash.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getloyaltystorescorporationidoffers.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsgroups.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsgroupsmarketgroupid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsprices.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsregionidhistory.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsregionidorders.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsregionidtypes.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmarketsstructuresstructureid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmetachangelog.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmetacompatibilitydates.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmetaname.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getmetastatus.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getskyhooksraidable.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getsovereigntycampaigns.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getsovereigntymap.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getsovereigntystructures.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getsovereigntysystems.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getstatus.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseancestries.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseasteroidbeltsasteroidbeltid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversebloodlines.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversecategories.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversecategoriescategoryid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseconstellations.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseconstellationsconstellationid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversefactions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversegraphics.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversegraphicsgraphicid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversegroups.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversegroupsgroupid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversemoonsmoonid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseplanetsplanetid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseraces.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseregions.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseregionsregionid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniverseschematicsschematicid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversestargatesstargateid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversestarsstarid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversestationsstationid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversestructures.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversestructuresstructureid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversesystemjumps.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversesystemkills.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversesystems.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversesystemssystemid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversetypes.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getuniversetypestypeid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getwars.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getwarswarid.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  getwarswaridkillmails.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postcharactersaffiliation.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postcharacterscharacteridassetslocations.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postcharacterscharacteridassetsnames.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postcharacterscharacteridcontacts.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postcharacterscharacteridcspa.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postcharacterscharacteridfittings.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postcharacterscharacteridmail.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postcharacterscharacteridmaillabels.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postcorporationscorporationidassetslocations.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postcorporationscorporationidassetsnames.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postfleetsfleetidmembers.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  postfleetsfleetidwings.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postfleetsfleetidwingswingidsquads.Route,
+		Status: http.StatusCreated,
+	},
+	{
+		Route:  postroute.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postuiautopilotwaypoint.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  postuiopenwindowcontract.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  postuiopenwindowinformation.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  postuiopenwindowmarketdetails.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  postuiopenwindownewmail.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  postuniverseids.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  postuniversenames.Route,
+		Status: http.StatusOK,
+	},
+	{
+		Route:  putcharacterscharacteridcalendareventid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  putcharacterscharacteridcontacts.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  putcharacterscharacteridmailmailid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  putfleetsfleetid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  putfleetsfleetidmembersmemberid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  putfleetsfleetidsquadssquadid.Route,
+		Status: http.StatusNoContent,
+	},
+	{
+		Route:  putfleetsfleetidwingswingid.Route,
+		Status: http.StatusNoContent,
+	},
+}
+
+// NewServer returns a fake server for the generated operations, which enforces their rate limit groups.
+func NewServer(opts ...fakeesi.Option) *fakeesi.Server {
+	opts = append([]fakeesi.Option{fakeesi.WithRateLimitGroups(esi.RateLimitGroups)}, opts...)
+	return fakeesi.NewServer(Operations, opts...)
+}
@@ -13,3 +13,9 @@ var Request = request.Create[Input, []*Output](
 	"/alliances/{alliance_id}/contacts",
 	request.WithRequiredScope("esi-alliances.read_contacts.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/alliances/{alliance_id}/contacts",
+}
@@ -2,13 +2,17 @@
 
 package getcharacterscharacteridblueprints
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	ItemId             int64  `json:"item_id"`
-	LocationFlag       string `json:"location_flag"`
-	LocationId         int64  `json:"location_id"`
-	MaterialEfficiency int64  `json:"material_efficiency"`
-	Quantity           int64  `json:"quantity"`
-	Runs               int64  `json:"runs"`
-	TimeEfficiency     int64  `json:"time_efficiency"`
-	TypeId             int64  `json:"type_id"`
+	ItemId             int64             `json:"item_id"`
+	LocationFlag       string            `json:"location_flag"`
+	LocationId         int64             `json:"location_id"`
+	MaterialEfficiency int64             `json:"material_efficiency"`
+	Quantity           int64             `json:"quantity"`
+	Runs               int64             `json:"runs"`
+	TimeEfficiency     int64             `json:"time_efficiency"`
+	TypeId             typeid.Identifier `json:"type_id"`
 }
@@ -2,7 +2,11 @@
 
 package getcharacterscharacteridcalendareventidattendees
 
+import (
+	"github.com/xaroth/lib-esi-go/common/character"
+)
+
 type Output struct {
-	CharacterId   *int64  `json:"character_id"`
-	EventResponse *string `json:"event_response"`
+	CharacterId   *character.Identifier `json:"character_id"`
+	EventResponse *string               `json:"event_response"`
 }
@@ -13,3 +13,9 @@ var Request = request.Create[Input, []*Output](
 	"/characters/{character_id}/calendar/{event_id}/attendees",
 	request.WithRequiredScope("esi-calendar.read_calendar_events.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/characters/{character_id}/calendar/{event_id}/attendees",
+}
@@ -4,11 +4,13 @@ package getcharacterscharacteridcorporationhistory
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/corporation"
 )
 
 type Output struct {
-	CorporationId int64     `json:"corporation_id"`
-	IsDeleted     *bool     `json:"is_deleted"`
-	RecordId      int64     `json:"record_id"`
-	StartDate     time.Time `json:"start_date"`
+	CorporationId corporation.Identifier `json:"corporation_id"`
+	IsDeleted     *bool                  `json:"is_deleted"`
+	RecordId      int64                  `json:"record_id"`
+	StartDate     time.Time              `json:"start_date"`
 }
@@ -2,6 +2,10 @@
 
 package getcharacterscharacteridfittings
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
 	Description string  `json:"description"`
 	FittingId   int64   `json:"fitting_id"`
@@ -11,7 +15,7 @@ type Output struct {
 }
 
 type Items struct {
-	Flag     string `json:"flag"`
-	Quantity int64  `json:"quantity"`
-	TypeId   int64  `json:"type_id"`
+	Flag     string            `json:"flag"`
+	Quantity int64             `json:"quantity"`
+	TypeId   typeid.Identifier `json:"type_id"`
 }
@@ -4,15 +4,17 @@ package getcharacterscharacteridfwstats
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/faction"
 )
 
 type Output struct {
-	CurrentRank   *int64        `json:"current_rank"`
-	EnlistedOn    *time.Time    `json:"enlisted_on"`
-	FactionId     *int64        `json:"faction_id"`
-	HighestRank   *int64        `json:"highest_rank"`
-	Kills         Kills         `json:"kills"`
-	VictoryPoints VictoryPoints `json:"victory_points"`
+	CurrentRank   *int64              `json:"current_rank"`
+	EnlistedOn    *time.Time          `json:"enlisted_on"`
+	FactionId     *faction.Identifier `json:"faction_id"`
+	HighestRank   *int64              `json:"highest_rank"`
+	Kills         Kills               `json:"kills"`
+	VictoryPoints VictoryPoints       `json:"victory_points"`
 }
 
 type Kills struct {
@@ -21,8 +23,4 @@ type Kills struct {
 	Yesterday int64 `json:"yesterday"`
 }
 
-type VictoryPoints struct {
-	LastWeek  int64 `json:"last_week"`
-	Total     int64 `json:"total"`
-	Yesterday int64 `json:"yesterday"`
-}
+type VictoryPoints = Kills
@@ -2,7 +2,11 @@
 
 package getcharacterscharacteridloyaltypoints
 
+import (
+	"github.com/xaroth/lib-esi-go/common/corporation"
+)
+
 type Output struct {
-	CorporationId int64 `json:"corporation_id"`
-	LoyaltyPoints int64 `json:"loyalty_points"`
+	CorporationId corporation.Identifier `json:"corporation_id"`
+	LoyaltyPoints int64                  `json:"loyalty_points"`
 }
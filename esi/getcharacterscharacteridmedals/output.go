@@ -4,18 +4,20 @@ package getcharacterscharacteridmedals
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/corporation"
 )
 
 type Output struct {
-	CorporationId int64      `json:"corporation_id"`
-	Date          time.Time  `json:"date"`
-	Description   string     `json:"description"`
-	Graphics      []Graphics `json:"graphics"`
-	IssuerId      int64      `json:"issuer_id"`
-	MedalId       int64      `json:"medal_id"`
-	Reason        string     `json:"reason"`
-	Status        string     `json:"status"`
-	Title         string     `json:"title"`
+	CorporationId corporation.Identifier `json:"corporation_id"`
+	Date          time.Time              `json:"date"`
+	Description   string                 `json:"description"`
+	Graphics      []Graphics             `json:"graphics"`
+	IssuerId      int64                  `json:"issuer_id"`
+	MedalId       int64                  `json:"medal_id"`
+	Reason        string                 `json:"reason"`
+	Status        string                 `json:"status"`
+	Title         string                 `json:"title"`
 }
 
 type Graphics struct {
@@ -2,9 +2,15 @@
 
 package getcharacterscharacteridmining
 
+import (
+	"github.com/xaroth/lib-esi-go/civil"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	Date          string `json:"date"`
-	Quantity      int64  `json:"quantity"`
-	SolarSystemId int64  `json:"solar_system_id"`
-	TypeId        int64  `json:"type_id"`
+	Date          civil.Date             `json:"date"`
+	Quantity      int64                  `json:"quantity"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
+	TypeId        typeid.Identifier      `json:"type_id"`
 }
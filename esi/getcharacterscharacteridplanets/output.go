@@ -4,14 +4,17 @@ package getcharacterscharacteridplanets
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/planet"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
 )
 
 type Output struct {
-	LastUpdate    time.Time `json:"last_update"`
-	NumPins       int64     `json:"num_pins"`
-	OwnerId       int64     `json:"owner_id"`
-	PlanetId      int64     `json:"planet_id"`
-	PlanetType    string    `json:"planet_type"`
-	SolarSystemId int64     `json:"solar_system_id"`
-	UpgradeLevel  int64     `json:"upgrade_level"`
+	LastUpdate    time.Time              `json:"last_update"`
+	NumPins       int64                  `json:"num_pins"`
+	OwnerId       int64                  `json:"owner_id"`
+	PlanetId      planet.Identifier      `json:"planet_id"`
+	PlanetType    string                 `json:"planet_type"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
+	UpgradeLevel  int64                  `json:"upgrade_level"`
 }
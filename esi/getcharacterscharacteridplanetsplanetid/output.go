@@ -4,6 +4,8 @@ package getcharacterscharacteridplanetsplanetid
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/typeid"
 )
 
 type Output struct {
@@ -19,8 +21,8 @@ type Links struct {
 }
 
 type Contents struct {
-	Amount int64 `json:"amount"`
-	TypeId int64 `json:"type_id"`
+	Amount int64             `json:"amount"`
+	TypeId typeid.Identifier `json:"type_id"`
 }
 
 type Heads struct {
@@ -52,7 +54,7 @@ type Pins struct {
 	Longitude        float64           `json:"longitude"`
 	PinId            int64             `json:"pin_id"`
 	SchematicId      *int64            `json:"schematic_id"`
-	TypeId           int64             `json:"type_id"`
+	TypeId           typeid.Identifier `json:"type_id"`
 }
 
 type Routes struct {
@@ -2,6 +2,10 @@
 
 package getcharacterscharacteridskills
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
 	Skills        []CharactersSkillsSkill `json:"skills"`
 	TotalSp       int64                   `json:"total_sp"`
@@ -9,8 +13,8 @@ type Output struct {
 }
 
 type CharactersSkillsSkill struct {
-	ActiveSkillLevel   int64 `json:"active_skill_level"`
-	SkillId            int64 `json:"skill_id"`
-	SkillpointsInSkill int64 `json:"skillpoints_in_skill"`
-	TrainedSkillLevel  int64 `json:"trained_skill_level"`
+	ActiveSkillLevel   int64             `json:"active_skill_level"`
+	SkillId            typeid.Identifier `json:"skill_id"`
+	SkillpointsInSkill int64             `json:"skillpoints_in_skill"`
+	TrainedSkillLevel  int64             `json:"trained_skill_level"`
 }
@@ -26,10 +26,7 @@ type CharactersStructuresMercenaryDensDetailEvolutionanarchy struct {
 	Level  string `json:"level"`
 }
 
-type CharactersStructuresMercenaryDensDetailEvolutiondevelopment struct {
-	Amount int64  `json:"amount"`
-	Level  string `json:"level"`
-}
+type CharactersStructuresMercenaryDensDetailEvolutiondevelopment = CharactersStructuresMercenaryDensDetailEvolutionanarchy
 
 type CharactersStructuresMercenaryDensDetailEvolution struct {
 	Anarchy     CharactersStructuresMercenaryDensDetailEvolutionanarchy     `json:"anarchy"`
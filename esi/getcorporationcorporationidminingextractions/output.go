@@ -4,12 +4,14 @@ package getcorporationcorporationidminingextractions
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/item"
 )
 
 type Output struct {
-	ChunkArrivalTime    time.Time `json:"chunk_arrival_time"`
-	ExtractionStartTime time.Time `json:"extraction_start_time"`
-	MoonId              int64     `json:"moon_id"`
-	NaturalDecayTime    time.Time `json:"natural_decay_time"`
-	StructureId         int64     `json:"structure_id"`
+	ChunkArrivalTime    time.Time       `json:"chunk_arrival_time"`
+	ExtractionStartTime time.Time       `json:"extraction_start_time"`
+	MoonId              int64           `json:"moon_id"`
+	NaturalDecayTime    time.Time       `json:"natural_decay_time"`
+	StructureId         item.Identifier `json:"structure_id"`
 }
@@ -2,8 +2,12 @@
 
 package getcorporationcorporationidminingobservers
 
+import (
+	"github.com/xaroth/lib-esi-go/civil"
+)
+
 type Output struct {
-	LastUpdated  string `json:"last_updated"`
-	ObserverId   int64  `json:"observer_id"`
-	ObserverType string `json:"observer_type"`
+	LastUpdated  civil.Date `json:"last_updated"`
+	ObserverId   int64      `json:"observer_id"`
+	ObserverType string     `json:"observer_type"`
 }
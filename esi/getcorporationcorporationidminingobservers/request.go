@@ -13,3 +13,9 @@ var Request = request.Create[Input, []*Output](
 	"/corporation/{corporation_id}/mining/observers",
 	request.WithRequiredScope("esi-industry.read_corporation_mining.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/corporation/{corporation_id}/mining/observers",
+}
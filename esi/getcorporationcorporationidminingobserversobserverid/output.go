@@ -2,10 +2,16 @@
 
 package getcorporationcorporationidminingobserversobserverid
 
+import (
+	"github.com/xaroth/lib-esi-go/civil"
+	"github.com/xaroth/lib-esi-go/common/character"
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	CharacterId           int64  `json:"character_id"`
-	LastUpdated           string `json:"last_updated"`
-	Quantity              int64  `json:"quantity"`
-	RecordedCorporationId int64  `json:"recorded_corporation_id"`
-	TypeId                int64  `json:"type_id"`
+	CharacterId           character.Identifier `json:"character_id"`
+	LastUpdated           civil.Date           `json:"last_updated"`
+	Quantity              int64                `json:"quantity"`
+	RecordedCorporationId int64                `json:"recorded_corporation_id"`
+	TypeId                typeid.Identifier    `json:"type_id"`
 }
@@ -4,11 +4,13 @@ package getcorporationscorporationidalliancehistory
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/alliance"
 )
 
 type Output struct {
-	AllianceId *int64    `json:"alliance_id"`
-	IsDeleted  *bool     `json:"is_deleted"`
-	RecordId   int64     `json:"record_id"`
-	StartDate  time.Time `json:"start_date"`
+	AllianceId *alliance.Identifier `json:"alliance_id"`
+	IsDeleted  *bool                `json:"is_deleted"`
+	RecordId   int64                `json:"record_id"`
+	StartDate  time.Time            `json:"start_date"`
 }
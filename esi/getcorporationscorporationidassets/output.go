@@ -2,13 +2,17 @@
 
 package getcorporationscorporationidassets
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	IsBlueprintCopy *bool  `json:"is_blueprint_copy"`
-	IsSingleton     bool   `json:"is_singleton"`
-	ItemId          int64  `json:"item_id"`
-	LocationFlag    string `json:"location_flag"`
-	LocationId      int64  `json:"location_id"`
-	LocationType    string `json:"location_type"`
-	Quantity        int64  `json:"quantity"`
-	TypeId          int64  `json:"type_id"`
+	IsBlueprintCopy *bool             `json:"is_blueprint_copy"`
+	IsSingleton     bool              `json:"is_singleton"`
+	ItemId          int64             `json:"item_id"`
+	LocationFlag    string            `json:"location_flag"`
+	LocationId      int64             `json:"location_id"`
+	LocationType    string            `json:"location_type"`
+	Quantity        int64             `json:"quantity"`
+	TypeId          typeid.Identifier `json:"type_id"`
 }
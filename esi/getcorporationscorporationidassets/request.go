@@ -13,3 +13,9 @@ var Request = request.Create[Input, []*Output](
 	"/corporations/{corporation_id}/assets",
 	request.WithRequiredScope("esi-assets.read_corporation_assets.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/corporations/{corporation_id}/assets",
+}
@@ -13,3 +13,9 @@ var Request = request.Create[Input, []*Output](
 	"/corporations/{corporation_id}/containers/logs",
 	request.WithRequiredScope("esi-corporations.read_container_logs.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/corporations/{corporation_id}/containers/logs",
+}
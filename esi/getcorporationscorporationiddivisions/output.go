@@ -12,7 +12,4 @@ type Hangar struct {
 	Name     *string `json:"name"`
 }
 
-type Wallet struct {
-	Division *int64  `json:"division"`
-	Name     *string `json:"name"`
-}
+type Wallet = Hangar
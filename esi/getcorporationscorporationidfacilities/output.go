@@ -2,8 +2,12 @@
 
 package getcorporationscorporationidfacilities
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	FacilityId int64 `json:"facility_id"`
-	SystemId   int64 `json:"system_id"`
-	TypeId     int64 `json:"type_id"`
+	FacilityId int64             `json:"facility_id"`
+	SystemId   int64             `json:"system_id"`
+	TypeId     typeid.Identifier `json:"type_id"`
 }
@@ -4,14 +4,16 @@ package getcorporationscorporationidfwstats
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/faction"
 )
 
 type Output struct {
-	EnlistedOn    *time.Time    `json:"enlisted_on"`
-	FactionId     *int64        `json:"faction_id"`
-	Kills         Kills         `json:"kills"`
-	Pilots        *int64        `json:"pilots"`
-	VictoryPoints VictoryPoints `json:"victory_points"`
+	EnlistedOn    *time.Time          `json:"enlisted_on"`
+	FactionId     *faction.Identifier `json:"faction_id"`
+	Kills         Kills               `json:"kills"`
+	Pilots        *int64              `json:"pilots"`
+	VictoryPoints VictoryPoints       `json:"victory_points"`
 }
 
 type Kills struct {
@@ -20,8 +22,4 @@ type Kills struct {
 	Yesterday int64 `json:"yesterday"`
 }
 
-type VictoryPoints struct {
-	LastWeek  int64 `json:"last_week"`
-	Total     int64 `json:"total"`
-	Yesterday int64 `json:"yesterday"`
-}
+type VictoryPoints = Kills
@@ -4,12 +4,14 @@ package getcorporationscorporationidmedals
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/character"
 )
 
 type Output struct {
-	CreatedAt   time.Time `json:"created_at"`
-	CreatorId   int64     `json:"creator_id"`
-	Description string    `json:"description"`
-	MedalId     int64     `json:"medal_id"`
-	Title       string    `json:"title"`
+	CreatedAt   time.Time            `json:"created_at"`
+	CreatorId   character.Identifier `json:"creator_id"`
+	Description string               `json:"description"`
+	MedalId     int64                `json:"medal_id"`
+	Title       string               `json:"title"`
 }
@@ -4,13 +4,15 @@ package getcorporationscorporationidmedalsissued
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/character"
 )
 
 type Output struct {
-	CharacterId int64     `json:"character_id"`
-	IssuedAt    time.Time `json:"issued_at"`
-	IssuerId    int64     `json:"issuer_id"`
-	MedalId     int64     `json:"medal_id"`
-	Reason      string    `json:"reason"`
-	Status      string    `json:"status"`
+	CharacterId character.Identifier `json:"character_id"`
+	IssuedAt    time.Time            `json:"issued_at"`
+	IssuerId    int64                `json:"issuer_id"`
+	MedalId     int64                `json:"medal_id"`
+	Reason      string               `json:"reason"`
+	Status      string               `json:"status"`
 }
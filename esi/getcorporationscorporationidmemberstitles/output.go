@@ -2,7 +2,11 @@
 
 package getcorporationscorporationidmemberstitles
 
+import (
+	"github.com/xaroth/lib-esi-go/common/character"
+)
+
 type Output struct {
-	CharacterId int64   `json:"character_id"`
-	Titles      []int64 `json:"titles"`
+	CharacterId character.Identifier `json:"character_id"`
+	Titles      []int64              `json:"titles"`
 }
@@ -4,23 +4,26 @@ package getcorporationscorporationidordershistory
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/region"
+	"github.com/xaroth/lib-esi-go/common/typeid"
 )
 
 type Output struct {
-	Duration       int64     `json:"duration"`
-	Escrow         *float64  `json:"escrow"`
-	IsBuyOrder     *bool     `json:"is_buy_order"`
-	Issued         time.Time `json:"issued"`
-	IssuedBy       *int64    `json:"issued_by"`
-	LocationId     int64     `json:"location_id"`
-	MinVolume      *int64    `json:"min_volume"`
-	OrderId        int64     `json:"order_id"`
-	Price          float64   `json:"price"`
-	Range          string    `json:"range"`
-	RegionId       int64     `json:"region_id"`
-	State          string    `json:"state"`
-	TypeId         int64     `json:"type_id"`
-	VolumeRemain   int64     `json:"volume_remain"`
-	VolumeTotal    int64     `json:"volume_total"`
-	WalletDivision int64     `json:"wallet_division"`
+	Duration       int64             `json:"duration"`
+	Escrow         *float64          `json:"escrow"`
+	IsBuyOrder     *bool             `json:"is_buy_order"`
+	Issued         time.Time         `json:"issued"`
+	IssuedBy       *int64            `json:"issued_by"`
+	LocationId     int64             `json:"location_id"`
+	MinVolume      *int64            `json:"min_volume"`
+	OrderId        int64             `json:"order_id"`
+	Price          float64           `json:"price"`
+	Range          string            `json:"range"`
+	RegionId       region.Identifier `json:"region_id"`
+	State          string            `json:"state"`
+	TypeId         typeid.Identifier `json:"type_id"`
+	VolumeRemain   int64             `json:"volume_remain"`
+	VolumeTotal    int64             `json:"volume_total"`
+	WalletDivision int64             `json:"wallet_division"`
 }
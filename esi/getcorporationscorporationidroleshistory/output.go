@@ -4,13 +4,15 @@ package getcorporationscorporationidroleshistory
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/character"
 )
 
 type Output struct {
-	ChangedAt   time.Time `json:"changed_at"`
-	CharacterId int64     `json:"character_id"`
-	IssuerId    int64     `json:"issuer_id"`
-	NewRoles    []string  `json:"new_roles"`
-	OldRoles    []string  `json:"old_roles"`
-	RoleType    string    `json:"role_type"`
+	ChangedAt   time.Time            `json:"changed_at"`
+	CharacterId character.Identifier `json:"character_id"`
+	IssuerId    int64                `json:"issuer_id"`
+	NewRoles    []string             `json:"new_roles"`
+	OldRoles    []string             `json:"old_roles"`
+	RoleType    string               `json:"role_type"`
 }
@@ -4,15 +4,17 @@ package getcorporationscorporationidstarbases
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/typeid"
 )
 
 type Output struct {
-	MoonId          *int64     `json:"moon_id"`
-	OnlinedSince    *time.Time `json:"onlined_since"`
-	ReinforcedUntil *time.Time `json:"reinforced_until"`
-	StarbaseId      int64      `json:"starbase_id"`
-	State           *string    `json:"state"`
-	SystemId        int64      `json:"system_id"`
-	TypeId          int64      `json:"type_id"`
-	UnanchorAt      *time.Time `json:"unanchor_at"`
+	MoonId          *int64            `json:"moon_id"`
+	OnlinedSince    *time.Time        `json:"onlined_since"`
+	ReinforcedUntil *time.Time        `json:"reinforced_until"`
+	StarbaseId      int64             `json:"starbase_id"`
+	State           *string           `json:"state"`
+	SystemId        int64             `json:"system_id"`
+	TypeId          typeid.Identifier `json:"type_id"`
+	UnanchorAt      *time.Time        `json:"unanchor_at"`
 }
@@ -2,6 +2,10 @@
 
 package getcorporationscorporationidstarbasesstarbaseid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
 	AllowAllianceMembers                bool     `json:"allow_alliance_members"`
 	AllowCorporationMembers             bool     `json:"allow_corporation_members"`
@@ -20,6 +24,6 @@ type Output struct {
 }
 
 type Fuels struct {
-	Quantity int64 `json:"quantity"`
-	TypeId   int64 `json:"type_id"`
+	Quantity int64             `json:"quantity"`
+	TypeId   typeid.Identifier `json:"type_id"`
 }
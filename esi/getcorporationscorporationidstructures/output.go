@@ -4,24 +4,28 @@ package getcorporationscorporationidstructures
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/corporation"
+	"github.com/xaroth/lib-esi-go/common/item"
+	"github.com/xaroth/lib-esi-go/common/typeid"
 )
 
 type Output struct {
-	CorporationId      int64      `json:"corporation_id"`
-	FuelExpires        *time.Time `json:"fuel_expires"`
-	Name               *string    `json:"name"`
-	NextReinforceApply *time.Time `json:"next_reinforce_apply"`
-	NextReinforceHour  *int64     `json:"next_reinforce_hour"`
-	ProfileId          int64      `json:"profile_id"`
-	ReinforceHour      *int64     `json:"reinforce_hour"`
-	Services           []Services `json:"services"`
-	State              string     `json:"state"`
-	StateTimerEnd      *time.Time `json:"state_timer_end"`
-	StateTimerStart    *time.Time `json:"state_timer_start"`
-	StructureId        int64      `json:"structure_id"`
-	SystemId           int64      `json:"system_id"`
-	TypeId             int64      `json:"type_id"`
-	UnanchorsAt        *time.Time `json:"unanchors_at"`
+	CorporationId      corporation.Identifier `json:"corporation_id"`
+	FuelExpires        *time.Time             `json:"fuel_expires"`
+	Name               *string                `json:"name"`
+	NextReinforceApply *time.Time             `json:"next_reinforce_apply"`
+	NextReinforceHour  *int64                 `json:"next_reinforce_hour"`
+	ProfileId          int64                  `json:"profile_id"`
+	ReinforceHour      *int64                 `json:"reinforce_hour"`
+	Services           []Services             `json:"services"`
+	State              string                 `json:"state"`
+	StateTimerEnd      *time.Time             `json:"state_timer_end"`
+	StateTimerStart    *time.Time             `json:"state_timer_start"`
+	StructureId        item.Identifier        `json:"structure_id"`
+	SystemId           int64                  `json:"system_id"`
+	TypeId             typeid.Identifier      `json:"type_id"`
+	UnanchorsAt        *time.Time             `json:"unanchors_at"`
 }
 
 type Services struct {
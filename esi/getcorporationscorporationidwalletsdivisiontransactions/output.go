@@ -4,16 +4,18 @@ package getcorporationscorporationidwalletsdivisiontransactions
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/typeid"
 )
 
 type Output struct {
-	ClientId      int64     `json:"client_id"`
-	Date          time.Time `json:"date"`
-	IsBuy         bool      `json:"is_buy"`
-	JournalRefId  int64     `json:"journal_ref_id"`
-	LocationId    int64     `json:"location_id"`
-	Quantity      int64     `json:"quantity"`
-	TransactionId int64     `json:"transaction_id"`
-	TypeId        int64     `json:"type_id"`
-	UnitPrice     float64   `json:"unit_price"`
+	ClientId      int64             `json:"client_id"`
+	Date          time.Time         `json:"date"`
+	IsBuy         bool              `json:"is_buy"`
+	JournalRefId  int64             `json:"journal_ref_id"`
+	LocationId    int64             `json:"location_id"`
+	Quantity      int64             `json:"quantity"`
+	TransactionId int64             `json:"transaction_id"`
+	TypeId        typeid.Identifier `json:"type_id"`
+	UnitPrice     float64           `json:"unit_price"`
 }
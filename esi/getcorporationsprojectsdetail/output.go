@@ -74,11 +74,7 @@ type CorporationsProjectsDetailConfigurationdamageship struct {
 	Ships      []Ship     `json:"ships"`
 }
 
-type CorporationsProjectsDetailConfigurationdefendfwcomplex struct {
-	Archetypes []CorporationsProjectsDetailConfigurationmatcherarchetype `json:"archetypes"`
-	Factions   []CorporationsProjectsDetailConfigurationmatcherfaction   `json:"factions"`
-	Locations  []Location                                                `json:"locations"`
-}
+type CorporationsProjectsDetailConfigurationdefendfwcomplex = CorporationsProjectsDetailConfigurationcapturefwcomplex
 
 type DockingLocation struct {
 	Structure *item.Identifier    `json:"structure_id,omitempty"`
@@ -100,11 +96,7 @@ type CorporationsProjectsDetailConfigurationdestroynpc struct {
 	Locations []Location `json:"locations"`
 }
 
-type CorporationsProjectsDetailConfigurationdestroyship struct {
-	Identities []Identity `json:"identities"`
-	Locations  []Location `json:"locations"`
-	Ships      []Ship     `json:"ships"`
-}
+type CorporationsProjectsDetailConfigurationdestroyship = CorporationsProjectsDetailConfigurationdamageship
 
 type CorporationsProjectsDetailConfigurationmatchercorporation struct {
 	Corporation *corporation.Identifier `json:"corporation_id"`
@@ -114,11 +106,7 @@ type CorporationsProjectsDetailConfigurationearnloyaltypoints struct {
 	Corporations []CorporationsProjectsDetailConfigurationmatchercorporation `json:"corporations"`
 }
 
-type CorporationsProjectsDetailConfigurationlostship struct {
-	Identities []Identity `json:"identities"`
-	Locations  []Location `json:"locations"`
-	Ships      []Ship     `json:"ships"`
-}
+type CorporationsProjectsDetailConfigurationlostship = CorporationsProjectsDetailConfigurationdamageship
 
 type CorporationsProjectsDetailConfigurationmanual struct {
 }
@@ -129,31 +117,18 @@ type CorporationsProjectsDetailConfigurationmanufactureitem struct {
 	Owner            string            `json:"owner"`
 }
 
-type Material struct {
-	Type  *typeid.Identifier `json:"type_id,omitempty"`
-	Group *group.Identifier  `json:"group_id,omitempty"`
-}
+type Material = Item
 
 type CorporationsProjectsDetailConfigurationminematerial struct {
 	Locations []Location `json:"locations"`
 	Materials []Material `json:"materials"`
 }
 
-type CorporationsProjectsDetailConfigurationremoteboostshield struct {
-	Identities []Identity `json:"identities"`
-	Locations  []Location `json:"locations"`
-	Ships      []Ship     `json:"ships"`
-}
+type CorporationsProjectsDetailConfigurationremoteboostshield = CorporationsProjectsDetailConfigurationdamageship
 
-type CorporationsProjectsDetailConfigurationremoterepairarmor struct {
-	Identities []Identity `json:"identities"`
-	Locations  []Location `json:"locations"`
-	Ships      []Ship     `json:"ships"`
-}
+type CorporationsProjectsDetailConfigurationremoterepairarmor = CorporationsProjectsDetailConfigurationdamageship
 
-type CorporationsProjectsDetailConfigurationsalvagewreck struct {
-	Locations []Location `json:"locations"`
-}
+type CorporationsProjectsDetailConfigurationsalvagewreck = CorporationsProjectsDetailConfigurationdestroynpc
 
 type CorporationsProjectsDetailConfigurationmatchersignature struct {
 	SignatureType *attribute.Identifier `json:"signature_type_id"`
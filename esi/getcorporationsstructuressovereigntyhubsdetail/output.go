@@ -38,10 +38,7 @@ type CorporationsStructuresSovereigntyHubsDetailResourcepower struct {
 	Available int64 `json:"available"`
 }
 
-type CorporationsStructuresSovereigntyHubsDetailResourceworkforce struct {
-	Allocated int64 `json:"allocated"`
-	Available int64 `json:"available"`
-}
+type CorporationsStructuresSovereigntyHubsDetailResourceworkforce = CorporationsStructuresSovereigntyHubsDetailResourcepower
 
 type CorporationsStructuresSovereigntyHubsDetailResources struct {
 	Power     CorporationsStructuresSovereigntyHubsDetailResourcepower     `json:"power"`
@@ -13,3 +13,9 @@ var Request = request.Create[Input, *Output](
 	"/corporations/{corporation_id}/structures/sovereignty-hubs/{sovereignty_hub_id}",
 	request.WithRequiredScope("esi-structures.read_corporation.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, *Output]{
+	Method: http.MethodGet,
+	Path:   "/corporations/{corporation_id}/structures/sovereignty-hubs/{sovereignty_hub_id}",
+}
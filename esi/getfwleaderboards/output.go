@@ -2,25 +2,23 @@
 
 package getfwleaderboards
 
+import (
+	"github.com/xaroth/lib-esi-go/common/faction"
+)
+
 type Output struct {
 	Kills         Kills         `json:"kills"`
 	VictoryPoints VictoryPoints `json:"victory_points"`
 }
 
 type ActiveTotal struct {
-	Amount    *int64 `json:"amount"`
-	FactionId *int64 `json:"faction_id"`
+	Amount    *int64              `json:"amount"`
+	FactionId *faction.Identifier `json:"faction_id"`
 }
 
-type LastWeek struct {
-	Amount    *int64 `json:"amount"`
-	FactionId *int64 `json:"faction_id"`
-}
+type LastWeek = ActiveTotal
 
-type Yesterday struct {
-	Amount    *int64 `json:"amount"`
-	FactionId *int64 `json:"faction_id"`
-}
+type Yesterday = ActiveTotal
 
 type Kills struct {
 	ActiveTotal []ActiveTotal `json:"active_total"`
@@ -28,8 +26,4 @@ type Kills struct {
 	Yesterday   []Yesterday   `json:"yesterday"`
 }
 
-type VictoryPoints struct {
-	ActiveTotal []ActiveTotal `json:"active_total"`
-	LastWeek    []LastWeek    `json:"last_week"`
-	Yesterday   []Yesterday   `json:"yesterday"`
-}
+type VictoryPoints = Kills
@@ -2,12 +2,16 @@
 
 package getfwstats
 
+import (
+	"github.com/xaroth/lib-esi-go/common/faction"
+)
+
 type Output struct {
-	FactionId         int64         `json:"faction_id"`
-	Kills             Kills         `json:"kills"`
-	Pilots            int64         `json:"pilots"`
-	SystemsControlled int64         `json:"systems_controlled"`
-	VictoryPoints     VictoryPoints `json:"victory_points"`
+	FactionId         faction.Identifier `json:"faction_id"`
+	Kills             Kills              `json:"kills"`
+	Pilots            int64              `json:"pilots"`
+	SystemsControlled int64              `json:"systems_controlled"`
+	VictoryPoints     VictoryPoints      `json:"victory_points"`
 }
 
 type Kills struct {
@@ -16,8 +20,4 @@ type Kills struct {
 	Yesterday int64 `json:"yesterday"`
 }
 
-type VictoryPoints struct {
-	LastWeek  int64 `json:"last_week"`
-	Total     int64 `json:"total"`
-	Yesterday int64 `json:"yesterday"`
-}
+type VictoryPoints = Kills
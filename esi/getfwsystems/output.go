@@ -2,11 +2,15 @@
 
 package getfwsystems
 
+import (
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+)
+
 type Output struct {
-	Contested              string `json:"contested"`
-	OccupierFactionId      int64  `json:"occupier_faction_id"`
-	OwnerFactionId         int64  `json:"owner_faction_id"`
-	SolarSystemId          int64  `json:"solar_system_id"`
-	VictoryPoints          int64  `json:"victory_points"`
-	VictoryPointsThreshold int64  `json:"victory_points_threshold"`
+	Contested              string                 `json:"contested"`
+	OccupierFactionId      int64                  `json:"occupier_faction_id"`
+	OwnerFactionId         int64                  `json:"owner_faction_id"`
+	SolarSystemId          solarsystem.Identifier `json:"solar_system_id"`
+	VictoryPoints          int64                  `json:"victory_points"`
+	VictoryPointsThreshold int64                  `json:"victory_points_threshold"`
 }
@@ -2,7 +2,11 @@
 
 package getfwwars
 
+import (
+	"github.com/xaroth/lib-esi-go/common/faction"
+)
+
 type Output struct {
-	AgainstId int64 `json:"against_id"`
-	FactionId int64 `json:"faction_id"`
+	AgainstId int64              `json:"against_id"`
+	FactionId faction.Identifier `json:"faction_id"`
 }
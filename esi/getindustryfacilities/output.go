@@ -2,11 +2,17 @@
 
 package getindustryfacilities
 
+import (
+	"github.com/xaroth/lib-esi-go/common/region"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	FacilityId    int64    `json:"facility_id"`
-	OwnerId       int64    `json:"owner_id"`
-	RegionId      int64    `json:"region_id"`
-	SolarSystemId int64    `json:"solar_system_id"`
-	Tax           *float64 `json:"tax"`
-	TypeId        int64    `json:"type_id"`
+	FacilityId    int64                  `json:"facility_id"`
+	OwnerId       int64                  `json:"owner_id"`
+	RegionId      region.Identifier      `json:"region_id"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
+	Tax           *float64               `json:"tax"`
+	TypeId        typeid.Identifier      `json:"type_id"`
 }
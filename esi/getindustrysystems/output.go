@@ -2,9 +2,13 @@
 
 package getindustrysystems
 
+import (
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+)
+
 type Output struct {
-	CostIndices   []CostIndices `json:"cost_indices"`
-	SolarSystemId int64         `json:"solar_system_id"`
+	CostIndices   []CostIndices          `json:"cost_indices"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
 }
 
 type CostIndices struct {
@@ -2,9 +2,13 @@
 
 package getinsuranceprices
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	Levels []Levels `json:"levels"`
-	TypeId int64    `json:"type_id"`
+	Levels []Levels          `json:"levels"`
+	TypeId typeid.Identifier `json:"type_id"`
 }
 
 type Levels struct {
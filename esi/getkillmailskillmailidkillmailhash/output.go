@@ -4,28 +4,34 @@ package getkillmailskillmailidkillmailhash
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/character"
+	"github.com/xaroth/lib-esi-go/common/corporation"
+	"github.com/xaroth/lib-esi-go/common/faction"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
 )
 
 type Output struct {
-	Attackers     []Attackers `json:"attackers"`
-	KillmailId    int64       `json:"killmail_id"`
-	KillmailTime  time.Time   `json:"killmail_time"`
-	MoonId        *int64      `json:"moon_id"`
-	SolarSystemId int64       `json:"solar_system_id"`
-	Victim        Victim      `json:"victim"`
-	WarId         *int64      `json:"war_id"`
+	Attackers     []Attackers            `json:"attackers"`
+	KillmailId    int64                  `json:"killmail_id"`
+	KillmailTime  time.Time              `json:"killmail_time"`
+	MoonId        *int64                 `json:"moon_id"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
+	Victim        Victim                 `json:"victim"`
+	WarId         *int64                 `json:"war_id"`
 }
 
 type Attackers struct {
-	AllianceId     *int64  `json:"alliance_id"`
-	CharacterId    *int64  `json:"character_id"`
-	CorporationId  *int64  `json:"corporation_id"`
-	DamageDone     int64   `json:"damage_done"`
-	FactionId      *int64  `json:"faction_id"`
-	FinalBlow      bool    `json:"final_blow"`
-	SecurityStatus float64 `json:"security_status"`
-	ShipTypeId     *int64  `json:"ship_type_id"`
-	WeaponTypeId   *int64  `json:"weapon_type_id"`
+	AllianceId     *alliance.Identifier    `json:"alliance_id"`
+	CharacterId    *character.Identifier   `json:"character_id"`
+	CorporationId  *corporation.Identifier `json:"corporation_id"`
+	DamageDone     int64                   `json:"damage_done"`
+	FactionId      *faction.Identifier     `json:"faction_id"`
+	FinalBlow      bool                    `json:"final_blow"`
+	SecurityStatus float64                 `json:"security_status"`
+	ShipTypeId     *int64                  `json:"ship_type_id"`
+	WeaponTypeId   *int64                  `json:"weapon_type_id"`
 }
 
 type Items struct {
@@ -44,12 +50,12 @@ type Position struct {
 }
 
 type Victim struct {
-	AllianceId    *int64    `json:"alliance_id"`
-	CharacterId   *int64    `json:"character_id"`
-	CorporationId *int64    `json:"corporation_id"`
-	DamageTaken   int64     `json:"damage_taken"`
-	FactionId     *int64    `json:"faction_id"`
-	Items         []Items   `json:"items"`
-	Position      *Position `json:"position"`
-	ShipTypeId    int64     `json:"ship_type_id"`
+	AllianceId    *alliance.Identifier    `json:"alliance_id"`
+	CharacterId   *character.Identifier   `json:"character_id"`
+	CorporationId *corporation.Identifier `json:"corporation_id"`
+	DamageTaken   int64                   `json:"damage_taken"`
+	FactionId     *faction.Identifier     `json:"faction_id"`
+	Items         []Items                 `json:"items"`
+	Position      *Position               `json:"position"`
+	ShipTypeId    int64                   `json:"ship_type_id"`
 }
@@ -2,17 +2,21 @@
 
 package getloyaltystorescorporationidoffers
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	AkCost        *int64          `json:"ak_cost"`
-	IskCost       int64           `json:"isk_cost"`
-	LpCost        int64           `json:"lp_cost"`
-	OfferId       int64           `json:"offer_id"`
-	Quantity      int64           `json:"quantity"`
-	RequiredItems []RequiredItems `json:"required_items"`
-	TypeId        int64           `json:"type_id"`
+	AkCost        *int64            `json:"ak_cost"`
+	IskCost       int64             `json:"isk_cost"`
+	LpCost        int64             `json:"lp_cost"`
+	OfferId       int64             `json:"offer_id"`
+	Quantity      int64             `json:"quantity"`
+	RequiredItems []RequiredItems   `json:"required_items"`
+	TypeId        typeid.Identifier `json:"type_id"`
 }
 
 type RequiredItems struct {
-	Quantity int64 `json:"quantity"`
-	TypeId   int64 `json:"type_id"`
+	Quantity int64             `json:"quantity"`
+	TypeId   typeid.Identifier `json:"type_id"`
 }
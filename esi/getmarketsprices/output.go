@@ -2,8 +2,12 @@
 
 package getmarketsprices
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	AdjustedPrice *float64 `json:"adjusted_price"`
-	AveragePrice  *float64 `json:"average_price"`
-	TypeId        int64    `json:"type_id"`
+	AdjustedPrice *float64          `json:"adjusted_price"`
+	AveragePrice  *float64          `json:"average_price"`
+	TypeId        typeid.Identifier `json:"type_id"`
 }
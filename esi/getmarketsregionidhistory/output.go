@@ -2,11 +2,15 @@
 
 package getmarketsregionidhistory
 
+import (
+	"github.com/xaroth/lib-esi-go/civil"
+)
+
 type Output struct {
-	Average    float64 `json:"average"`
-	Date       string  `json:"date"`
-	Highest    float64 `json:"highest"`
-	Lowest     float64 `json:"lowest"`
-	OrderCount int64   `json:"order_count"`
-	Volume     int64   `json:"volume"`
+	Average    float64    `json:"average"`
+	Date       civil.Date `json:"date"`
+	Highest    float64    `json:"highest"`
+	Lowest     float64    `json:"lowest"`
+	OrderCount int64      `json:"order_count"`
+	Volume     int64      `json:"volume"`
 }
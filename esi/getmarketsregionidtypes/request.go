@@ -12,3 +12,9 @@ var Request = request.Create[Input, Output](
 	http.MethodGet,
 	"/markets/{region_id}/types",
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, Output]{
+	Method: http.MethodGet,
+	Path:   "/markets/{region_id}/types",
+}
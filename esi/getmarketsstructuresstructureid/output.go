@@ -4,18 +4,20 @@ package getmarketsstructuresstructureid
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/typeid"
 )
 
 type Output struct {
-	Duration     int64     `json:"duration"`
-	IsBuyOrder   bool      `json:"is_buy_order"`
-	Issued       time.Time `json:"issued"`
-	LocationId   int64     `json:"location_id"`
-	MinVolume    int64     `json:"min_volume"`
-	OrderId      int64     `json:"order_id"`
-	Price        float64   `json:"price"`
-	Range        string    `json:"range"`
-	TypeId       int64     `json:"type_id"`
-	VolumeRemain int64     `json:"volume_remain"`
-	VolumeTotal  int64     `json:"volume_total"`
+	Duration     int64             `json:"duration"`
+	IsBuyOrder   bool              `json:"is_buy_order"`
+	Issued       time.Time         `json:"issued"`
+	LocationId   int64             `json:"location_id"`
+	MinVolume    int64             `json:"min_volume"`
+	OrderId      int64             `json:"order_id"`
+	Price        float64           `json:"price"`
+	Range        string            `json:"range"`
+	TypeId       typeid.Identifier `json:"type_id"`
+	VolumeRemain int64             `json:"volume_remain"`
+	VolumeTotal  int64             `json:"volume_total"`
 }
@@ -13,3 +13,9 @@ var Request = request.Create[Input, []*Output](
 	"/markets/structures/{structure_id}",
 	request.WithRequiredScope("esi-markets.structure_markets.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/markets/structures/{structure_id}",
+}
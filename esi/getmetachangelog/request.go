@@ -12,3 +12,9 @@ var Request = request.CreateStatic[*Output](
 	http.MethodGet,
 	"/meta/changelog",
 )
+
+// Route identifies the operation with its output type, e.g. to register responses with a fake server.
+var Route = request.Route[struct{}, *Output]{
+	Method: http.MethodGet,
+	Path:   "/meta/changelog",
+}
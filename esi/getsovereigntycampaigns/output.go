@@ -4,22 +4,27 @@ package getsovereigntycampaigns
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/constellation"
+	"github.com/xaroth/lib-esi-go/common/item"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
 )
 
 type Output struct {
-	AttackersScore  *float64       `json:"attackers_score"`
-	CampaignId      int64          `json:"campaign_id"`
-	ConstellationId int64          `json:"constellation_id"`
-	DefenderId      *int64         `json:"defender_id"`
-	DefenderScore   *float64       `json:"defender_score"`
-	EventType       string         `json:"event_type"`
-	Participants    []Participants `json:"participants"`
-	SolarSystemId   int64          `json:"solar_system_id"`
-	StartTime       time.Time      `json:"start_time"`
-	StructureId     int64          `json:"structure_id"`
+	AttackersScore  *float64                 `json:"attackers_score"`
+	CampaignId      int64                    `json:"campaign_id"`
+	ConstellationId constellation.Identifier `json:"constellation_id"`
+	DefenderId      *int64                   `json:"defender_id"`
+	DefenderScore   *float64                 `json:"defender_score"`
+	EventType       string                   `json:"event_type"`
+	Participants    []Participants           `json:"participants"`
+	SolarSystemId   solarsystem.Identifier   `json:"solar_system_id"`
+	StartTime       time.Time                `json:"start_time"`
+	StructureId     item.Identifier          `json:"structure_id"`
 }
 
 type Participants struct {
-	AllianceId int64   `json:"alliance_id"`
-	Score      float64 `json:"score"`
+	AllianceId alliance.Identifier `json:"alliance_id"`
+	Score      float64             `json:"score"`
 }
@@ -2,9 +2,15 @@
 
 package getsovereigntymap
 
+import (
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/corporation"
+	"github.com/xaroth/lib-esi-go/common/faction"
+)
+
 type Output struct {
-	AllianceId    *int64 `json:"alliance_id"`
-	CorporationId *int64 `json:"corporation_id"`
-	FactionId     *int64 `json:"faction_id"`
-	SystemId      int64  `json:"system_id"`
+	AllianceId    *alliance.Identifier    `json:"alliance_id"`
+	CorporationId *corporation.Identifier `json:"corporation_id"`
+	FactionId     *faction.Identifier     `json:"faction_id"`
+	SystemId      int64                   `json:"system_id"`
 }
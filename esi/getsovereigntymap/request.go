@@ -8,4 +8,13 @@ import (
 	"github.com/xaroth/lib-esi-go/request"
 )
 
-var Request = request.CreateStatic[[]*Output](http.MethodGet, "/sovereignty/map")
+var Request = request.CreateStatic[[]*Output](
+	http.MethodGet,
+	"/sovereignty/map",
+)
+
+// Route identifies the operation with its output type, e.g. to register responses with a fake server.
+var Route = request.Route[struct{}, []*Output]{
+	Method: http.MethodGet,
+	Path:   "/sovereignty/map",
+}
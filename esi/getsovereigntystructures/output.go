@@ -4,14 +4,18 @@ package getsovereigntystructures
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/item"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
 )
 
 type Output struct {
-	AllianceId                  int64      `json:"alliance_id"`
-	SolarSystemId               int64      `json:"solar_system_id"`
-	StructureId                 int64      `json:"structure_id"`
-	StructureTypeId             int64      `json:"structure_type_id"`
-	VulnerabilityOccupancyLevel *float64   `json:"vulnerability_occupancy_level"`
-	VulnerableEndTime           *time.Time `json:"vulnerable_end_time"`
-	VulnerableStartTime         *time.Time `json:"vulnerable_start_time"`
+	AllianceId                  alliance.Identifier    `json:"alliance_id"`
+	SolarSystemId               solarsystem.Identifier `json:"solar_system_id"`
+	StructureId                 item.Identifier        `json:"structure_id"`
+	StructureTypeId             int64                  `json:"structure_type_id"`
+	VulnerabilityOccupancyLevel *float64               `json:"vulnerability_occupancy_level"`
+	VulnerableEndTime           *time.Time             `json:"vulnerable_end_time"`
+	VulnerableStartTime         *time.Time             `json:"vulnerable_start_time"`
 }
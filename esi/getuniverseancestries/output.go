@@ -2,11 +2,15 @@
 
 package getuniverseancestries
 
+import (
+	"github.com/xaroth/lib-esi-go/common/bloodline"
+)
+
 type Output struct {
-	BloodlineId      int64   `json:"bloodline_id"`
-	Description      string  `json:"description"`
-	IconId           *int64  `json:"icon_id"`
-	Id               int64   `json:"id"`
-	Name             string  `json:"name"`
-	ShortDescription *string `json:"short_description"`
+	BloodlineId      bloodline.Identifier `json:"bloodline_id"`
+	Description      string               `json:"description"`
+	IconId           *int64               `json:"icon_id"`
+	Id               int64                `json:"id"`
+	Name             string               `json:"name"`
+	ShortDescription *string              `json:"short_description"`
 }
@@ -2,16 +2,22 @@
 
 package getuniversebloodlines
 
+import (
+	"github.com/xaroth/lib-esi-go/common/bloodline"
+	"github.com/xaroth/lib-esi-go/common/corporation"
+	"github.com/xaroth/lib-esi-go/common/race"
+)
+
 type Output struct {
-	BloodlineId   int64  `json:"bloodline_id"`
-	Charisma      int64  `json:"charisma"`
-	CorporationId int64  `json:"corporation_id"`
-	Description   string `json:"description"`
-	Intelligence  int64  `json:"intelligence"`
-	Memory        int64  `json:"memory"`
-	Name          string `json:"name"`
-	Perception    int64  `json:"perception"`
-	RaceId        int64  `json:"race_id"`
-	ShipTypeId    int64  `json:"ship_type_id"`
-	Willpower     int64  `json:"willpower"`
+	BloodlineId   bloodline.Identifier   `json:"bloodline_id"`
+	Charisma      int64                  `json:"charisma"`
+	CorporationId corporation.Identifier `json:"corporation_id"`
+	Description   string                 `json:"description"`
+	Intelligence  int64                  `json:"intelligence"`
+	Memory        int64                  `json:"memory"`
+	Name          string                 `json:"name"`
+	Perception    int64                  `json:"perception"`
+	RaceId        race.Identifier        `json:"race_id"`
+	ShipTypeId    int64                  `json:"ship_type_id"`
+	Willpower     int64                  `json:"willpower"`
 }
@@ -2,12 +2,17 @@
 
 package getuniverseconstellationsconstellationid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/constellation"
+	"github.com/xaroth/lib-esi-go/common/region"
+)
+
 type Output struct {
-	ConstellationId int64    `json:"constellation_id"`
-	Name            string   `json:"name"`
-	Position        Position `json:"position"`
-	RegionId        int64    `json:"region_id"`
-	Systems         []int64  `json:"systems"`
+	ConstellationId constellation.Identifier `json:"constellation_id"`
+	Name            string                   `json:"name"`
+	Position        Position                 `json:"position"`
+	RegionId        region.Identifier        `json:"region_id"`
+	Systems         []int64                  `json:"systems"`
 }
 
 type Position struct {
@@ -2,15 +2,21 @@
 
 package getuniversefactions
 
+import (
+	"github.com/xaroth/lib-esi-go/common/corporation"
+	"github.com/xaroth/lib-esi-go/common/faction"
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+)
+
 type Output struct {
-	CorporationId        *int64  `json:"corporation_id"`
-	Description          string  `json:"description"`
-	FactionId            int64   `json:"faction_id"`
-	IsUnique             bool    `json:"is_unique"`
-	MilitiaCorporationId *int64  `json:"militia_corporation_id"`
-	Name                 string  `json:"name"`
-	SizeFactor           float64 `json:"size_factor"`
-	SolarSystemId        *int64  `json:"solar_system_id"`
-	StationCount         int64   `json:"station_count"`
-	StationSystemCount   int64   `json:"station_system_count"`
+	CorporationId        *corporation.Identifier `json:"corporation_id"`
+	Description          string                  `json:"description"`
+	FactionId            faction.Identifier      `json:"faction_id"`
+	IsUnique             bool                    `json:"is_unique"`
+	MilitiaCorporationId *int64                  `json:"militia_corporation_id"`
+	Name                 string                  `json:"name"`
+	SizeFactor           float64                 `json:"size_factor"`
+	SolarSystemId        *solarsystem.Identifier `json:"solar_system_id"`
+	StationCount         int64                   `json:"station_count"`
+	StationSystemCount   int64                   `json:"station_system_count"`
 }
@@ -2,12 +2,17 @@
 
 package getuniverseplanetsplanetid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/planet"
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	Name     string   `json:"name"`
-	PlanetId int64    `json:"planet_id"`
-	Position Position `json:"position"`
-	SystemId int64    `json:"system_id"`
-	TypeId   int64    `json:"type_id"`
+	Name     string            `json:"name"`
+	PlanetId planet.Identifier `json:"planet_id"`
+	Position Position          `json:"position"`
+	SystemId int64             `json:"system_id"`
+	TypeId   typeid.Identifier `json:"type_id"`
 }
 
 type Position struct {
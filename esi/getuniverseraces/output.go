@@ -2,9 +2,14 @@
 
 package getuniverseraces
 
+import (
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/race"
+)
+
 type Output struct {
-	AllianceId  int64  `json:"alliance_id"`
-	Description string `json:"description"`
-	Name        string `json:"name"`
-	RaceId      int64  `json:"race_id"`
+	AllianceId  alliance.Identifier `json:"alliance_id"`
+	Description string              `json:"description"`
+	Name        string              `json:"name"`
+	RaceId      race.Identifier     `json:"race_id"`
 }
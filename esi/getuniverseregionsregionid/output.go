@@ -2,9 +2,13 @@
 
 package getuniverseregionsregionid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/region"
+)
+
 type Output struct {
-	Constellations []int64 `json:"constellations"`
-	Description    *string `json:"description"`
-	Name           string  `json:"name"`
-	RegionId       int64   `json:"region_id"`
+	Constellations []int64           `json:"constellations"`
+	Description    *string           `json:"description"`
+	Name           string            `json:"name"`
+	RegionId       region.Identifier `json:"region_id"`
 }
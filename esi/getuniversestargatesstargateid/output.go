@@ -2,13 +2,17 @@
 
 package getuniversestargatesstargateid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	Destination Destination `json:"destination"`
-	Name        string      `json:"name"`
-	Position    Position    `json:"position"`
-	StargateId  int64       `json:"stargate_id"`
-	SystemId    int64       `json:"system_id"`
-	TypeId      int64       `json:"type_id"`
+	Destination Destination       `json:"destination"`
+	Name        string            `json:"name"`
+	Position    Position          `json:"position"`
+	StargateId  int64             `json:"stargate_id"`
+	SystemId    int64             `json:"system_id"`
+	TypeId      typeid.Identifier `json:"type_id"`
 }
 
 type Destination struct {
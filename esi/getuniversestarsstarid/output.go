@@ -2,13 +2,18 @@
 
 package getuniversestarsstarid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	Age           int64   `json:"age"`
-	Luminosity    float64 `json:"luminosity"`
-	Name          string  `json:"name"`
-	Radius        int64   `json:"radius"`
-	SolarSystemId int64   `json:"solar_system_id"`
-	SpectralClass string  `json:"spectral_class"`
-	Temperature   int64   `json:"temperature"`
-	TypeId        int64   `json:"type_id"`
+	Age           int64                  `json:"age"`
+	Luminosity    float64                `json:"luminosity"`
+	Name          string                 `json:"name"`
+	Radius        int64                  `json:"radius"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
+	SpectralClass string                 `json:"spectral_class"`
+	Temperature   int64                  `json:"temperature"`
+	TypeId        typeid.Identifier      `json:"type_id"`
 }
@@ -2,12 +2,17 @@
 
 package getuniversestructuresstructureid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/solarsystem"
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
-	Name          string    `json:"name"`
-	OwnerId       int64     `json:"owner_id"`
-	Position      *Position `json:"position"`
-	SolarSystemId int64     `json:"solar_system_id"`
-	TypeId        *int64    `json:"type_id"`
+	Name          string                 `json:"name"`
+	OwnerId       int64                  `json:"owner_id"`
+	Position      *Position              `json:"position"`
+	SolarSystemId solarsystem.Identifier `json:"solar_system_id"`
+	TypeId        *typeid.Identifier     `json:"type_id"`
 }
 
 type Position struct {
@@ -2,6 +2,10 @@
 
 package getuniversetypestypeid
 
+import (
+	"github.com/xaroth/lib-esi-go/common/typeid"
+)
+
 type Output struct {
 	Capacity        *float64          `json:"capacity"`
 	Description     string            `json:"description"`
@@ -17,7 +21,7 @@ type Output struct {
 	PortionSize     *int64            `json:"portion_size"`
 	Published       bool              `json:"published"`
 	Radius          *float64          `json:"radius"`
-	TypeId          int64             `json:"type_id"`
+	TypeId          typeid.Identifier `json:"type_id"`
 	Volume          *float64          `json:"volume"`
 }
 
@@ -4,6 +4,9 @@ package getwarswarid
 
 import (
 	"time"
+
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/corporation"
 )
 
 type Output struct {
@@ -20,20 +23,15 @@ type Output struct {
 }
 
 type Aggressor struct {
-	AllianceId    *int64  `json:"alliance_id"`
-	CorporationId *int64  `json:"corporation_id"`
-	IskDestroyed  float64 `json:"isk_destroyed"`
-	ShipsKilled   int64   `json:"ships_killed"`
+	AllianceId    *alliance.Identifier    `json:"alliance_id"`
+	CorporationId *corporation.Identifier `json:"corporation_id"`
+	IskDestroyed  float64                 `json:"isk_destroyed"`
+	ShipsKilled   int64                   `json:"ships_killed"`
 }
 
 type Allies struct {
-	AllianceId    *int64 `json:"alliance_id"`
-	CorporationId *int64 `json:"corporation_id"`
+	AllianceId    *alliance.Identifier    `json:"alliance_id"`
+	CorporationId *corporation.Identifier `json:"corporation_id"`
 }
 
-type Defender struct {
-	AllianceId    *int64  `json:"alliance_id"`
-	CorporationId *int64  `json:"corporation_id"`
-	IskDestroyed  float64 `json:"isk_destroyed"`
-	ShipsKilled   int64   `json:"ships_killed"`
-}
+type Defender = Aggressor
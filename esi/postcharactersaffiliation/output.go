@@ -2,9 +2,16 @@
 
 package postcharactersaffiliation
 
+import (
+	"github.com/xaroth/lib-esi-go/common/alliance"
+	"github.com/xaroth/lib-esi-go/common/character"
+	"github.com/xaroth/lib-esi-go/common/corporation"
+	"github.com/xaroth/lib-esi-go/common/faction"
+)
+
 type Output struct {
-	AllianceId    *int64 `json:"alliance_id"`
-	CharacterId   int64  `json:"character_id"`
-	CorporationId int64  `json:"corporation_id"`
-	FactionId     *int64 `json:"faction_id"`
+	AllianceId    *alliance.Identifier   `json:"alliance_id"`
+	CharacterId   character.Identifier   `json:"character_id"`
+	CorporationId corporation.Identifier `json:"corporation_id"`
+	FactionId     *faction.Identifier    `json:"faction_id"`
 }
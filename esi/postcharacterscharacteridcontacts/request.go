@@ -13,3 +13,9 @@ var Request = request.Create[Input, Output](
 	"/characters/{character_id}/contacts",
 	request.WithRequiredScope("esi-characters.write_contacts.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, Output]{
+	Method: http.MethodPost,
+	Path:   "/characters/{character_id}/contacts",
+}
@@ -13,3 +13,9 @@ var Request = request.Create[Input, struct{}](
 	"/fleets/{fleet_id}/members",
 	request.WithRequiredScope("esi-fleets.write_fleet.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, struct{}]{
+	Method: http.MethodPost,
+	Path:   "/fleets/{fleet_id}/members",
+}
@@ -13,3 +13,9 @@ var Request = request.Create[Input, *Output](
 	"/fleets/{fleet_id}/wings",
 	request.WithRequiredScope("esi-fleets.write_fleet.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, *Output]{
+	Method: http.MethodPost,
+	Path:   "/fleets/{fleet_id}/wings",
+}
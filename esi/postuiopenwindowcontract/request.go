@@ -13,3 +13,9 @@ var Request = request.Create[Input, struct{}](
 	"/ui/openwindow/contract",
 	request.WithRequiredScope("esi-ui.open_window.v1"),
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, struct{}]{
+	Method: http.MethodPost,
+	Path:   "/ui/openwindow/contract",
+}
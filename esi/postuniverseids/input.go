@@ -2,6 +2,18 @@
 
 package postuniverseids
 
+import (
+	"github.com/xaroth/lib-esi-go/request"
+)
+
 type Input struct {
 	Body []string `body:"json" required:"true"`
 }
+
+// Validate checks the input against the constraints of the spec. Requests call it before they are sent.
+func (i Input) Validate() error {
+	var v request.Validator
+	v.MinItems("body", len(i.Body), 1)
+	v.MaxItems("body", len(i.Body), 500)
+	return v.Err()
+}
@@ -20,47 +20,20 @@ type Agents struct {
 	Name *string `json:"name"`
 }
 
-type Alliances struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Alliances = Agents
 
-type Characters struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Characters = Agents
 
-type Constellations struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Constellations = Agents
 
-type Corporations struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Corporations = Agents
 
-type Factions struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Factions = Agents
 
-type InventoryTypes struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type InventoryTypes = Agents
 
-type Regions struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Regions = Agents
 
-type Stations struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Stations = Agents
 
-type Systems struct {
-	Id   *int64  `json:"id"`
-	Name *string `json:"name"`
-}
+type Systems = Agents
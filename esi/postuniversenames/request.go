@@ -12,3 +12,9 @@ var Request = request.Create[Input, []*Output](
 	http.MethodPost,
 	"/universe/names",
 )
+
+// Route identifies the operation with its input and output types, e.g. to register responses with a fake server.
+var Route = request.Route[Input, []*Output]{
+	Method: http.MethodPost,
+	Path:   "/universe/names",
+}
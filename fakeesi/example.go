@@ -0,0 +1,54 @@
+package fakeesi
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/civil"
+)
+
+// exampleTime is the time of synthesized examples.
+var exampleTime = time.Date(2003, time.May, 6, 11, 0, 0, 0, time.UTC)
+
+const maxExampleDepth = 8
+
+// exampleOf returns a value of the type with every pointer set and every slice holding one element,
+// so its JSON encoding shows every field. Times and dates are set to exampleTime.
+func exampleOf(t reflect.Type) reflect.Value {
+	return fill(t, 0)
+}
+
+func fill(t reflect.Type, depth int) reflect.Value {
+	v := reflect.New(t).Elem()
+	if depth > maxExampleDepth {
+		return v
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(t.Elem())
+		elem.Elem().Set(fill(t.Elem(), depth+1))
+		return elem
+	case reflect.Slice:
+		// Byte slices are raw JSON, which may not be empty.
+		if t.Elem().Kind() == reflect.Uint8 {
+			return v
+		}
+		return reflect.Append(reflect.MakeSlice(t, 0, 1), fill(t.Elem(), depth+1))
+	case reflect.Map:
+		return reflect.MakeMap(t)
+	case reflect.Struct:
+		switch {
+		case t.ConvertibleTo(reflect.TypeFor[time.Time]()):
+			return reflect.ValueOf(exampleTime).Convert(t)
+		case t.ConvertibleTo(reflect.TypeFor[civil.Date]()):
+			return reflect.ValueOf(civil.DateOf(exampleTime)).Convert(t)
+		}
+		for i := range t.NumField() {
+			if t.Field(i).IsExported() {
+				v.Field(i).Set(fill(t.Field(i).Type, depth+1))
+			}
+		}
+	}
+	return v
+}
@@ -0,0 +1,201 @@
+package fakeesi
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/request/esierror"
+)
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// decodeInput decodes the request into the input type of the operation, the way ESI parses its parameters,
+// and returns the violations of the spec. It returns an error if the body is not valid JSON.
+func decodeInput(op *operation, r *http.Request, params map[string]string) ([]esierror.ErrorDetails, error) {
+	typ := op.Route.InputType()
+	if typ.Kind() != reflect.Struct {
+		return nil, nil
+	}
+	input := reflect.New(typ).Elem()
+	query := r.URL.Query()
+
+	var (
+		details      []esierror.ErrorDetails
+		bodyFields   []int
+		bodyRequired bool
+	)
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if _, ok := field.Tag.Lookup("body"); ok {
+			bodyFields = append(bodyFields, i)
+			bodyRequired = bodyRequired || field.Tag.Get("required") == "true"
+			continue
+		}
+
+		for _, in := range []string{"path", "query", "header"} {
+			name, ok := field.Tag.Lookup(in)
+			if !ok {
+				continue
+			}
+			location := in + "." + name
+
+			var values []string
+			switch in {
+			case "path":
+				if value, ok := params[name]; ok {
+					values = []string{value}
+				}
+			case "query":
+				values = query[name]
+			case "header":
+				values = r.Header.Values(name)
+			}
+			if len(values) == 0 {
+				if in == "path" || field.Tag.Get("required") == "true" {
+					details = append(details, esierror.ErrorDetails{
+						Message:  fmt.Sprintf("required %s parameter is missing", in),
+						Location: location,
+					})
+				}
+				continue
+			}
+
+			if err := setValue(input.Field(i), values); err != nil {
+				details = append(details, esierror.ErrorDetails{
+					Message:  err.Error(),
+					Location: location,
+					Value:    strings.Join(values, ","),
+				})
+				continue
+			}
+			if allowed := op.Enums[location]; len(allowed) > 0 {
+				for _, value := range splitValues(values) {
+					if !slices.Contains(allowed, value) {
+						details = append(details, esierror.ErrorDetails{
+							Message:  fmt.Sprintf("expected value to be one of %q", strings.Join(allowed, ", ")),
+							Location: location,
+							Value:    value,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	if len(bodyFields) > 0 {
+		var body []byte
+		if r.Body != nil {
+			var err error
+			if body, err = io.ReadAll(r.Body); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case len(bytes.TrimSpace(body)) == 0:
+			if bodyRequired {
+				details = append(details, esierror.ErrorDetails{Message: "request body is required", Location: "body"})
+			}
+		case !json.Valid(body):
+			return nil, errors.New("invalid request body: malformed JSON")
+		case len(bodyFields) == 1:
+			if err := json.Unmarshal(body, input.Field(bodyFields[0]).Addr().Interface()); err != nil {
+				details = append(details, esierror.ErrorDetails{Message: err.Error(), Location: "body"})
+			}
+		}
+	}
+
+	// The remaining constraints of the spec are checked by the Validate method of the input, if it has one.
+	if len(details) > 0 {
+		return details, nil
+	}
+	if validator, ok := input.Addr().Interface().(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			var validationErr esierror.ValidationError
+			if errors.As(err, &validationErr) {
+				return validationErr.Details, nil
+			}
+			return []esierror.ErrorDetails{{Message: err.Error()}}, nil
+		}
+	}
+	return nil, nil
+}
+
+// splitValues splits comma separated values, as ESI accepts arrays either way.
+func splitValues(values []string) []string {
+	var split []string
+	for _, value := range values {
+		split = append(split, strings.Split(value, ",")...)
+	}
+	return split
+}
+
+// setValue parses the values of a parameter into the field.
+func setValue(v reflect.Value, values []string) error {
+	switch {
+	case v.Kind() == reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		if err := setValue(elem.Elem(), values); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case v.Kind() == reflect.Slice && !v.Type().Implements(textUnmarshalerType):
+		for _, value := range splitValues(values) {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := setScalar(elem, value); err != nil {
+				return err
+			}
+			v.Set(reflect.Append(v, elem))
+		}
+		return nil
+	default:
+		return setScalar(v, values[0])
+	}
+}
+
+func setScalar(v reflect.Value, value string) error {
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("invalid integer")
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("invalid integer")
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return errors.New("invalid number")
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.New("invalid boolean")
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported parameter type %s", v.Type())
+	}
+	return nil
+}
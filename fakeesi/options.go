@@ -0,0 +1,41 @@
+package fakeesi
+
+import (
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+)
+
+type config struct {
+	rateLimitGroups []ratelimiting.RouteGroup
+	now             func() time.Time
+}
+
+type Option func(*config)
+
+func newConfig(opts ...Option) *config {
+	c := &config{
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithRateLimitGroups sets the rate limit groups of the routes, e.g. esi.RateLimitGroups.
+// Responses of routes in a group carry the X-Ratelimit-* headers, and are rejected with
+// 429 Too Many Requests once the bucket of the group is exhausted.
+func WithRateLimitGroups(groups []ratelimiting.RouteGroup) Option {
+	return func(c *config) {
+		c.rateLimitGroups = append(c.rateLimitGroups, groups...)
+	}
+}
+
+// WithNow sets the clock of the server, which the Expires and Last-Modified headers,
+// and the rate limit windows are based on.
+func WithNow(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
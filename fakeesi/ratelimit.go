@@ -0,0 +1,104 @@
+package fakeesi
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+)
+
+// bucket holds the tokens spent in a rate limit group, which are returned once they leave the window.
+type bucket struct {
+	spent []spend
+}
+
+type spend struct {
+	at     time.Time
+	tokens int
+}
+
+// used drops the tokens that left the window, and returns the number of tokens spent within it.
+func (b *bucket) used(now time.Time, window time.Duration) int {
+	for len(b.spent) > 0 && !b.spent[0].at.Add(window).After(now) {
+		b.spent = b.spent[1:]
+	}
+	used := 0
+	for _, s := range b.spent {
+		used += s.tokens
+	}
+	return used
+}
+
+// retryAfter returns how long until the given number of tokens are available again.
+func (b *bucket) retryAfter(now time.Time, window time.Duration, available, tokens int) time.Duration {
+	for _, s := range b.spent {
+		available += s.tokens
+		if available >= tokens {
+			return s.at.Add(window).Sub(now)
+		}
+	}
+	return window
+}
+
+// tokenCost returns the number of tokens ESI charges for a response with the status.
+func tokenCost(status int) int {
+	switch {
+	case status >= 500:
+		return 0
+	case status == http.StatusTooManyRequests:
+		return 0
+	case status >= 400:
+		return 5
+	case status >= 300:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// rateLimit charges the response to the bucket of the group, and sets the X-Ratelimit-* headers.
+// Responses that do not fit in the bucket are replaced by 429 Too Many Requests.
+func (s *Server) rateLimit(group *ratelimiting.RouteGroup, resp response, now time.Time) response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[group.Group]
+	if !ok {
+		b = &bucket{}
+		s.buckets[group.Group] = b
+	}
+
+	remaining := group.BucketSize - b.used(now, group.WindowSize)
+	cost := tokenCost(resp.status)
+	if cost > remaining {
+		wait := b.retryAfter(now, group.WindowSize, remaining, cost)
+		date := resp.header.Get("Date")
+		resp = errorResponse(http.StatusTooManyRequests, "Too many requests", nil)
+		resp.header.Set("Date", date)
+		resp.header.Set(ratelimiting.RetryAfterKey, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		cost = 0
+	}
+	if cost > 0 {
+		b.spent = append(b.spent, spend{at: now, tokens: cost})
+	}
+
+	resp.header.Set(ratelimiting.RateLimitGroupKey, group.Group)
+	resp.header.Set(ratelimiting.RateLimitLimitKey, strconv.Itoa(group.BucketSize)+"/"+formatWindow(group.WindowSize))
+	resp.header.Set(ratelimiting.RateLimitRemainingKey, strconv.Itoa(remaining-cost))
+	resp.header.Set("X-Ratelimit-Used", strconv.Itoa(cost))
+	return resp
+}
+
+// formatWindow formats a window the way ESI does, e.g. 15m or 1h.
+func formatWindow(window time.Duration) string {
+	switch {
+	case window%time.Hour == 0:
+		return strconv.Itoa(int(window/time.Hour)) + "h"
+	case window%time.Minute == 0:
+		return strconv.Itoa(int(window/time.Minute)) + "m"
+	default:
+		return strconv.Itoa(int(math.Ceil(window.Seconds()))) + "s"
+	}
+}
@@ -0,0 +1,393 @@
+// Package fakeesi is a fake ESI server for tests. It serves the generated operations it is given,
+// validates requests against their inputs, and answers with registered or example responses,
+// along with the pagination, caching and rate limit headers of ESI.
+//
+// The generator emits the operations of the spec into the esitest package next to the operation packages:
+//
+//	srv := esitest.NewServer()
+//	srv.On(getmarketsregionidorders.Route).Return([]*getmarketsregionidorders.Output{{OrderId: 1}}).Pages(3)
+//
+//	resp, err := getmarketsregionidorders.Request(ctx, srv, &getmarketsregionidorders.Input{RegionId: 10000002, OrderType: "all"})
+//
+// The server is a request.RequestSender, an http.RoundTripper to use below middleware, and an http.Handler
+// for httptest.NewServer.
+package fakeesi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+	"github.com/xaroth/lib-esi-go/request/esierror"
+)
+
+// Route identifies an operation. It is implemented by the request.Route of every generated package.
+type Route interface {
+	// String returns the route formatted as "METHOD /path".
+	String() string
+	InputType() reflect.Type
+	OutputType() reflect.Type
+}
+
+// Operation describes an operation to the server.
+type Operation struct {
+	Route Route
+
+	// The status of successful responses, e.g. 200, 201 or 204. Defaults to 200.
+	Status int
+
+	// The number of seconds responses are cached for, from x-cached-seconds. Zero if not cached.
+	CacheSeconds int
+
+	// The allowed values of enum parameters by location, e.g. query.order_type.
+	Enums map[string][]string
+
+	// The JSON body returned when no response is registered.
+	// When empty, an example is synthesized from the output type.
+	Example string
+}
+
+type operation struct {
+	Operation
+	method    string
+	segments  []string
+	paginated bool
+	group     *ratelimiting.RouteGroup
+	example   []byte
+}
+
+// response is what the server answers a request with.
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// Server is a fake ESI server.
+type Server struct {
+	cfg        *config
+	operations []*operation
+	routes     map[string]*operation
+
+	mu      sync.Mutex
+	calls   map[string]*Call
+	counts  map[string]int
+	buckets map[string]*bucket
+}
+
+// NewServer creates a server for the given operations.
+func NewServer(operations []Operation, opts ...Option) *Server {
+	cfg := newConfig(opts...)
+	groups := make(map[string]*ratelimiting.RouteGroup, len(cfg.rateLimitGroups))
+	for i := range cfg.rateLimitGroups {
+		groups[cfg.rateLimitGroups[i].Route] = &cfg.rateLimitGroups[i]
+	}
+
+	s := &Server{
+		cfg:     cfg,
+		routes:  make(map[string]*operation, len(operations)),
+		calls:   make(map[string]*Call),
+		counts:  make(map[string]int),
+		buckets: make(map[string]*bucket),
+	}
+	for _, o := range operations {
+		route := o.Route.String()
+		method, path, _ := strings.Cut(route, " ")
+		op := &operation{
+			Operation: o,
+			method:    method,
+			segments:  strings.Split(strings.Trim(path, "/"), "/"),
+			paginated: hasPageParameter(o.Route.InputType()),
+			group:     groups[route],
+		}
+		if op.Status == 0 {
+			op.Status = http.StatusOK
+		}
+		if o.Example != "" {
+			op.example = []byte(o.Example)
+		} else if body, err := json.Marshal(exampleOf(o.Route.OutputType()).Interface()); err == nil {
+			op.example = body
+		}
+		s.operations = append(s.operations, op)
+		s.routes[route] = op
+	}
+	return s
+}
+
+// On registers a response for the route, replacing any registered before. It panics if the server does not
+// serve the route. Until a response is registered, the route answers with the example of the operation.
+func (s *Server) On(route Route) *Call {
+	op, ok := s.routes[route.String()]
+	if !ok {
+		panic(fmt.Sprintf("fakeesi: unknown route %s", route))
+	}
+
+	call := &Call{mu: &s.mu, op: op}
+	s.mu.Lock()
+	s.calls[route.String()] = call
+	s.mu.Unlock()
+	return call
+}
+
+// Calls returns the number of requests the route has served, including rejected ones.
+func (s *Server) Calls(route Route) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counts[route.String()]
+}
+
+// Reset removes the registered responses, request counts and spent rate limit tokens.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.calls)
+	clear(s.counts)
+	clear(s.buckets)
+}
+
+// Do serves the request, so the server can be passed to generated requests as their sender.
+func (s *Server) Do(req *http.Request) (*http.Response, error) {
+	return s.RoundTrip(req)
+}
+
+// RoundTrip serves the request, so the server can be used as the transport below middleware.
+func (s *Server) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	resp := rec.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp := s.serve(r)
+	for key, values := range resp.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(resp.status)
+	if len(resp.body) > 0 && r.Method != http.MethodHead {
+		_, _ = w.Write(resp.body)
+	}
+}
+
+func (s *Server) serve(r *http.Request) response {
+	op, params, status := s.match(r.Method, r.URL.Path)
+	if op == nil {
+		return errorResponse(status, http.StatusText(status), nil)
+	}
+	route := op.Route.String()
+
+	s.mu.Lock()
+	s.counts[route]++
+	var call Call
+	if registered, ok := s.calls[route]; ok {
+		call = *registered
+		call.header = registered.header.Clone()
+	}
+	s.mu.Unlock()
+
+	now := s.cfg.now()
+	resp := s.respond(op, r, params, call, now)
+	resp.header.Set("Date", now.UTC().Format(http.TimeFormat))
+	if op.group != nil {
+		resp = s.rateLimit(op.group, resp, now)
+	}
+	return resp
+}
+
+// match finds the operation serving the path, preferring literal segments over parameters.
+// It returns the status to answer with if there is none.
+func (s *Server) match(method, path string) (*operation, map[string]string, int) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var (
+		best       *operation
+		bestParams map[string]string
+		bestScore  = -1
+		pathFound  bool
+	)
+	for _, op := range s.operations {
+		params, score, ok := matchSegments(op.segments, segments)
+		if !ok {
+			continue
+		}
+		pathFound = true
+		if op.method != method || score <= bestScore {
+			continue
+		}
+		best, bestParams, bestScore = op, params, score
+	}
+	switch {
+	case best != nil:
+		return best, bestParams, 0
+	case pathFound:
+		return nil, nil, http.StatusMethodNotAllowed
+	default:
+		return nil, nil, http.StatusNotFound
+	}
+}
+
+// matchSegments matches a path against a pattern, returning its parameters and the number of literal segments.
+func matchSegments(pattern, segments []string) (map[string]string, int, bool) {
+	if len(pattern) != len(segments) {
+		return nil, 0, false
+	}
+	params := make(map[string]string)
+	literals := 0
+	for i, p := range pattern {
+		if name, ok := strings.CutPrefix(p, "{"); ok && strings.HasSuffix(name, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			params[strings.TrimSuffix(name, "}")] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
+
+func (s *Server) respond(op *operation, r *http.Request, params map[string]string, call Call, now time.Time) response {
+	details, err := decodeInput(op, r, params)
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, err.Error(), nil)
+	}
+	if len(details) > 0 {
+		return errorResponse(http.StatusUnprocessableEntity, "validation failed", details)
+	}
+
+	if call.status != 0 {
+		return errorResponse(call.status, call.message, nil)
+	}
+
+	pages := max(call.pages, 1)
+	if op.paginated {
+		if page, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && page > pages {
+			return errorResponse(http.StatusNotFound, "Requested page does not exist!", nil)
+		}
+	}
+
+	resp := response{status: op.Status, header: make(http.Header)}
+	if op.Status != http.StatusNoContent {
+		resp.body = op.example
+		if call.body != nil {
+			resp.body = call.body
+		}
+		resp.header.Set("Content-Type", "application/json")
+	}
+	if op.paginated {
+		resp.header.Set("X-Pages", strconv.Itoa(pages))
+	}
+	for key, values := range call.header {
+		resp.header[key] = values
+	}
+
+	if r.Method != http.MethodGet {
+		return resp
+	}
+	sum := sha256.Sum256(resp.body)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	resp.header.Set("ETag", etag)
+	resp.header.Set("Last-Modified", now.UTC().Format(http.TimeFormat))
+	if op.CacheSeconds > 0 {
+		resp.header.Set("Expires", now.Add(time.Duration(op.CacheSeconds)*time.Second).UTC().Format(http.TimeFormat))
+	}
+	if r.Header.Get("If-None-Match") == etag {
+		resp.status = http.StatusNotModified
+		resp.body = nil
+		resp.header.Del("Content-Type")
+	}
+	return resp
+}
+
+func errorResponse(status int, message string, details []esierror.ErrorDetails) response {
+	body, _ := json.Marshal(esierror.ErrorData{ErrorMessage: message, Details: details})
+	return response{
+		status: status,
+		header: http.Header{"Content-Type": {"application/json"}},
+		body:   body,
+	}
+}
+
+// hasPageParameter reports whether an input type has a page query parameter.
+func hasPageParameter(input reflect.Type) bool {
+	if input.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range input.NumField() {
+		if input.Field(i).Tag.Get("query") == "page" {
+			return true
+		}
+	}
+	return false
+}
+
+// Call is a response registered for a route with Server.On.
+type Call struct {
+	mu *sync.Mutex
+	op *operation
+
+	body    []byte
+	pages   int
+	header  http.Header
+	status  int
+	message string
+}
+
+// Return sets the data the route responds with. It panics if the data is not of the output type of the route.
+func (c *Call) Return(data any) *Call {
+	if data != nil && !reflect.TypeOf(data).AssignableTo(c.op.Route.OutputType()) {
+		panic(fmt.Sprintf("fakeesi: %s returns %s, not %T", c.op.Route, c.op.Route.OutputType(), data))
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		panic(fmt.Sprintf("fakeesi: %s: %v", c.op.Route, err))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.body = bytes.TrimSpace(body)
+	c.status, c.message = 0, ""
+	return c
+}
+
+// ReturnError makes the route respond with an ESI error.
+func (c *Call) ReturnError(status int, message string) *Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.status, c.message = status, message
+	return c
+}
+
+// Pages sets the number of pages of a paginated route, reported in the X-Pages header.
+// Every page returns the same data, and requests for later pages are answered with 404 Not Found.
+func (c *Call) Pages(n int) *Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pages = n
+	return c
+}
+
+// Header sets a header on the responses of the route, overriding the headers of the server.
+func (c *Call) Header(key, value string) *Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.header == nil {
+		c.header = make(http.Header)
+	}
+	c.header.Set(key, value)
+	return c
+}
@@ -0,0 +1,400 @@
+package fakeesi_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/esi/deletecharacterscharacteridfittingsfittingid"
+	"github.com/xaroth/lib-esi-go/esi/getalliancesallianceid"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsregionidorders"
+	"github.com/xaroth/lib-esi-go/esi/postuniverseids"
+	"github.com/xaroth/lib-esi-go/fakeesi"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+	"github.com/xaroth/lib-esi-go/request"
+	"github.com/xaroth/lib-esi-go/request/esierror"
+)
+
+var now = time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+func newServer(opts ...fakeesi.Option) *fakeesi.Server {
+	opts = append([]fakeesi.Option{fakeesi.WithNow(func() time.Time { return now })}, opts...)
+	return fakeesi.NewServer([]fakeesi.Operation{
+		{
+			Route:        getmarketsregionidorders.Route,
+			CacheSeconds: 300,
+			Enums:        map[string][]string{"query.order_type": {"buy", "sell", "all"}},
+		},
+		{
+			Route:   getalliancesallianceid.Route,
+			Example: `{"name": "Example Alliance", "ticker": "EXA"}`,
+		},
+		{Route: postuniverseids.Route},
+		{Route: deletecharacterscharacteridfittingsfittingid.Route, Status: http.StatusNoContent},
+	}, opts...)
+}
+
+func TestServer_registered(t *testing.T) {
+	srv := newServer()
+	orders := []*getmarketsregionidorders.Output{{OrderId: 1, Price: 4.5}, {OrderId: 2, IsBuyOrder: true}}
+	srv.On(getmarketsregionidorders.Route).Return(orders).Pages(3)
+
+	page := int32(2)
+	input := &getmarketsregionidorders.Input{RegionId: 10000002, OrderType: "all", Page: &page}
+	resp, err := getmarketsregionidorders.Request(t.Context(), srv, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Pages") != "3" {
+		t.Errorf("unexpected response %d with X-Pages %q", resp.StatusCode, resp.Header.Get("X-Pages"))
+	}
+	if diff := cmp.Diff(orders, resp.Data); diff != "" {
+		t.Errorf("data mismatch (-want +got):\n%s", diff)
+	}
+
+	page = 4
+	resp, err = getmarketsregionidorders.Request(t.Context(), srv, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNotFound || resp.ErrorData == nil || resp.ErrorData.ErrorMessage != "Requested page does not exist!" {
+		t.Errorf("unexpected response %d %+v for a page beyond X-Pages", resp.StatusCode, resp.ErrorData)
+	}
+	if calls := srv.Calls(getmarketsregionidorders.Route); calls != 2 {
+		t.Errorf("Calls() = %d, want 2", calls)
+	}
+
+	srv.On(getmarketsregionidorders.Route).ReturnError(http.StatusServiceUnavailable, "The datasource tranquility is temporarily unavailable")
+	resp, err = getmarketsregionidorders.Request(t.Context(), srv, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.ErrorData.ErrorMessage != "The datasource tranquility is temporarily unavailable" {
+		t.Errorf("unexpected response %d %+v", resp.StatusCode, resp.ErrorData)
+	}
+
+	srv.Reset()
+	if calls := srv.Calls(getmarketsregionidorders.Route); calls != 0 {
+		t.Errorf("Calls() = %d after Reset, want 0", calls)
+	}
+}
+
+func TestServer_examples(t *testing.T) {
+	srv := newServer()
+
+	alliance, err := getalliancesallianceid.Request(t.Context(), srv, &getalliancesallianceid.Input{Alliance: 99000001})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alliance.Data.Name != "Example Alliance" || alliance.Data.Ticker != "EXA" {
+		t.Errorf("unexpected alliance %+v", alliance.Data)
+	}
+
+	// Without an example, one is synthesized from the output type.
+	orders, err := getmarketsregionidorders.Request(t.Context(), srv, &getmarketsregionidorders.Input{RegionId: 10000002, OrderType: "sell"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders.Data) != 1 || orders.Data[0].Issued.IsZero() {
+		t.Errorf("unexpected orders %+v", orders.Data)
+	}
+	if orders.Header.Get("X-Pages") != "1" {
+		t.Errorf("X-Pages = %q, want 1", orders.Header.Get("X-Pages"))
+	}
+
+	deleted, err := deletecharacterscharacteridfittingsfittingid.Request(t.Context(), srv, &deletecharacterscharacteridfittingsfittingid.Input{Character: 90000001, FittingId: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode != http.StatusNoContent || deleted.Header.Get("Content-Type") != "" {
+		t.Errorf("unexpected response %d with Content-Type %q", deleted.StatusCode, deleted.Header.Get("Content-Type"))
+	}
+}
+
+func TestServer_validation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		status   int
+		expected esierror.ErrorData
+	}{
+		{
+			name:     "missing required parameter",
+			method:   http.MethodGet,
+			target:   "/markets/10000002/orders",
+			status:   http.StatusUnprocessableEntity,
+			expected: esierror.ErrorData{ErrorMessage: "validation failed", Details: []esierror.ErrorDetails{{Message: "required query parameter is missing", Location: "query.order_type"}}},
+		},
+		{
+			name:     "invalid path parameter",
+			method:   http.MethodGet,
+			target:   "/markets/forge/orders?order_type=all",
+			status:   http.StatusUnprocessableEntity,
+			expected: esierror.ErrorData{ErrorMessage: "validation failed", Details: []esierror.ErrorDetails{{Message: "invalid integer", Location: "path.region_id", Value: "forge"}}},
+		},
+		{
+			name:     "enum",
+			method:   http.MethodGet,
+			target:   "/markets/10000002/orders?order_type=some",
+			status:   http.StatusUnprocessableEntity,
+			expected: esierror.ErrorData{ErrorMessage: "validation failed", Details: []esierror.ErrorDetails{{Message: `expected value to be one of "buy, sell, all"`, Location: "query.order_type", Value: "some"}}},
+		},
+		{
+			name:     "missing body",
+			method:   http.MethodPost,
+			target:   "/universe/ids",
+			status:   http.StatusUnprocessableEntity,
+			expected: esierror.ErrorData{ErrorMessage: "validation failed", Details: []esierror.ErrorDetails{{Message: "request body is required", Location: "body"}}},
+		},
+		{
+			name:     "malformed body",
+			method:   http.MethodPost,
+			target:   "/universe/ids",
+			body:     `["Jita"`,
+			status:   http.StatusBadRequest,
+			expected: esierror.ErrorData{ErrorMessage: "invalid request body: malformed JSON"},
+		},
+		{
+			name:     "unknown path",
+			method:   http.MethodGet,
+			target:   "/markets/10000002/history",
+			status:   http.StatusNotFound,
+			expected: esierror.ErrorData{ErrorMessage: "Not Found"},
+		},
+		{
+			name:     "unknown method",
+			method:   http.MethodDelete,
+			target:   "/universe/ids",
+			status:   http.StatusMethodNotAllowed,
+			expected: esierror.ErrorData{ErrorMessage: "Method Not Allowed"},
+		},
+	}
+
+	srv := newServer()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var body io.Reader
+			if testCase.body != "" {
+				body = strings.NewReader(testCase.body)
+			}
+			resp, err := srv.Do(httptest.NewRequest(testCase.method, testCase.target, body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != testCase.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, testCase.status)
+			}
+			var errData esierror.ErrorData
+			if err := json.NewDecoder(resp.Body).Decode(&errData); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(testCase.expected, errData); diff != "" {
+				t.Errorf("error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// namesInput has a Validate method like the ones generated for inputs with constraints in the spec.
+type namesInput struct {
+	Body []string `body:"json" required:"true"`
+}
+
+func (i namesInput) Validate() error {
+	var v request.Validator
+	v.MinItems("body", len(i.Body), 1)
+	return v.Err()
+}
+
+func TestServer_validateMethod(t *testing.T) {
+	srv := fakeesi.NewServer([]fakeesi.Operation{
+		{Route: request.Route[namesInput, *postuniverseids.Output]{Method: http.MethodPost, Path: "/universe/ids"}},
+	})
+
+	resp, err := srv.Do(httptest.NewRequest(http.MethodPost, "/universe/ids", strings.NewReader(`[]`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+	var errData esierror.ErrorData
+	if err := json.NewDecoder(resp.Body).Decode(&errData); err != nil {
+		t.Fatal(err)
+	}
+	expected := esierror.ErrorData{ErrorMessage: "validation failed", Details: []esierror.ErrorDetails{{Message: "expected array length >= 1", Location: "body", Value: "0"}}}
+	if diff := cmp.Diff(expected, errData); diff != "" {
+		t.Errorf("error mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestServer_caching(t *testing.T) {
+	srv := newServer()
+	input := &getmarketsregionidorders.Input{RegionId: 10000002, OrderType: "buy"}
+
+	resp, err := getmarketsregionidorders.Request(t.Context(), srv, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag := resp.Header.Get("ETag")
+	if !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+		t.Errorf("ETag = %q, want a quoted tag", etag)
+	}
+	if expires := resp.Header.Get("Expires"); expires != "Mon, 19 Oct 2026 12:05:00 GMT" {
+		t.Errorf("Expires = %q", expires)
+	}
+	if modified := resp.Header.Get("Last-Modified"); modified != "Mon, 19 Oct 2026 12:00:00 GMT" {
+		t.Errorf("Last-Modified = %q", modified)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/markets/10000002/orders?order_type=buy", nil)
+	req.Header.Set("If-None-Match", etag)
+	notModified, err := srv.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if notModified.StatusCode != http.StatusNotModified || notModified.Header.Get("ETag") != etag {
+		t.Errorf("unexpected response %d with ETag %q", notModified.StatusCode, notModified.Header.Get("ETag"))
+	}
+
+	// Operations that are not cached have no Expires header.
+	alliance, err := getalliancesallianceid.Request(t.Context(), srv, &getalliancesallianceid.Input{Alliance: 99000001})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alliance.Header.Get("Expires") != "" || alliance.Header.Get("ETag") == "" {
+		t.Errorf("unexpected headers %v", alliance.Header)
+	}
+}
+
+func TestServer_rateLimit(t *testing.T) {
+	clock := now
+	srv := newServer(
+		fakeesi.WithNow(func() time.Time { return clock }),
+		fakeesi.WithRateLimitGroups([]ratelimiting.RouteGroup{
+			{Route: getalliancesallianceid.Route.String(), Group: "alliance", BucketSize: 5, WindowSize: 15 * time.Minute},
+		}),
+	)
+	input := &getalliancesallianceid.Input{Alliance: 99000001}
+
+	type result struct {
+		Status     int
+		Remaining  string
+		Used       string
+		RetryAfter string
+	}
+	var results []result
+	request := func() {
+		resp, err := getalliancesallianceid.Request(t.Context(), srv, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Header.Get(ratelimiting.RateLimitGroupKey) != "alliance" || resp.Header.Get(ratelimiting.RateLimitLimitKey) != "5/15m" {
+			t.Errorf("unexpected rate limit headers %v", resp.Header)
+		}
+		results = append(results, result{
+			Status:     resp.StatusCode,
+			Remaining:  resp.Header.Get(ratelimiting.RateLimitRemainingKey),
+			Used:       resp.Header.Get("X-Ratelimit-Used"),
+			RetryAfter: resp.Header.Get(ratelimiting.RetryAfterKey),
+		})
+	}
+	request()
+	clock = clock.Add(time.Minute)
+	request()
+	request()
+	clock = now.Add(15 * time.Minute)
+	request()
+
+	expected := []result{
+		{Status: http.StatusOK, Remaining: "3", Used: "2"},
+		{Status: http.StatusOK, Remaining: "1", Used: "2"},
+		{Status: http.StatusTooManyRequests, Remaining: "1", Used: "0", RetryAfter: "840"},
+		{Status: http.StatusOK, Remaining: "1", Used: "2"},
+	}
+	if diff := cmp.Diff(expected, results); diff != "" {
+		t.Errorf("results mismatch (-want +got):\n%s", diff)
+	}
+
+	// Routes without a group have no rate limit headers.
+	resp, err := getmarketsregionidorders.Request(t.Context(), srv, &getmarketsregionidorders.Input{RegionId: 10000002, OrderType: "all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Header.Get(ratelimiting.RateLimitGroupKey) != "" {
+		t.Errorf("unexpected rate limit headers %v", resp.Header)
+	}
+}
+
+func TestServer_handler(t *testing.T) {
+	srv := newServer()
+	srv.On(getalliancesallianceid.Route).Return(&getalliancesallianceid.Output{Name: "Handler Alliance"}).Header("X-Esi-Request-Id", "test")
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/alliances/99000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var alliance getalliancesallianceid.Output
+	if err := json.NewDecoder(resp.Body).Decode(&alliance); err != nil {
+		t.Fatal(err)
+	}
+	if alliance.Name != "Handler Alliance" || resp.Header.Get("X-Esi-Request-Id") != "test" {
+		t.Errorf("unexpected response %+v with headers %v", alliance, resp.Header)
+	}
+}
+
+func TestServer_panics(t *testing.T) {
+	testCases := []struct {
+		name   string
+		invoke func(srv *fakeesi.Server)
+	}{
+		{
+			name: "output of another route",
+			invoke: func(srv *fakeesi.Server) {
+				srv.On(getalliancesallianceid.Route).Return([]*getmarketsregionidorders.Output{})
+			},
+		},
+		{
+			name: "unknown route",
+			invoke: func(srv *fakeesi.Server) {
+				srv.On(fakeRoute{})
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			testCase.invoke(newServer())
+		})
+	}
+}
+
+// fakeRoute is a route the server does not serve.
+type fakeRoute struct{}
+
+func (fakeRoute) String() string { return "GET /alliances/{alliance_id}/icons" }
+
+func (fakeRoute) InputType() reflect.Type { return nil }
+
+func (fakeRoute) OutputType() reflect.Type { return nil }
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"go/format"
 	"strconv"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/civil"
 )
 
 const generatedBy = "// Code generated by cmd/generate-common-models; DO NOT EDIT.\n\n"
@@ -18,9 +21,15 @@ func GeneratePackage(m Model, modulePath, importBase string) (mainGo []byte, tes
 	case m.Schema.Type == "string" && m.Schema.Format == "uuid":
 		src, err = renderStringAlias(m)
 	case m.Schema.Type == "string" && m.Schema.Format == "date":
-		src, err = renderStringAlias(m)
+		src, err = renderTime("date", m, modulePath)
+	case m.Schema.Type == "string" && m.Schema.Format == "date-time":
+		src, err = renderTime("date_time", m, modulePath)
+	case m.Schema.Type == "string" && m.Schema.Format == "duration":
+		src, err = renderTime("duration", m, modulePath)
 	case m.Schema.Type == "string" && len(m.Schema.Enum) > 0:
 		src, err = renderEnum(m, modulePath)
+	case isObjectSchema(m.Schema):
+		src, err = renderStruct(m, modulePath, importBase)
 	default:
 		return nil, nil, fmt.Errorf("%s: unsupported common model shape type=%q format=%q", m.SchemaName, m.Schema.Type, m.Schema.Format)
 	}
@@ -56,6 +65,15 @@ func renderStringAlias(m Model) (string, error) {
 	return ExecuteTemplate("string_alias", m)
 }
 
+// renderTime renders date, date-time and duration models as types based on civil.Date, time.Time and civil.Duration.
+func renderTime(name string, m Model, modulePath string) (string, error) {
+	return ExecuteTemplate(name, timeTemplateData{
+		Package:    m.Package,
+		TypeName:   m.TypeName,
+		ModulePath: modulePath,
+	})
+}
+
 func renderEnum(m Model, modulePath string) (string, error) {
 	enum := m.Schema.Enum
 	descs := m.Schema.XEnumDescriptions
@@ -102,37 +120,61 @@ func renderTest(m Model, modulePath, importBase string) (string, error) {
 	importPath := modulePath + "/" + importBase + "/" + m.Package
 	qualifiedType := m.Package + "." + m.TypeName
 
-	cases := make([]testCaseData, 0, len(m.Examples))
+	data := testTemplateData{
+		TestPackage: m.Package + "_test",
+		ImportPath:  importPath,
+	}
+	switch m.Schema.Format {
+	case "date", "duration":
+		data.Imports = []string{modulePath + "/civil"}
+	case "date-time":
+		data.StdImports = []string{"time"}
+	}
+
 	for i, ex := range m.Examples {
 		inputJSON, wantConv, err := exampleToTest(m, ex)
 		if err != nil {
 			return "", err
 		}
-		cases = append(cases, testCaseData{
+		data.Cases = append(data.Cases, testCaseData{
 			TypeName:      m.TypeName,
 			Index:         i,
 			InputJSON:     inputJSON,
 			QualifiedType: qualifiedType,
 			WantConv:      wantConv,
+			TimeEqual:     m.Schema.Format == "date-time",
 		})
 	}
 
-	return ExecuteTemplate("test", testTemplateData{
-		TestPackage: m.Package + "_test",
-		ImportPath:  importPath,
-		Cases:       cases,
-	})
+	return ExecuteTemplate("test", data)
 }
 
 func exampleToTest(m Model, ex any) (inputJSON, wantConv string, err error) {
 	qualifiedType := m.Package + "." + m.TypeName
 	switch {
+	case isObjectSchema(m.Schema):
+		// Structs are compared by their JSON, as they may hold pointers and slices.
+		want, err := canonicalExample(m, ex)
+		if err != nil {
+			return "", "", err
+		}
+		return "`" + want + "`", "", nil
 	case m.Schema.Type == "integer" && m.Schema.Format == "int64":
 		n, err := toInt64(ex)
 		if err != nil {
 			return "", "", err
 		}
 		return "`" + strconv.FormatInt(n, 10) + "`", fmt.Sprintf("%s(%d)", qualifiedType, n), nil
+	case m.Schema.Type == "string" && (m.Schema.Format == "date" || m.Schema.Format == "date-time" || m.Schema.Format == "duration"):
+		s, ok := ex.(string)
+		if !ok {
+			return "", "", fmt.Errorf("expected string example, got %T", ex)
+		}
+		want, err := timeExample(m.Schema.Format, s)
+		if err != nil {
+			return "", "", fmt.Errorf("%s example: %w", m.SchemaName, err)
+		}
+		return "`" + strconv.Quote(s) + "`", fmt.Sprintf("%s(%s)", qualifiedType, want), nil
 	case m.Schema.Type == "string":
 		s, ok := ex.(string)
 		if !ok {
@@ -148,6 +190,29 @@ func exampleToTest(m Model, ex any) (inputJSON, wantConv string, err error) {
 	}
 }
 
+// timeExample returns the Go expression of a date, date-time or duration example.
+func timeExample(format, s string) (string, error) {
+	switch format {
+	case "date":
+		d, err := civil.ParseDate(s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("civil.Date{Year: %d, Month: %d, Day: %d}", d.Year, d.Month, d.Day), nil
+	case "duration":
+		d, err := civil.ParseDuration(s)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("civil.Duration(%d)", int64(d)), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("time.Unix(%d, %d)", t.Unix(), t.Nanosecond()), nil
+}
+
 func toInt64(ex any) (int64, error) {
 	switch v := ex.(type) {
 	case float64:
@@ -1,6 +1,7 @@
 package commonmodels_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -69,3 +70,241 @@ func TestGeneratePackage_uuid(t *testing.T) {
 		t.Errorf("main missing String(): %s", mainGo)
 	}
 }
+
+func TestGeneratePackage_dates(t *testing.T) {
+	testCases := []struct {
+		name    string
+		model   commonmodels.Model
+		main    []string
+		test    []string
+		wantErr bool
+	}{
+		{
+			name: "date",
+			model: commonmodels.Model{
+				SchemaName: "CompatibilityDate",
+				Package:    "compatibilitydate",
+				TypeName:   "CompatibilityDate",
+				Schema:     openapi.Schema{Type: "string", Format: "date"},
+				Examples:   []any{"2025-08-26"},
+			},
+			main: []string{
+				`"github.com/xaroth/lib-esi-go/civil"`,
+				"type CompatibilityDate civil.Date",
+				"func Parse(s string) (CompatibilityDate, error)",
+				"func (v CompatibilityDate) Time() time.Time",
+				"func (v *CompatibilityDate) UnmarshalText(data []byte) error",
+			},
+			test: []string{
+				`"github.com/xaroth/lib-esi-go/civil"`,
+				"v != compatibilitydate.CompatibilityDate(civil.Date{Year: 2025, Month: 8, Day: 26})",
+			},
+		},
+		{
+			name: "date-time",
+			model: commonmodels.Model{
+				SchemaName: "Timestamp",
+				Package:    "timestamp",
+				TypeName:   "Timestamp",
+				Schema:     openapi.Schema{Type: "string", Format: "date-time"},
+				Examples:   []any{"2016-06-26T21:00:00+02:00"},
+			},
+			main: []string{
+				"type Timestamp time.Time",
+				"func (v Timestamp) Compare(other Timestamp) int",
+			},
+			test: []string{
+				`"time"`,
+				"!v.Time().Equal(timestamp.Timestamp(time.Unix(1466967600, 0)).Time())",
+			},
+		},
+		{
+			name: "duration",
+			model: commonmodels.Model{
+				SchemaName: "Timeout",
+				Package:    "timeout",
+				TypeName:   "Timeout",
+				Schema:     openapi.Schema{Type: "string", Format: "duration"},
+				Examples:   []any{"PT1H30M"},
+			},
+			main: []string{
+				`"github.com/xaroth/lib-esi-go/civil"`,
+				"type Timeout civil.Duration",
+				"func (v Timeout) Duration() time.Duration",
+				"func (v *Timeout) UnmarshalText(data []byte) error",
+			},
+			test: []string{
+				`"github.com/xaroth/lib-esi-go/civil"`,
+				"v != timeout.Timeout(civil.Duration(5400000000000))",
+			},
+		},
+		{
+			name: "error: invalid duration example",
+			model: commonmodels.Model{
+				SchemaName: "Timeout",
+				Package:    "timeout",
+				TypeName:   "Timeout",
+				Schema:     openapi.Schema{Type: "string", Format: "duration"},
+				Examples:   []any{"P1M"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error: invalid example",
+			model: commonmodels.Model{
+				SchemaName: "CompatibilityDate",
+				Package:    "compatibilitydate",
+				TypeName:   "CompatibilityDate",
+				Schema:     openapi.Schema{Type: "string", Format: "date"},
+				Examples:   []any{"2025-02-30"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mainGo, testGo, err := commonmodels.GeneratePackage(testCase.model, "github.com/xaroth/lib-esi-go", "common")
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("GeneratePackage error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			for _, want := range testCase.main {
+				if !strings.Contains(string(mainGo), want) {
+					t.Errorf("main missing %q: %s", want, mainGo)
+				}
+			}
+			for _, want := range testCase.test {
+				if !strings.Contains(string(testGo), want) {
+					t.Errorf("test missing %q: %s", want, testGo)
+				}
+			}
+		})
+	}
+}
+
+// structSchemas has an object common model that refers to other common models and nests inline objects.
+const structSchemas = `{
+  "CharacterID": { "x-common-model": true, "type": "integer", "format": "int64" },
+  "Position": {
+    "x-common-model": true,
+    "type": "object",
+    "required": ["x", "y", "z"],
+    "properties": {
+      "x": { "type": "number", "format": "double" },
+      "y": { "type": "number", "format": "double" },
+      "z": { "type": "number", "format": "double" }
+    }
+  },
+  "Affiliation": {
+    "x-common-model": true,
+    "type": "object",
+    "required": ["character_id", "history"],
+    "properties": {
+      "character_id": { "$ref": "#/components/schemas/CharacterID" },
+      "position": { "$ref": "#/components/schemas/Position", "description": "Where the character is" },
+      "history": { "type": "array", "items": {
+        "type": "object",
+        "required": ["start_date"],
+        "properties": {
+          "start_date": { "type": "string", "format": "date-time" },
+          "is_deleted": { "type": "boolean" }
+        }
+      } }
+    }
+  },
+  "Station": {
+    "x-common-model": true,
+    "type": "object",
+    "properties": {
+      "services": { "oneOf": [{ "type": "string" }, { "type": "integer" }] }
+    }
+  }
+}`
+
+func TestGeneratePackage_struct(t *testing.T) {
+	var schemas map[string]openapi.Schema
+	if err := json.Unmarshal([]byte(structSchemas), &schemas); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		model   commonmodels.Model
+		main    []string
+		test    []string
+		wantErr bool
+	}{
+		{
+			name: "nested refs",
+			model: commonmodels.Model{
+				SchemaName: "Affiliation",
+				Package:    "affiliation",
+				TypeName:   "Affiliation",
+				Schema:     schemas["Affiliation"],
+				Schemas:    schemas,
+				Examples: []any{map[string]any{
+					"character_id": float64(90000001),
+					"position":     map[string]any{"z": float64(1), "y": float64(2), "x": 3.25},
+					"history":      []any{map[string]any{"start_date": "2016-06-26T21:00:00+02:00"}},
+				}},
+			},
+			main: []string{
+				`"github.com/xaroth/lib-esi-go/common/character"`,
+				`"github.com/xaroth/lib-esi-go/common/position"`,
+				"type Affiliation struct { Character character.Identifier `json:\"character_id\"`",
+				"History []History `json:\"history\"`",
+				"// Where the character is Position *position.Position `json:\"position\"`",
+				"type History struct { IsDeleted *bool `json:\"is_deleted\"` StartDate time.Time `json:\"start_date\"` }",
+			},
+			test: []string{
+				"var v affiliation.Affiliation",
+				// Properties in field order, and absent optional properties as null.
+				`{"character_id":90000001,"history":[{"is_deleted":null,"start_date":"2016-06-26T21:00:00+02:00"}],"position":{"x":3.25,"y":2,"z":1}}`,
+			},
+		},
+		{
+			name: "error: missing required property",
+			model: commonmodels.Model{
+				SchemaName: "Position",
+				Package:    "position",
+				TypeName:   "Position",
+				Schema:     schemas["Position"],
+				Schemas:    schemas,
+				Examples:   []any{map[string]any{"x": float64(1), "y": float64(2)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error: oneOf",
+			model: commonmodels.Model{
+				SchemaName: "Station",
+				Package:    "station",
+				TypeName:   "Station",
+				Schema:     schemas["Station"],
+				Schemas:    schemas,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mainGo, testGo, err := commonmodels.GeneratePackage(testCase.model, "github.com/xaroth/lib-esi-go", "common")
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("GeneratePackage error = %v, wantErr %v", err, testCase.wantErr)
+			}
+			// Ignore the alignment of gofmt.
+			main := strings.Join(strings.Fields(string(mainGo)), " ")
+			for _, want := range testCase.main {
+				if !strings.Contains(main, want) {
+					t.Errorf("main missing %q: %s", want, mainGo)
+				}
+			}
+			for _, want := range testCase.test {
+				if !strings.Contains(string(testGo), want) {
+					t.Errorf("test missing %q: %s", want, testGo)
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@ type Model struct {
 	TypeName   string
 	Schema     openapi.Schema
 	Examples   []any
+
+	// Schemas are the component schemas of the spec, which the properties of object models refer to.
+	Schemas map[string]openapi.Schema
 }
 
 // ModelsFromSpec returns all x-common-model schemas sorted by name.
@@ -33,7 +36,7 @@ func ModelsFromSpec(spec *openapi.Spec) ([]Model, error) {	if spec == nil {
 	models := make([]Model, 0, len(names))
 	for _, name := range names {
 		schema := spec.Components.Schemas[name]
-		examples, err := parseExamples(schema.Examples)
+		examples, err := schemaExamples(schema)
 		if err != nil {
 			return nil, fmt.Errorf("%s examples: %w", name, err)
 		}
@@ -43,11 +46,26 @@ func ModelsFromSpec(spec *openapi.Spec) ([]Model, error) {	if spec == nil {
 			TypeName:   TypeName(name),
 			Schema:     schema,
 			Examples:   examples,
+			Schemas:    spec.Components.Schemas,
 		})
 	}
 	return models, nil
 }
 
+// schemaExamples returns the value of the example keyword of a schema, followed by those of its examples keyword.
+func schemaExamples(schema openapi.Schema) ([]any, error) {
+	examples, err := parseExamples(schema.Examples)
+	if err != nil || len(schema.Example) == 0 {
+		return examples, err
+	}
+
+	var example any
+	if err := json.Unmarshal(schema.Example, &example); err != nil {
+		return nil, err
+	}
+	return append([]any{example}, examples...), nil
+}
+
 func parseExamples(raw json.RawMessage) ([]any, error) {
 	if len(raw) == 0 {
 		return nil, nil
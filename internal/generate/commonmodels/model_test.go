@@ -1,10 +1,13 @@
 package commonmodels_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/xaroth/lib-esi-go/internal/generate/commonmodels"
 	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
 )
 
 func TestModelsFromSpec(t *testing.T) {
@@ -29,3 +32,34 @@ func TestModelsFromSpec(t *testing.T) {
 		t.Fatalf("models = %+v", models)
 	}
 }
+
+func TestModelsFromSpec_examples(t *testing.T) {
+	var spec openapi.Spec
+	if err := json.Unmarshal([]byte(`{"components": {"schemas": {
+		"Position": {
+			"x-common-model": true,
+			"type": "object",
+			"properties": { "x": { "type": "number" } },
+			"example": { "x": 1.5 },
+			"examples": [{ "x": -3 }]
+		},
+		"StationID": { "x-common-model": true, "type": "integer", "format": "int64", "example": 60003760 }
+	}}}`), &spec); err != nil {
+		t.Fatal(err)
+	}
+	models, err := commonmodels.ModelsFromSpec(&spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	examples := make(map[string][]any)
+	for _, m := range models {
+		examples[m.SchemaName] = m.Examples
+	}
+	if diff := cmp.Diff(map[string][]any{
+		"Position":  {map[string]any{"x": 1.5}, map[string]any{"x": -3.0}},
+		"StationID": {60003760.0},
+	}, examples); diff != "" {
+		t.Errorf("examples mismatch (-want +got):\n%s", diff)
+	}
+}
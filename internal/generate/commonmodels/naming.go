@@ -66,6 +66,28 @@ func goSafeLower(s string) string {
 	return b.String()
 }
 
+// FieldName returns the Go field name of a property, e.g. Position for position. Properties referring to an
+// identifier common model drop their _id suffix, e.g. Corporation for corporation_id.
+func FieldName(wire string, commonSchema string) string {
+	if commonSchema != "" && TypeName(commonSchema) == "Identifier" {
+		wire = strings.TrimSuffix(wire, "_id")
+	}
+	var b strings.Builder
+	for _, p := range strings.Split(wire, "_") {
+		if p == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(p[:1]))
+		if len(p) > 1 {
+			b.WriteString(strings.ToLower(p[1:]))
+		}
+	}
+	if b.Len() == 0 {
+		return "Unknown"
+	}
+	return b.String()
+}
+
 // EnumFieldName derives a Go identifier for an enum constant field.
 func EnumFieldName(wireValue string, description string) string {
 	if description != "" {
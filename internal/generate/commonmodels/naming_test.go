@@ -42,6 +42,25 @@ func TestTypeName(t *testing.T) {
 	}
 }
 
+func TestFieldName(t *testing.T) {
+	tests := []struct {
+		wire   string
+		common string
+		want   string
+	}{
+		{"position", "", "Position"},
+		{"start_date", "", "StartDate"},
+		{"corporation_id", "CorporationID", "Corporation"},
+		{"corporation_id", "", "CorporationId"},
+		{"_", "", "Unknown"},
+	}
+	for _, tt := range tests {
+		if got := commonmodels.FieldName(tt.wire, tt.common); got != tt.want {
+			t.Errorf("FieldName(%q, %q) = %q, want %q", tt.wire, tt.common, got, tt.want)
+		}
+	}
+}
+
 func TestEnumFieldName(t *testing.T) {
 	if got := commonmodels.EnumFieldName("male", "Male"); got != "Male" {
 		t.Errorf("enumFieldName with description = %q, want Male", got)
@@ -0,0 +1,357 @@
+package commonmodels
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/civil"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+)
+
+type structFieldData struct {
+	Name string
+	Type string
+	Wire string
+	Doc  []string
+}
+
+type structDefData struct {
+	Name   string
+	Fields []structFieldData
+}
+
+type structTemplateData struct {
+	Package    string
+	StdImports []string
+	Imports    []string
+	Structs    []structDefData // the model first, then its nested structs
+}
+
+// structBuilder maps the properties of an object model to Go fields. Fields follow the rules of
+// cmd/generate-request: required properties are values, optional ones pointers, and arrays slices of values.
+// Properties referring to other common models use their types, and inline objects become nested structs.
+type structBuilder struct {
+	m          Model
+	modulePath string
+	importBase string
+	resolver   *openapi.Resolver
+
+	structs []structDefData
+	std     map[string]bool
+	imports map[string]bool
+}
+
+func isObjectSchema(schema openapi.Schema) bool {
+	return schema.Type == "object" || len(schema.Properties) > 0
+}
+
+func renderStruct(m Model, modulePath, importBase string) (string, error) {
+	sb := &structBuilder{
+		m:          m,
+		modulePath: modulePath,
+		importBase: importBase,
+		resolver:   openapi.NewResolver(&openapi.Spec{Components: openapi.Components{Schemas: m.Schemas}}),
+		std:        make(map[string]bool),
+		imports:    make(map[string]bool),
+	}
+	if _, err := sb.build(m.TypeName, m.Schema); err != nil {
+		return "", fmt.Errorf("%s: %w", m.SchemaName, err)
+	}
+	return ExecuteTemplate("struct", structTemplateData{
+		Package:    m.Package,
+		StdImports: sortedSet(sb.std),
+		Imports:    sortedSet(sb.imports),
+		Structs:    sb.structs,
+	})
+}
+
+func (sb *structBuilder) defined(name string) bool {
+	for _, s := range sb.structs {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// build registers the struct of an object schema and returns its name.
+func (sb *structBuilder) build(name string, schema openapi.Schema) (string, error) {
+	if sb.defined(name) {
+		return "", fmt.Errorf("struct %s is defined twice", name)
+	}
+	index := len(sb.structs)
+	sb.structs = append(sb.structs, structDefData{Name: name})
+
+	var fields []structFieldData
+	for _, wire := range sortedProperties(schema) {
+		ref := schema.Properties[wire]
+		resolved, schemaName, err := sb.resolver.ResolveSchemaRef(ref)
+		if err != nil {
+			return "", fmt.Errorf("property %q: %w", wire, err)
+		}
+		typ, err := sb.fieldType(ref, wire, containsString(schema.Required, wire))
+		if err != nil {
+			return "", fmt.Errorf("property %q: %w", wire, err)
+		}
+		if !resolved.XCommonModel.IsTrue() {
+			schemaName = ""
+		}
+		description := ref.Description
+		if description == "" {
+			description = resolved.Description
+		}
+		fields = append(fields, structFieldData{
+			Name: FieldName(wire, schemaName),
+			Type: typ,
+			Wire: wire,
+			Doc:  commentLines(description),
+		})
+	}
+	sb.structs[index].Fields = fields
+	return name, nil
+}
+
+func (sb *structBuilder) fieldType(ref openapi.SchemaRef, wire string, required bool) (string, error) {
+	schema, schemaName, err := sb.resolver.ResolveSchemaRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	var typ string
+	switch {
+	case schemaName != "" && schema.XCommonModel.IsTrue():
+		typ = sb.commonType(schemaName)
+	case len(schema.OneOf) > 0 || len(ref.OneOf) > 0:
+		return "", fmt.Errorf("oneOf is not supported")
+	case isObjectSchema(schema):
+		if schemaName != "" && sb.defined(TypeName(schemaName)) {
+			typ = TypeName(schemaName)
+			break
+		}
+		name := FieldName(wire, "")
+		if schemaName != "" {
+			name = TypeName(schemaName)
+		}
+		if typ, err = sb.build(name, schema); err != nil {
+			return "", err
+		}
+	case schema.Type == "array":
+		if schema.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		elem, err := sb.fieldType(*schema.Items, wire, true)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	default:
+		if typ, err = sb.primitiveType(schema); err != nil {
+			return "", err
+		}
+	}
+	if !required && typ != "json.RawMessage" {
+		typ = "*" + typ
+	}
+	return typ, nil
+}
+
+// commonType returns the type of another common model, or of the model itself for recursive schemas.
+func (sb *structBuilder) commonType(schemaName string) string {
+	pkg := PackageName(schemaName)
+	if pkg == sb.m.Package {
+		return TypeName(schemaName)
+	}
+	sb.imports[sb.modulePath+"/"+sb.importBase+"/"+pkg] = true
+	return pkg + "." + TypeName(schemaName)
+}
+
+func (sb *structBuilder) primitiveType(schema openapi.Schema) (string, error) {
+	switch schema.Type {
+	case "":
+		sb.std["encoding/json"] = true
+		return "json.RawMessage", nil
+	case "integer":
+		if schema.Format == "int32" {
+			return "int32", nil
+		}
+		return "int64", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			sb.std["time"] = true
+			return "time.Time", nil
+		case "date":
+			sb.imports[sb.modulePath+"/civil"] = true
+			return "civil.Date", nil
+		case "duration":
+			sb.imports[sb.modulePath+"/civil"] = true
+			return "civil.Duration", nil
+		default:
+			return "string", nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported schema type %q format %q", schema.Type, schema.Format)
+	}
+}
+
+// canonicalExample returns the JSON the struct of an object model marshals an example to: properties
+// in field order, absent optional properties as null and date-times in the format of time.Time.
+func canonicalExample(m Model, ex any) (string, error) {
+	resolver := openapi.NewResolver(&openapi.Spec{Components: openapi.Components{Schemas: m.Schemas}})
+	v, err := canonicalValue(resolver, m.Schema, ex)
+	if err != nil {
+		return "", fmt.Errorf("%s example: %w", m.SchemaName, err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func canonicalValue(resolver *openapi.Resolver, schema openapi.Schema, ex any) (any, error) {
+	if ex == nil {
+		return nil, nil
+	}
+	switch {
+	case isObjectSchema(schema):
+		object, ok := ex.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected object, got %T", ex)
+		}
+		var out orderedObject
+		for _, wire := range sortedProperties(schema) {
+			value, ok := object[wire]
+			if !ok && containsString(schema.Required, wire) {
+				return nil, fmt.Errorf("required property %q is missing", wire)
+			}
+			prop, _, err := resolver.ResolveSchemaRef(schema.Properties[wire])
+			if err != nil {
+				return nil, err
+			}
+			if value, err = canonicalValue(resolver, prop, value); err != nil {
+				return nil, fmt.Errorf("property %q: %w", wire, err)
+			}
+			out = append(out, objectProperty{Wire: wire, Value: value})
+		}
+		return out, nil
+	case schema.Type == "array" && schema.Items != nil:
+		list, ok := ex.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected array, got %T", ex)
+		}
+		item, _, err := resolver.ResolveSchemaRef(*schema.Items)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]any, len(list))
+		for i, value := range list {
+			if out[i], err = canonicalValue(resolver, item, value); err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		return out, nil
+	case schema.Type == "string" && schema.Format == "date-time":
+		s, ok := ex.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", ex)
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(time.RFC3339Nano), nil
+	case schema.Type == "string" && schema.Format == "duration":
+		s, ok := ex.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", ex)
+		}
+		d, err := civil.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		return d.String(), nil
+	default:
+		return ex, nil
+	}
+}
+
+type objectProperty struct {
+	Wire  string
+	Value any
+}
+
+// orderedObject is a JSON object that marshals its properties in order.
+type orderedObject []objectProperty
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, p := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(p.Wire)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return []byte(b.String()), nil
+}
+
+func sortedProperties(schema openapi.Schema) []string {
+	wires := make([]string, 0, len(schema.Properties))
+	for wire := range schema.Properties {
+		wires = append(wires, wire)
+	}
+	sort.Strings(wires)
+	return wires
+}
+
+func sortedSet(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for s := range set {
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func containsString(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// commentLines splits a description into comment lines, without blank lines at either end.
+func commentLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
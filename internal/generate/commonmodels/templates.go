@@ -51,16 +51,26 @@ type enumTemplateData struct {
 	Fields     []enumFieldData
 }
 
+// timeTemplateData renders the date and date_time templates.
+type timeTemplateData struct {
+	Package    string
+	TypeName   string
+	ModulePath string
+}
+
 type testCaseData struct {
 	TypeName       string
 	Index          int
 	InputJSON      string
 	QualifiedType  string
 	WantConv       string
+	TimeEqual      bool // compare with time.Time.Equal, as the location of equal times may differ
 }
 
 type testTemplateData struct {
 	TestPackage string
 	ImportPath  string
+	StdImports  []string // besides encoding/json and testing
+	Imports     []string // besides ImportPath
 	Cases       []testCaseData
 }
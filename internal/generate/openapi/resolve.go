@@ -67,12 +67,13 @@ func (r *Resolver) ResolveSchemaRefDeep(ref SchemaRef) (Schema, string, error) {
 		if merged.Format != "" {
 			schema.Format = merged.Format
 		}
+		schema.Constraints = schema.Constraints.Merge(merged.Constraints)
 	}
 	return schema, name, nil
 }
 
 func (ref SchemaRef) SchemaRefNested() bool {
-	return ref.Type != "" || ref.Format != "" || len(ref.Enum) > 0
+	return ref.Type != "" || ref.Format != "" || len(ref.Enum) > 0 || !ref.Constraints.IsZero()
 }
 
 func schemaFromRef(ref SchemaRef) Schema {
@@ -84,14 +85,17 @@ func schemaFromRef(ref SchemaRef) Schema {
 		Ref:               ref.Ref,
 		Type:              ref.Type,
 		Format:            ref.Format,
+		Description:       ref.Description,
 		Enum:              ref.Enum,
 		Items:             items,
 		Properties:        ref.Properties,
 		Required:          ref.Required,
 		OneOf:             ref.OneOf,
+		Example:           ref.Example,
 		Examples:          ref.Examples,
 		XCommonModel:      ref.XCommonModel,
 		XEnumDescriptions: ref.XEnumDescriptions,
+		Constraints:       ref.Constraints,
 	}
 }
 
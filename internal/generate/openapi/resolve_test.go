@@ -1,8 +1,10 @@
 package openapi_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
 )
 
@@ -81,6 +83,29 @@ func TestResolveSchemaRef_missing(t *testing.T) {
 	}
 }
 
+func TestResolveSchemaRefDeep_constraints(t *testing.T) {
+	var spec openapi.Spec
+	err := json.Unmarshal([]byte(`{"components": {"schemas": {"Name": {"type": "string", "minLength": 1, "maxLength": 100}}}}`), &spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := openapi.NewResolver(&spec)
+
+	var ref openapi.SchemaRef
+	if err := json.Unmarshal([]byte(`{"$ref": "#/components/schemas/Name", "maxLength": 37, "pattern": "^[A-Za-z ]+$"}`), &ref); err != nil {
+		t.Fatal(err)
+	}
+	s, _, err := r.ResolveSchemaRefDeep(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minLength, maxLength := 1, 37
+	want := openapi.Constraints{MinLength: &minLength, MaxLength: &maxLength, Pattern: "^[A-Za-z ]+$"}
+	if diff := cmp.Diff(want, s.Constraints); diff != "" {
+		t.Errorf("constraints mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestCommonSchemaNames(t *testing.T) {
 	spec := &openapi.Spec{
 		Components: openapi.Components{
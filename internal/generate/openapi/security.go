@@ -2,8 +2,8 @@ package openapi
 
 import "sort"
 
-// RequiredOAuth2Scopes returns the OAuth scopes declared on an operation.
-// Scopes from every security requirement are combined; any listed scope qualifies.
+// RequiredOAuth2Scopes returns every OAuth scope named on an operation.
+// Scopes from every security requirement are combined; see SecurityScopes for which combinations grant access.
 func RequiredOAuth2Scopes(security []SecurityRequirement) []string {
 	if len(security) == 0 {
 		return nil
@@ -25,3 +25,18 @@ func RequiredOAuth2Scopes(security []SecurityRequirement) []string {
 	sort.Strings(scopes)
 	return scopes
 }
+
+// SecurityScopes returns the OAuth scopes of each security requirement of an operation, preserving their structure:
+// any one requirement grants access, and needs all of its scopes.
+// An empty requirement makes authentication optional, in which case no requirements are returned.
+func SecurityScopes(security []SecurityRequirement) [][]string {
+	var requirements [][]string
+	for _, req := range security {
+		scopes := RequiredOAuth2Scopes([]SecurityRequirement{req})
+		if len(scopes) == 0 {
+			return nil
+		}
+		requirements = append(requirements, scopes)
+	}
+	return requirements
+}
@@ -3,6 +3,7 @@ package openapi_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
 )
 
@@ -58,3 +59,59 @@ func TestRequiredOAuth2Scopes(t *testing.T) {
 		})
 	}
 }
+
+func TestSecurityScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		security []openapi.SecurityRequirement
+		want     [][]string
+	}{
+		{
+			name: "single requirement",
+			security: []openapi.SecurityRequirement{
+				{"OAuth2": {"esi-fittings.read_fittings.v1"}},
+			},
+			want: [][]string{{"esi-fittings.read_fittings.v1"}},
+		},
+		{
+			name: "all scopes of a requirement",
+			security: []openapi.SecurityRequirement{
+				{"OAuth2": {"scope-b", "scope-a"}},
+			},
+			want: [][]string{{"scope-a", "scope-b"}},
+		},
+		{
+			name: "alternative requirements",
+			security: []openapi.SecurityRequirement{
+				{"OAuth2": {"scope-a"}},
+				{"OAuth2": {"scope-b", "scope-c"}},
+			},
+			want: [][]string{{"scope-a"}, {"scope-b", "scope-c"}},
+		},
+		{
+			name: "optional authentication",
+			security: []openapi.SecurityRequirement{
+				{"OAuth2": {"scope-a"}},
+				{},
+			},
+			want: nil,
+		},
+		{
+			name:     "empty",
+			security: nil,
+			want:     nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := openapi.SecurityScopes(tc.security)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("SecurityScopes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
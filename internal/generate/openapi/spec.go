@@ -20,10 +20,24 @@ type PathItem map[string]Operation
 type Operation struct {
 	OperationID string                `json:"operationId"`
 	Summary     string                `json:"summary"`
+	Description string                `json:"description"`
+	Tags        []string              `json:"tags"`
 	Parameters  []ParameterRef        `json:"parameters"`
 	RequestBody *RequestBody          `json:"requestBody"`
 	Responses   map[string]Response   `json:"responses"`
 	Security    []SecurityRequirement `json:"security"`
+	XRateLimit  *RateLimit            `json:"x-rate-limit"`
+	// The corporation roles of which the character needs any one, from the x-required-roles extension.
+	XRequiredRoles []string `json:"x-required-roles"`
+	// How long responses are cached by ESI, from the x-cached-seconds extension; 0 when not cached.
+	XCachedSeconds int `json:"x-cached-seconds"`
+}
+
+// RateLimit is the x-rate-limit extension, describing the rate limit group an operation belongs to.
+type RateLimit struct {
+	Group      string `json:"group"`
+	MaxTokens  int    `json:"max-tokens"`
+	WindowSize string `json:"window-size"`
 }
 
 // SecurityRequirement maps a security scheme name to required OAuth scopes.
@@ -44,14 +58,17 @@ type SchemaRef struct {
 
 	Type       SchemaType           `json:"type"`
 	Format     string               `json:"format"`
+	Description string              `json:"description"`
 	Enum       []any                `json:"enum"`
 	Items      *SchemaRef           `json:"items"`
 	Properties map[string]SchemaRef `json:"properties"`
 	Required   []string             `json:"required"`
 	OneOf      []SchemaRef          `json:"oneOf"`
+	Example    json.RawMessage      `json:"example"`
 	Examples   json.RawMessage      `json:"examples"`
 	XCommonModel Boolish            `json:"x-common-model"`
 	XEnumDescriptions []string      `json:"x-enum-descriptions"`
+	Constraints
 }
 
 type Schema struct {
@@ -59,14 +76,61 @@ type Schema struct {
 
 	Type              SchemaType            `json:"type"`
 	Format            string                `json:"format"`
+	Description       string                `json:"description"`
 	Enum              []any                 `json:"enum"`
 	Items             *SchemaRef            `json:"items"`
 	Properties        map[string]SchemaRef  `json:"properties"`
 	Required          []string              `json:"required"`
 	OneOf             []SchemaRef           `json:"oneOf"`
+	Example           json.RawMessage       `json:"example"`
 	Examples          json.RawMessage       `json:"examples"`
 	XCommonModel      Boolish               `json:"x-common-model"`
 	XEnumDescriptions []string              `json:"x-enum-descriptions"`
+	Constraints
+}
+
+// Constraints are the validation keywords of a schema. Unset bounds are nil.
+type Constraints struct {
+	Minimum     *float64 `json:"minimum"`
+	Maximum     *float64 `json:"maximum"`
+	MinLength   *int     `json:"minLength"`
+	MaxLength   *int     `json:"maxLength"`
+	Pattern     string   `json:"pattern"`
+	MinItems    *int     `json:"minItems"`
+	MaxItems    *int     `json:"maxItems"`
+	UniqueItems bool     `json:"uniqueItems"`
+}
+
+// IsZero reports whether no constraint is set.
+func (c Constraints) IsZero() bool {
+	return c == Constraints{}
+}
+
+// Merge returns c with the constraints set in other replacing its own.
+func (c Constraints) Merge(other Constraints) Constraints {
+	if other.Minimum != nil {
+		c.Minimum = other.Minimum
+	}
+	if other.Maximum != nil {
+		c.Maximum = other.Maximum
+	}
+	if other.MinLength != nil {
+		c.MinLength = other.MinLength
+	}
+	if other.MaxLength != nil {
+		c.MaxLength = other.MaxLength
+	}
+	if other.Pattern != "" {
+		c.Pattern = other.Pattern
+	}
+	if other.MinItems != nil {
+		c.MinItems = other.MinItems
+	}
+	if other.MaxItems != nil {
+		c.MaxItems = other.MaxItems
+	}
+	c.UniqueItems = c.UniqueItems || other.UniqueItems
+	return c
 }
 
 type Parameter struct {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
 )
@@ -16,6 +17,7 @@ func BuildPackage(op Operation, spec *openapi.Spec, cfg Config) (PackageModel, e
 	var (
 		inputFields  []StructField
 		inputNested  []StructDef
+		enums        map[string][]string
 	)
 	inSB := newStructBuilder(mapper, resolver)
 
@@ -34,6 +36,20 @@ func BuildPackage(op Operation, spec *openapi.Spec, cfg Config) (PackageModel, e
 		if err != nil {
 			return PackageModel{}, fmt.Errorf("%s parameter %q: %w", op.OperationID, p.Name, err)
 		}
+		validation, err := validationFor(resolver, *p.Schema, p.In+"."+p.Name)
+		if err != nil {
+			return PackageModel{}, fmt.Errorf("%s parameter %q: %w", op.OperationID, p.Name, err)
+		}
+		enum, err := enumValues(resolver, *p.Schema)
+		if err != nil {
+			return PackageModel{}, fmt.Errorf("%s parameter %q: %w", op.OperationID, p.Name, err)
+		}
+		if len(enum) > 0 {
+			if enums == nil {
+				enums = make(map[string][]string)
+			}
+			enums[p.In+"."+p.Name] = enum
+		}
 		tagKey := p.In
 		if tagKey == "header" {
 			tagKey = "header"
@@ -44,6 +60,8 @@ func BuildPackage(op Operation, spec *openapi.Spec, cfg Config) (PackageModel, e
 			TagKey:      tagKey,
 			TagVal:      p.Name,
 			TagRequired: p.Required && p.In != "path",
+			Doc:         fieldSchemaDoc(resolver, mapper, p.Description, *p.Schema),
+			Validation:  validation,
 		})
 	}
 
@@ -65,12 +83,26 @@ func BuildPackage(op Operation, spec *openapi.Spec, cfg Config) (PackageModel, e
 		return PackageModel{}, err
 	}
 
+	rateLimit, err := buildRateLimit(op.Spec.XRateLimit)
+	if err != nil {
+		return PackageModel{}, fmt.Errorf("%s x-rate-limit: %w", op.OperationID, err)
+	}
+
+	example, err := responseExample(op, resolver)
+	if err != nil {
+		return PackageModel{}, fmt.Errorf("%s example: %w", op.OperationID, err)
+	}
+
 	static := len(inputFields) == 0
 
 	return PackageModel{
+		OperationID:       op.OperationID,
 		PackageName:       PackageNameFromOperationID(op.OperationID),
 		Method:            op.Method,
 		Path:              op.Path,
+		Summary:           op.Spec.Summary,
+		Description:       op.Spec.Description,
+		CacheSeconds:      op.Spec.XCachedSeconds,
 		InputFields:       inputFields,
 		InputNested:       inputNested,
 		OutputFields:      outputFields,
@@ -82,14 +114,40 @@ func BuildPackage(op Operation, spec *openapi.Spec, cfg Config) (PackageModel, e
 		Static:            static,
 		NeedsTime:         needsTime,
 		RequiredScopes:    openapi.RequiredOAuth2Scopes(op.Spec.Security),
+		Security:          openapi.SecurityScopes(op.Spec.Security),
+		RateLimit:         rateLimit,
+		RequiredRoles:     op.Spec.XRequiredRoles,
+		Status:            successStatus(op),
+		ParameterEnums:    enums,
+		Example:           example,
+	}, nil
+}
+
+func buildRateLimit(rl *openapi.RateLimit) (*RateLimitModel, error) {
+	if rl == nil || rl.Group == "" {
+		return nil, nil
+	}
+	if rl.MaxTokens <= 0 {
+		return nil, fmt.Errorf("invalid max-tokens %d", rl.MaxTokens)
+	}
+	windowSize, err := time.ParseDuration(rl.WindowSize)
+	if err != nil || windowSize <= 0 {
+		return nil, fmt.Errorf("invalid window-size %q", rl.WindowSize)
+	}
+	return &RateLimitModel{
+		Group:      rl.Group,
+		BucketSize: rl.MaxTokens,
+		WindowSize: windowSize,
 	}, nil
 }
 
 func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyRequired bool) ([]StructField, []StructDef, error) {
-	s, _, err := sb.resolver.ResolveSchemaRef(schema)
+	s, schemaName, err := sb.resolver.ResolveSchemaRef(schema)
 	if err != nil {
 		return nil, nil, err
 	}
+	// Bodies of common models are passed as a whole rather than by their properties.
+	common := schemaName != "" && sb.mapper.commonSchemas[schemaName]
 
 	if s.Type == "array" {
 		itemRef := schema
@@ -102,6 +160,10 @@ func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyReq
 		if err != nil {
 			return nil, nil, err
 		}
+		validation, err := validationFor(sb.resolver, schema, "body")
+		if err != nil {
+			return nil, nil, err
+		}
 		if variants := oneOfVariants(itemSchema, itemRef); len(variants) > 0 {
 			goType, _, err := sb.mapOneOf(variants, "body", itemName, true, true)
 			if err != nil {
@@ -113,9 +175,10 @@ func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyReq
 				TagKey:      "body",
 				TagVal:      "json",
 				TagRequired: bodyRequired,
+				Validation:  validation,
 			}}, sb.nested, nil
 		}
-		if isObjectSchema(itemSchema) {
+		if isObjectSchema(itemSchema) && !sb.mapper.commonSchemas[itemName] {
 			name := objectStructName(itemName, "body")
 			fields, _, err := sb.buildFields(itemSchema, itemName, itemRef, "json")
 			if err != nil {
@@ -128,6 +191,7 @@ func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyReq
 				TagKey:      "body",
 				TagVal:      "json",
 				TagRequired: bodyRequired,
+				Validation:  validation,
 			}}, sb.nested, nil
 		}
 		goType, itemCommon, err := sb.mapper.MapSchemaRef(itemRef, true)
@@ -142,10 +206,11 @@ func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyReq
 			TagKey:      "body",
 			TagVal:      "json",
 			TagRequired: bodyRequired,
+			Validation:  validation,
 		}}, nil, nil
 	}
 
-	if s.Type == "object" {
+	if s.Type == "object" && !common {
 		wires := make([]string, 0, len(s.Properties))
 		for wire := range s.Properties {
 			wires = append(wires, wire)
@@ -160,12 +225,18 @@ func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyReq
 			if err != nil {
 				return nil, nil, fmt.Errorf("property %q: %w", wire, err)
 			}
+			validation, err := validationFor(sb.resolver, propRef, "body."+wire)
+			if err != nil {
+				return nil, nil, fmt.Errorf("property %q: %w", wire, err)
+			}
 			fields = append(fields, StructField{
 				Name:        FieldNameFromWire(wire, commonName),
 				Type:        goType,
 				TagKey:      "body",
 				TagVal:      "json",
 				TagRequired: required,
+				Doc:         fieldSchemaDoc(sb.resolver, sb.mapper, propRef.Description, propRef),
+				Validation:  validation,
 			})
 		}
 		return fields, sb.nested, nil
@@ -175,12 +246,17 @@ func buildRequestBodyFields(schema openapi.SchemaRef, sb *structBuilder, bodyReq
 	if err != nil {
 		return nil, nil, err
 	}
+	validation, err := validationFor(sb.resolver, schema, "body")
+	if err != nil {
+		return nil, nil, err
+	}
 	return []StructField{{
 		Name:        FieldNameFromWire("body", commonName),
 		Type:        goType,
 		TagKey:      "body",
 		TagVal:      "json",
 		TagRequired: bodyRequired,
+		Validation:  validation,
 	}}, nil, nil
 }
 
@@ -194,6 +270,7 @@ func schemaFromSchema(s openapi.Schema) openapi.SchemaRef {
 		Properties: s.Properties,
 		Required:   s.Required,
 		OneOf:      s.OneOf,
+		Constraints: s.Constraints,
 	}
 }
 
@@ -240,12 +317,12 @@ func buildJSONResponse(op Operation, schemaRef openapi.SchemaRef, resolver *open
 		if err != nil {
 			return nil, nil, "", false, "", GoType{}, false, err
 		}
-		if schemaYieldsStruct(itemSchema, itemName, *schema.Items) {
+		if mapper.schemaYieldsStruct(itemSchema, itemName, *schema.Items) {
 			fields, needsTime, err := sb.buildFields(itemSchema, itemName, *schema.Items, "json")
 			if err != nil {
 				return nil, nil, "", false, "", GoType{}, false, err
 			}
-			return fields, sb.nested, "[]*Output", false, "", GoType{}, needsTime, nil
+			return sb.dedup(fields), sb.nested, "[]*Output", false, "", GoType{}, needsTime, nil
 		}
 		goType, _, err := mapper.MapSchemaRef(*schema.Items, true)
 		if err != nil {
@@ -257,7 +334,7 @@ func buildJSONResponse(op Operation, schemaRef openapi.SchemaRef, resolver *open
 		return nil, nil, "Output", false, alias, goType, strings.Contains(elem, "time.Time"), nil
 	}
 
-	if !schemaYieldsStruct(schema, schemaName, schemaRef) {
+	if !mapper.schemaYieldsStruct(schema, schemaName, schemaRef) {
 		goType, _, err := mapper.MapSchemaRef(schemaRef, true)
 		if err != nil {
 			return nil, nil, "", false, "", GoType{}, false, err
@@ -271,7 +348,7 @@ func buildJSONResponse(op Operation, schemaRef openapi.SchemaRef, resolver *open
 	if err != nil {
 		return nil, nil, "", false, "", GoType{}, false, err
 	}
-	return fields, sb.nested, "*Output", false, "", GoType{}, needsTime, nil
+	return sb.dedup(fields), sb.nested, "*Output", false, "", GoType{}, needsTime, nil
 }
 
 func containsString(slice []string, s string) bool {
@@ -0,0 +1,363 @@
+package requestgen
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+const (
+	// ClientPackage is the directory, relative to the output directory, of the generated client facade.
+	ClientPackage = "client"
+
+	// ClientFile is the name of the generated client facade.
+	ClientFile = "client.go"
+)
+
+type clientArgData struct {
+	Name  string
+	Type  string
+	Field string
+}
+
+type clientMethodData struct {
+	Name        string
+	Method      string
+	Path        string
+	PackageName string
+	OutputType  string
+	Static      bool
+	Args        []clientArgData
+	HasInput    bool // the input has query, header or body parameters besides the path parameters
+}
+
+type clientGroupData struct {
+	Name    string
+	Impl    string
+	Prefix  string
+	Methods []clientMethodData
+}
+
+type clientTemplateData struct {
+	Import        string
+	Interfaces    string
+	RequestImport string
+	CommonImports []string // common models and other libraries the arguments use
+	OtherImports  []string
+	Imports       []string // operation packages
+	Groups        []clientGroupData
+}
+
+// clientGroup is the first segment of the paths of a group of operations.
+type clientGroup struct {
+	segment  string // e.g. characters
+	name     string // e.g. Characters
+	singular string // e.g. character
+}
+
+// GenerateClient renders the client facade, which calls every operation through an interface per domain.
+// packagesImport is the import path the operation packages are generated under.
+func GenerateClient(packagesImport string, packages []PackageModel, cfg Config) ([]byte, error) {
+	groups := clientGroups(packages)
+	byGroup := make(map[string][]PackageModel)
+	for _, pkg := range packages {
+		segment := groups[firstSegment(pkg.Path)].segment
+		byGroup[segment] = append(byGroup[segment], pkg)
+	}
+
+	data := clientTemplateData{
+		Import:        path.Join(packagesImport, ClientPackage),
+		RequestImport: cfg.requestImport(),
+	}
+	var argImports, interfaces []string
+	for _, segment := range sortedKeys(byGroup) {
+		group := groups[segment]
+		methods, imports, err := clientMethods(group, byGroup[segment])
+		if err != nil {
+			return nil, err
+		}
+		argImports = append(argImports, imports...)
+		data.Groups = append(data.Groups, clientGroupData{
+			Name:    group.name,
+			Impl:    lowerFirst(group.name),
+			Prefix:  "/" + group.segment,
+			Methods: methods,
+		})
+		interfaces = append(interfaces, group.name)
+	}
+	sort.Slice(data.Groups, func(i, j int) bool {
+		return data.Groups[i].Name < data.Groups[j].Name
+	})
+	sort.Strings(interfaces)
+	data.Interfaces = strings.Join(interfaces, ",")
+
+	sort.Strings(argImports)
+	data.CommonImports, data.OtherImports = importGroups(slices.Compact(argImports), cfg)
+	for _, pkg := range packages {
+		data.Imports = append(data.Imports, path.Join(packagesImport, pkg.PackageName))
+	}
+	sort.Strings(data.Imports)
+
+	src, err := executeTemplate("client.go.tmpl", data)
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source([]byte(generatedBy + src))
+	if err != nil {
+		return nil, fmt.Errorf("format client: %w", err)
+	}
+	return out, nil
+}
+
+// WriteClient writes the client facade into the client package of outDir.
+func WriteClient(outDir string, packages []PackageModel, cfg Config, check bool) error {
+	moduleRoot, err := openapi.ModuleRoot(outDir)
+	if err != nil {
+		return err
+	}
+	modulePath, err := openapi.ModulePath(outDir)
+	if err != nil {
+		return err
+	}
+	importBase, err := openapi.ImportBase(moduleRoot, outDir)
+	if err != nil {
+		return err
+	}
+
+	src, err := GenerateClient(path.Join(modulePath, importBase), packages, cfg)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(outDir, ClientPackage, ClientFile), src, check)
+}
+
+// clientGroups maps the first path segment of every operation to its group. A singular segment joins the
+// group of its plural if there is one, e.g. /corporation/ joins /corporations/.
+func clientGroups(packages []PackageModel) map[string]clientGroup {
+	segments := make(map[string]bool)
+	for _, pkg := range packages {
+		segments[firstSegment(pkg.Path)] = true
+	}
+	groups := make(map[string]clientGroup, len(segments))
+	for segment := range segments {
+		group := segment
+		if segments[segment+"s"] {
+			group = segment + "s"
+		}
+		wire := strings.ReplaceAll(group, "-", "_")
+		groups[segment] = clientGroup{
+			segment:  group,
+			name:     snakeToPascal(wire),
+			singular: singularWire(wire),
+		}
+	}
+	return groups
+}
+
+// clientMethods returns the methods of the operations of a group, and the imports of their arguments.
+func clientMethods(group clientGroup, packages []PackageModel) ([]clientMethodData, []string, error) {
+	names := make([]string, len(packages))
+	for i, pkg := range packages {
+		names[i] = clientMethodName(pkg, group)
+	}
+	// Fall back to names from the operationIds where the paths give the same name.
+	counts := make(map[string]int)
+	for _, name := range names {
+		counts[name]++
+	}
+	for i, pkg := range packages {
+		if counts[names[i]] > 1 {
+			names[i] = clientFallbackName(pkg, group)
+		}
+	}
+
+	var imports []string
+	methods := make([]clientMethodData, 0, len(packages))
+	seen := make(map[string]string)
+	for i, pkg := range packages {
+		if other, ok := seen[names[i]]; ok {
+			return nil, nil, fmt.Errorf("client: %s and %s are both %s.%s", other, pkg.OperationID, group.name, names[i])
+		}
+		seen[names[i]] = pkg.OperationID
+
+		method := clientMethodData{
+			Name:        names[i],
+			Method:      pkg.Method,
+			Path:        pkg.Path,
+			PackageName: pkg.PackageName,
+			OutputType:  qualifiedOutputType(pkg.PackageName, pkg.OutputType),
+			Static:      pkg.Static,
+		}
+		for _, f := range pkg.InputFields {
+			if f.TagKey != "path" {
+				method.HasInput = true
+			}
+		}
+		for _, param := range pathParameters(pkg.Path) {
+			for _, f := range pkg.InputFields {
+				if f.TagKey != "path" || f.TagVal != param {
+					continue
+				}
+				method.Args = append(method.Args, clientArgData{
+					Name:  argName(f),
+					Type:  f.Type.Type,
+					Field: f.Name,
+				})
+				if f.Type.Import != "" {
+					imports = append(imports, f.Type.Import)
+				}
+			}
+		}
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+	return methods, imports, nil
+}
+
+// clientMethodName names the method of an operation after the segments of its path within the group, e.g.
+// GET /characters/{character_id}/wallet/journal is WalletJournal and GET /markets/{region_id}/orders is
+// RegionOrders. A collection followed by the ID of its items is named after the item, e.g.
+// GET /universe/types/{type_id} is Type, and methods other than GET are prefixed, e.g. PostMail.
+// Operations on the group itself are named after it, e.g. GET /alliances is Alliances and
+// GET /alliances/{alliance_id} is Alliance.
+func clientMethodName(pkg PackageModel, group clientGroup) string {
+	segments := strings.Split(strings.Trim(pkg.Path, "/"), "/")[1:]
+	if len(segments) > 0 && strings.Trim(segments[0], "{}") == group.singular+"_id" {
+		segments = segments[1:]
+	}
+
+	// Operations on an item by its parameters alone are named after the group, e.g. POST /route/{origin}/{destination}.
+	if !slices.ContainsFunc(segments, func(segment string) bool { return !isParameter(segment) }) {
+		segments = nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(segments); i++ {
+		segment := strings.ReplaceAll(segments[i], "-", "_")
+		if isParameter(segment) {
+			b.WriteString(snakeToPascal(parameterStem(segment)))
+			continue
+		}
+		if i+1 < len(segments) && isParameter(segments[i+1]) {
+			item, stem := singularWire(segment), parameterStem(segments[i+1])
+			if strings.HasSuffix(item, stem) || strings.HasSuffix(stem, item) {
+				b.WriteString(snakeToPascal(longer(item, stem)))
+				i++
+				continue
+			}
+		}
+		b.WriteString(snakeToPascal(segment))
+	}
+
+	name := b.String()
+	if name == "" {
+		if strings.Contains(pkg.Path, "{") {
+			name = snakeToPascal(group.singular)
+		} else {
+			name = group.name
+		}
+	}
+	if verb := snakeToPascal(strings.ToLower(pkg.Method)); verb != "Get" {
+		name = verb + name
+	}
+	return name
+}
+
+var idWord = regexp.MustCompile(`Id([A-Z]|$)`)
+
+// clientFallbackName names the method of an operation after its operationId, without the group and the ID of
+// the group, e.g. GetCharactersCharacterIdMailMailId is MailMail.
+func clientFallbackName(pkg PackageModel, group clientGroup) string {
+	verb := snakeToPascal(strings.ToLower(pkg.Method))
+	name := strings.TrimPrefix(pkg.OperationID, verb)
+	singular := snakeToPascal(group.singular)
+	for _, prefix := range []string{group.name, singular} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			name = rest
+			break
+		}
+	}
+	if rest, ok := strings.CutPrefix(name, singular+"Id"); ok && (rest == "" || strings.ToUpper(rest[:1]) == rest[:1]) {
+		name = rest
+	}
+	name = idWord.ReplaceAllString(name, "$1")
+	if name == "" {
+		name = singular
+	}
+	if verb != "Get" {
+		name = verb + name
+	}
+	return name
+}
+
+// argName returns the argument name of a path parameter, e.g. Division is division. Names that are keywords
+// or common model packages, such as type or character, get an ID suffix.
+func argName(f StructField) string {
+	name := lowerFirst(f.Name)
+	if token.IsKeyword(name) || name == f.Type.Package || strings.HasPrefix(f.Type.Type, name+".") {
+		name += "ID"
+	}
+	return name
+}
+
+func firstSegment(p string) string {
+	segment, _, _ := strings.Cut(strings.TrimPrefix(p, "/"), "/")
+	return segment
+}
+
+// pathParameters returns the names of the parameters of a path, in order.
+func pathParameters(p string) []string {
+	var params []string
+	for _, segment := range strings.Split(p, "/") {
+		if isParameter(segment) {
+			params = append(params, strings.Trim(segment, "{}"))
+		}
+	}
+	return params
+}
+
+func isParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// parameterStem returns the name of a path parameter without braces and its _id suffix, e.g. region.
+func parameterStem(segment string) string {
+	return strings.TrimSuffix(strings.Trim(segment, "{}"), "_id")
+}
+
+// singularWire returns the singular of a snake case name, e.g. asteroid_belts is asteroid_belt.
+func singularWire(wire string) string {
+	switch {
+	case strings.HasSuffix(wire, "ies") && len(wire) > 3:
+		return wire[:len(wire)-3] + "y"
+	case strings.HasSuffix(wire, "s") && !strings.HasSuffix(wire, "ss") && !strings.HasSuffix(wire, "us"):
+		return wire[:len(wire)-1]
+	default:
+		return wire
+	}
+}
+
+func longer(a, b string) string {
+	if len(b) > len(a) {
+		return b
+	}
+	return a
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,93 @@
+package requestgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestGenerateClient(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	ops, err := requestgen.FindOperations(spec, []string{"ALL_PATHS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	var packages []requestgen.PackageModel
+	for _, op := range ops {
+		pkg, err := requestgen.BuildPackage(op, spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	src, err := requestgen.GenerateClient("github.com/xaroth/lib-esi-go/esi", packages, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(src)
+	for _, want := range []string{
+		"package client",
+		"-package=mock github.com/xaroth/lib-esi-go/esi/client Alliances,Characters,Corporations,Universe\n",
+		`"github.com/xaroth/lib-esi-go/common/alliance"`,
+		"Alliances:    alliances{sender: sender},",
+		"\tAlliances(ctx context.Context, opts ...request.RequestOption) (*request.Response[getalliances.Output], error)",
+		"\tAlliance(ctx context.Context, allianceID alliance.Identifier, opts ...request.RequestOption) " +
+			"(*request.Response[*getalliancesallianceid.Output], error)",
+		"\tPostAffiliation(ctx context.Context, input *postcharactersaffiliation.Input, opts ...request.RequestOption)",
+		"\tDeleteFitting(ctx context.Context, characterId int64, fittingId int32, opts ...request.RequestOption) " +
+			"(*request.Response[struct{}], error)",
+		"\tStargate(ctx context.Context, stargateId int64, opts ...request.RequestOption)",
+		"\tFactions(ctx context.Context, opts ...request.RequestOption)",
+		"\treturn getalliancesallianceid.Request(ctx, c.sender, &getalliancesallianceid.Input{\n\t\tAlliance: allianceID,\n\t}, opts...)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("client missing %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestGenerateClient_methodNames(t *testing.T) {
+	testCases := []struct {
+		operationID string
+		method      string
+		path        string
+		expected    string
+	}{
+		{"GetCharactersCharacterIdWalletJournal", "GET", "/characters/{character_id}/wallet/journal", "// WalletJournal calls"},
+		{"GetMarketsRegionIdOrders", "GET", "/markets/{region_id}/orders", "// RegionOrders calls"},
+		{"GetMarketsGroupsMarketGroupId", "GET", "/markets/groups/{market_group_id}", "// MarketGroup calls"},
+		{"GetUniverseAsteroidBeltsAsteroidBeltId", "GET", "/universe/asteroid_belts/{asteroid_belt_id}", "// AsteroidBelt calls"},
+		{"GetCorporationCorporationIdMiningExtractions", "GET", "/corporation/{corporation_id}/mining/extractions", "// MiningExtractions calls"},
+		{"GetCorporationsCorporationId", "GET", "/corporations/{corporation_id}", "// Corporation calls"},
+		{"PutFleetsFleetIdMembersMemberId", "PUT", "/fleets/{fleet_id}/members/{member_id}", "// PutMember calls"},
+		{"PostRoute", "POST", "/route/{origin_system_id}/{destination_system_id}", "// PostRoute calls"},
+		{"GetStatus", "GET", "/status", "// Status calls"},
+		// Operations the paths give the same name are named after their operationIds.
+		{"GetCharactersCharacterIdMail", "GET", "/characters/{character_id}/mail", "// Mail calls"},
+		{"GetCharactersCharacterIdMailMailId", "GET", "/characters/{character_id}/mail/{mail_id}", "// MailMail calls"},
+	}
+	var packages []requestgen.PackageModel
+	for _, tc := range testCases {
+		packages = append(packages, requestgen.PackageModel{
+			OperationID: tc.operationID,
+			PackageName: requestgen.PackageNameFromOperationID(tc.operationID),
+			Method:      tc.method,
+			Path:        tc.path,
+			OutputType:  "*Output",
+		})
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	src, err := requestgen.GenerateClient("github.com/xaroth/lib-esi-go/esi", packages, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range testCases {
+		if !strings.Contains(string(src), tc.expected+" "+tc.method+" "+tc.path+".") {
+			t.Errorf("%s: missing %q", tc.operationID, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package requestgen
+
+import (
+	"strconv"
+	"strings"
+)
+
+// dedup collapses nested structs of identical shape into the first of them, and returns the root fields
+// with their references updated. With Config.StructAliases the other structs are kept as aliases of the first,
+// so their names keep working; otherwise they are dropped, and every reference to them is replaced.
+//
+// Structs that only differ in which of those structs they reference are identical as well,
+// so the pass repeats until no more structs collapse.
+func (sb *structBuilder) dedup(root []StructField) []StructField {
+	canonical := make(map[string]string)
+	for {
+		firsts := make(map[string]string)
+		collapsed := false
+		for _, def := range sb.nested {
+			if def.Alias != nil || canonical[def.Name] != "" {
+				continue
+			}
+			shape := structShape(def.Fields, canonical)
+			if first, ok := firsts[shape]; ok {
+				canonical[def.Name] = first
+				collapsed = true
+				continue
+			}
+			firsts[shape] = def.Name
+		}
+		if !collapsed {
+			break
+		}
+	}
+	if len(canonical) == 0 {
+		return root
+	}
+
+	aliases := sb.mapper.cfg.StructAliases
+	nested := make([]StructDef, 0, len(sb.nested))
+	for _, def := range sb.nested {
+		if first := resolveStructName(def.Name, canonical); first != def.Name {
+			if aliases {
+				nested = append(nested, StructDef{Name: def.Name, Alias: &GoType{Type: first}})
+			}
+			continue
+		}
+		if !aliases {
+			def.Fields = retypeFields(def.Fields, canonical)
+		}
+		nested = append(nested, def)
+	}
+	sb.nested = nested
+
+	if aliases {
+		return root
+	}
+	return retypeFields(root, canonical)
+}
+
+// structShape is the identity of a struct for deduplication: its fields with names, types and tags,
+// but not their comments. References to collapsed structs count as references to the struct they collapsed into.
+func structShape(fields []StructField, canonical map[string]string) string {
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(f.Name)
+		b.WriteByte(' ')
+		b.WriteString(retype(f.Type.Type, canonical))
+		b.WriteByte(' ')
+		b.WriteString(f.TagKey + ":" + strconv.Quote(f.TagVal))
+		if f.TagOmitEmpty {
+			b.WriteString(",omitempty")
+		}
+		if f.TagRequired {
+			b.WriteString(" required")
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// resolveStructName follows collapsed structs to the struct they ended up in.
+func resolveStructName(name string, canonical map[string]string) string {
+	for {
+		next, ok := canonical[name]
+		if !ok {
+			return name
+		}
+		name = next
+	}
+}
+
+// retype replaces the struct a type refers to, keeping slices and pointers, e.g. []Alliances → []Agents.
+func retype(typ string, canonical map[string]string) string {
+	base := strings.TrimLeft(typ, "[]*")
+	return strings.TrimSuffix(typ, base) + resolveStructName(base, canonical)
+}
+
+func retypeFields(fields []StructField, canonical map[string]string) []StructField {
+	out := make([]StructField, len(fields))
+	for i, f := range fields {
+		f.Type.Type = retype(f.Type.Type, canonical)
+		out[i] = f
+	}
+	return out
+}
@@ -0,0 +1,248 @@
+package requestgen_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+// dedupSpec has nested structs of identical shapes, within and across operations.
+const dedupSpec = `{
+  "paths": {
+    "/universe/ids": {
+      "post": {
+        "operationId": "PostUniverseIds",
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "object",
+          "properties": {
+            "agents": { "type": "array", "items": {
+              "type": "object",
+              "properties": { "id": { "type": "integer", "format": "int64" }, "name": { "type": "string" } }
+            } },
+            "alliances": { "type": "array", "items": {
+              "type": "object",
+              "properties": { "id": { "type": "integer", "format": "int64" }, "name": { "type": "string" } }
+            } },
+            "characters": { "type": "array", "items": {
+              "type": "object",
+              "properties": { "id": { "type": "integer", "format": "int64" }, "name": { "type": "string" } }
+            } },
+            "contacts": { "type": "array", "items": {
+              "type": "object",
+              "properties": { "labels": { "type": "array", "items": { "$ref": "#/components/schemas/ContactLabel" } } }
+            } },
+            "blocked": { "type": "array", "items": {
+              "type": "object",
+              "properties": { "labels": { "type": "array", "items": { "$ref": "#/components/schemas/BlockedLabel" } } }
+            } },
+            "position": { "$ref": "#/components/schemas/Position" }
+          }
+        } } } } }
+      }
+    },
+    "/universe/stations/{station_id}": {
+      "get": {
+        "operationId": "GetUniverseStationsStationId",
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "object",
+          "required": ["position"],
+          "properties": {
+            "name": { "type": "string" },
+            "position": { "$ref": "#/components/schemas/Position" }
+          }
+        } } } } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "ContactLabel": {
+        "type": "object",
+        "properties": { "label_id": { "type": "integer", "format": "int64" } }
+      },
+      "BlockedLabel": {
+        "type": "object",
+        "properties": { "label_id": { "type": "integer", "format": "int64" } }
+      },
+      "Position": {
+        "type": "object",
+        "required": ["x", "y", "z"],
+        "properties": {
+          "x": { "type": "number", "format": "double" },
+          "y": { "type": "number", "format": "double" },
+          "z": { "type": "number", "format": "double" }
+        }
+      }
+    }
+  }
+}`
+
+func buildDedupPackages(t *testing.T, cfg requestgen.Config) map[string]requestgen.PackageModel {
+	t.Helper()
+	var spec openapi.Spec
+	if err := json.Unmarshal([]byte(dedupSpec), &spec); err != nil {
+		t.Fatal(err)
+	}
+	ops, err := requestgen.FindOperations(&spec, []string{"PostUniverseIds", "GetUniverseStationsStationId"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	packages := make(map[string]requestgen.PackageModel)
+	for _, op := range ops {
+		pkg, err := requestgen.BuildPackage(op, &spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages[pkg.PackageName] = pkg
+	}
+	return packages
+}
+
+func generatedOutput(t *testing.T, pkg requestgen.PackageModel, cfg requestgen.Config) string {
+	t.Helper()
+	files, err := requestgen.GeneratePackage(pkg, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ignore the alignment of gofmt.
+	return strings.Join(strings.Fields(string(files.Output)), " ")
+}
+
+func TestGeneratePackage_dedup(t *testing.T) {
+	testCases := []struct {
+		name        string
+		aliases     bool
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:    "aliases",
+			aliases: true,
+			contains: []string{
+				"Alliances []Alliances `json:\"alliances\"`",
+				"type Agents struct { Id *int64 `json:\"id\"` Name *string `json:\"name\"` }",
+				"type Alliances = Agents",
+				"type Characters = Agents",
+				// Structs that only differ in the identical structs they reference collapse as well.
+				"Blocked []Blocked `json:\"blocked\"`",
+				"Contacts []Contacts `json:\"contacts\"`",
+				"type ContactLabel = BlockedLabel",
+				"type Contacts = Blocked",
+			},
+			notContains: []string{
+				"type Alliances struct",
+				"type ContactLabel struct",
+				"type Contacts struct",
+			},
+		},
+		{
+			name: "no aliases",
+			contains: []string{
+				"Alliances []Agents `json:\"alliances\"`",
+				"Characters []Agents `json:\"characters\"`",
+				"Blocked []Blocked `json:\"blocked\"`",
+				"Contacts []Blocked `json:\"contacts\"`",
+				"type Blocked struct { Labels []BlockedLabel `json:\"labels\"` }",
+			},
+			notContains: []string{
+				"type Alliances",
+				"ContactLabel",
+				"type Contacts ",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common", StructAliases: testCase.aliases}
+			output := generatedOutput(t, buildDedupPackages(t, cfg)["postuniverseids"], cfg)
+			for _, want := range testCase.contains {
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			for _, unwanted := range testCase.notContains {
+				if strings.Contains(output, unwanted) {
+					t.Errorf("output contains %q:\n%s", unwanted, output)
+				}
+			}
+		})
+	}
+}
+
+func TestPromoteSharedStructs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		aliases  bool
+		contains map[string][]string
+	}{
+		{
+			name:    "aliases",
+			aliases: true,
+			contains: map[string][]string{
+				"getuniversestationsstationid": {
+					"Position Position `json:\"position\"`",
+					"type Position = shared.Position",
+					`"github.com/xaroth/lib-esi-go/common/shared"`,
+				},
+				"postuniverseids": {
+					"Position *Position `json:\"position\"`",
+					"type Position = shared.Position",
+				},
+			},
+		},
+		{
+			name: "no aliases",
+			contains: map[string][]string{
+				"getuniversestationsstationid": {
+					"Position shared.Position `json:\"position\"`",
+					`"github.com/xaroth/lib-esi-go/common/shared"`,
+				},
+				"postuniverseids": {
+					"Position *shared.Position `json:\"position\"`",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common", StructAliases: testCase.aliases}
+			built := buildDedupPackages(t, cfg)
+			packages, shared := requestgen.PromoteSharedStructs([]requestgen.PackageModel{
+				built["getuniversestationsstationid"],
+				built["postuniverseids"],
+			}, cfg)
+
+			// Agents only recurs within one package, and Contacts references other nested structs.
+			if len(shared) != 1 || shared[0].Name != "Position" {
+				t.Fatalf("unexpected shared structs %+v", shared)
+			}
+
+			for _, pkg := range packages {
+				output := generatedOutput(t, pkg, cfg)
+				for _, want := range testCase.contains[pkg.PackageName] {
+					if !strings.Contains(output, want) {
+						t.Errorf("%s output missing %q:\n%s", pkg.PackageName, want, output)
+					}
+				}
+				if strings.Contains(output, "type Position struct") {
+					t.Errorf("%s output still declares Position:\n%s", pkg.PackageName, output)
+				}
+			}
+
+			src, err := requestgen.GenerateSharedStructs(shared, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := strings.Join(strings.Fields(string(src)), " ")
+			if !strings.Contains(out, "package shared") ||
+				!strings.Contains(out, "type Position struct { X float64 `json:\"x\"` Y float64 `json:\"y\"` Z float64 `json:\"z\"` }") {
+				t.Errorf("shared: %s", src)
+			}
+		})
+	}
+}
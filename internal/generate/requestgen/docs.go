@@ -0,0 +1,118 @@
+package requestgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+)
+
+// PackageDoc returns the lines of the package doc comment of an operation package:
+// the method and path, the summary and description, the required scopes, and the cache time.
+func PackageDoc(m PackageModel) []string {
+	lines := []string{fmt.Sprintf("Package %s implements %s %s.", m.PackageName, m.Method, m.Path)}
+	// Punctuation also keeps gofmt from turning a single line into a heading.
+	for _, text := range []string{sentence(m.Summary), sentence(m.Description)} {
+		if paragraph := commentLines(text); len(paragraph) > 0 && !sameText(paragraph, lines[len(lines)-1]) {
+			lines = append(lines, "")
+			lines = append(lines, paragraph...)
+		}
+	}
+
+	switch len(m.Security) {
+	case 0:
+	case 1:
+		lines = append(lines, "", "Requires the "+joinScopes(m.Security[0])+".")
+	default:
+		lines = append(lines, "", "Requires any one of these sets of scopes:", "")
+		for _, scopes := range m.Security {
+			lines = append(lines, "  - "+strings.Join(scopes, ", "))
+		}
+	}
+	if len(m.RequiredRoles) > 0 {
+		lines = append(lines, "", "The character needs the corporation role "+strings.Join(m.RequiredRoles, " or ")+".")
+	}
+	if m.CacheSeconds > 0 {
+		lines = append(lines, "", fmt.Sprintf("Responses are cached for up to %d seconds.", m.CacheSeconds))
+	}
+	return lines
+}
+
+// fieldDoc returns the comment lines of a field from its description, followed by the values
+// of its enum and their meanings. Enums of common models are documented in their own package.
+func fieldDoc(description string, schema openapi.Schema, common bool) []string {
+	lines := commentLines(description)
+	if common || len(schema.Enum) == 0 {
+		return lines
+	}
+	if len(lines) > 0 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, "One of:")
+	for i, value := range schema.Enum {
+		item := fmt.Sprintf("  - %v", value)
+		if len(schema.XEnumDescriptions) == len(schema.Enum) {
+			if meaning := strings.Join(strings.Fields(schema.XEnumDescriptions[i]), " "); meaning != "" {
+				item += ": " + meaning
+			}
+		}
+		lines = append(lines, item)
+	}
+	return lines
+}
+
+// fieldSchemaDoc resolves the schema of a field, and returns its comment lines.
+// The description next to a $ref takes precedence over the one of the referenced schema.
+func fieldSchemaDoc(resolver *openapi.Resolver, mapper *TypeMapper, description string, ref openapi.SchemaRef) []string {
+	schema, schemaName, err := resolver.ResolveSchemaRef(ref)
+	if err != nil {
+		return commentLines(description)
+	}
+	if description == "" {
+		description = schema.Description
+	}
+	if schema.Type == "array" && schema.Items != nil {
+		if items, itemName, err := resolver.ResolveSchemaRef(*schema.Items); err == nil {
+			schema, schemaName = items, itemName
+		}
+	}
+	return fieldDoc(description, schema, schemaName != "" && mapper.commonSchemas[schemaName])
+}
+
+// commentLines splits text into comment lines, dropping trailing whitespace and blank lines
+// at either end. Blank lines in between are kept to separate paragraphs.
+func commentLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// sentence ends the text with a period, as summaries and descriptions in the spec usually lack one.
+func sentence(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" || strings.HasSuffix(text, ".") || strings.HasSuffix(text, "!") || strings.HasSuffix(text, "?") {
+		return text
+	}
+	return text + "."
+}
+
+// sameText reports whether a paragraph only repeats the given line, ignoring a trailing period,
+// e.g. a description that equals the summary.
+func sameText(paragraph []string, line string) bool {
+	return len(paragraph) == 1 && strings.TrimSuffix(paragraph[0], ".") == strings.TrimSuffix(line, ".")
+}
+
+func joinScopes(scopes []string) string {
+	if len(scopes) == 1 {
+		return scopes[0] + " scope"
+	}
+	return "scopes " + strings.Join(scopes[:len(scopes)-1], ", ") + " and " + scopes[len(scopes)-1]
+}
@@ -0,0 +1,122 @@
+package requestgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestGeneratePackage_docs(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+
+	generate := func(t *testing.T, operationID string) requestgen.GeneratedFiles {
+		t.Helper()
+		ops, err := requestgen.FindOperations(spec, []string{operationID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if operationID == "GetCorporationsProjectsDetail" {
+			ops[0].Spec.Security = []openapi.SecurityRequirement{
+				{"OAuth2": {"esi-corporations.read_projects.v1"}},
+				{"OAuth2": {"esi-corporations.read_structures.v1", "esi-fittings.write_fittings.v1"}},
+			}
+		}
+		pkg, err := requestgen.BuildPackage(ops[0], spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files, err := requestgen.GeneratePackage(pkg, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return files
+	}
+
+	testCases := []struct {
+		name        string
+		operationID string
+		file        func(files requestgen.GeneratedFiles) []byte
+		contains    []string
+	}{
+		{
+			name:        "package doc",
+			operationID: "GetAlliancesAllianceId",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Request },
+			contains: []string{
+				"// Package getalliancesallianceid implements GET /alliances/{alliance_id}.\n//\n" +
+					"// Get alliance information.\n//\n" +
+					"// Public information about an alliance.\n//\n" +
+					"// Responses are cached for up to 3600 seconds.\npackage getalliancesallianceid\n",
+			},
+		},
+		{
+			name:        "package doc with scopes and roles",
+			operationID: "GetCorporationsProjectsDetail",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Request },
+			contains: []string{
+				"// Requires any one of these sets of scopes:\n//\n" +
+					"//   - esi-corporations.read_projects.v1\n" +
+					"//   - esi-corporations.read_structures.v1, esi-fittings.write_fittings.v1\n",
+				"// The character needs the corporation role Director or Project_Manager.\npackage",
+			},
+		},
+		{
+			name:        "package doc with one scope",
+			operationID: "DeleteCharactersCharacterIdFittingsFittingId",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Request },
+			contains: []string{
+				"//\n// Requires the esi-fittings.write_fittings.v1 scope.\npackage",
+			},
+		},
+		{
+			name:        "parameter description",
+			operationID: "GetAlliancesAllianceId",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Input },
+			contains: []string{
+				"\t// The ID of the alliance\n\tAlliance alliance.Identifier",
+			},
+		},
+		{
+			name:        "property descriptions",
+			operationID: "GetAlliancesAllianceId",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Output },
+			contains: []string{
+				"\t// the executor corporation ID, if this alliance is not closed\n\tExecutorCorporation",
+				"\t// the full name of the alliance\n\tName string",
+			},
+		},
+		{
+			name:        "enum descriptions",
+			operationID: "GetCorporationsProjectsDetail",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Output },
+			contains: []string{
+				"\t// The state of the project.\n\t//\n\t// One of:\n" +
+					"\t//   - Active: Open for contributions\n" +
+					"\t//   - Closed: Closed by the corporation\n" +
+					"\t//   - Completed\n" +
+					"\tState *string",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			src := string(testCase.file(generate(t, testCase.operationID)))
+			for _, want := range testCase.contains {
+				if !strings.Contains(src, want) {
+					t.Errorf("missing %q:\n%s", want, src)
+				}
+			}
+		})
+	}
+
+	// Operations without descriptions only name the method and path.
+	req := string(generate(t, "GetUniverseFactions").Request)
+	if !strings.Contains(req, "// Package getuniversefactions implements GET /universe/factions.\npackage getuniversefactions\n") {
+		t.Errorf("request: %s", req)
+	}
+}
@@ -0,0 +1,83 @@
+package requestgen_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/common/character"
+	"github.com/xaroth/lib-esi-go/esi/client"
+	"github.com/xaroth/lib-esi-go/esi/client/mock"
+	"github.com/xaroth/lib-esi-go/esi/esitest"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridwalletjournal"
+	"github.com/xaroth/lib-esi-go/esi/getmarketsregionidorders"
+	"github.com/xaroth/lib-esi-go/request"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_new(t *testing.T) {
+	srv := esitest.NewServer()
+	orders := []*getmarketsregionidorders.Output{{OrderId: 6325472398, Price: 4.5, VolumeRemain: 10}}
+	srv.On(getmarketsregionidorders.Route).Return(orders)
+	c := client.New(srv)
+
+	input := &getmarketsregionidorders.Input{OrderType: "sell", RegionId: 1}
+	resp, err := c.Markets.RegionOrders(t.Context(), 10000002, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d %+v", resp.StatusCode, resp.ErrorData)
+	}
+	if diff := cmp.Diff(orders, resp.Data); diff != "" {
+		t.Errorf("data mismatch (-want +got):\n%s", diff)
+	}
+	if input.RegionId != 1 {
+		t.Errorf("input.RegionId = %d, the input of the caller should not change", input.RegionId)
+	}
+	if srv.Calls(getmarketsregionidorders.Route) != 1 {
+		t.Errorf("calls = %d, want 1", srv.Calls(getmarketsregionidorders.Route))
+	}
+
+	// A nil input is the zero input, which is missing the required order type.
+	if _, err := c.Markets.RegionOrders(t.Context(), 10000002, nil); err == nil {
+		t.Error("expected an error for the missing order type")
+	}
+}
+
+// balance sums the journal of a character, as code under test would.
+func balance(ctx context.Context, c *client.Client, characterID character.Identifier) (float64, error) {
+	resp, err := c.Characters.WalletJournal(ctx, characterID, nil)
+	if err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, entry := range resp.Data {
+		if entry.Amount != nil {
+			total += *entry.Amount
+		}
+	}
+	return total, nil
+}
+
+func TestClient_mock(t *testing.T) {
+	characters := mock.NewMockCharacters(gomock.NewController(t))
+	characters.EXPECT().
+		WalletJournal(gomock.Any(), character.Identifier(90000001), gomock.Nil()).
+		Return(&request.Response[[]*getcharacterscharacteridwalletjournal.Output]{
+			Data: []*getcharacterscharacteridwalletjournal.Output{{Amount: ptr(100.0)}, {Amount: ptr(-25.5)}, {}},
+		}, nil)
+
+	total, err := balance(t.Context(), &client.Client{Characters: characters}, 90000001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 74.5 {
+		t.Errorf("balance = %v, want 74.5", total)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
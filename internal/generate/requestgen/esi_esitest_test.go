@@ -0,0 +1,145 @@
+package requestgen_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/esi/esitest"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridassets"
+	"github.com/xaroth/lib-esi-go/esi/registry"
+)
+
+func TestEsitest_everyPackageServed(t *testing.T) {
+	requests, err := filepath.Glob(filepath.Join(esiDir(t), "*", "request.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var packages []string
+	for _, request := range requests {
+		packages = append(packages, filepath.Base(filepath.Dir(request)))
+	}
+
+	// Operations may share a route, but not their types.
+	key := func(route string, input, output reflect.Type) string {
+		return route + " " + input.String() + " " + output.String()
+	}
+	descriptors := make(map[string]string)
+	for _, d := range registry.All() {
+		input := d.Input
+		if input == nil {
+			input = reflect.TypeFor[struct{}]()
+		}
+		descriptors[key(d.Route(), input, d.Output)] = filepath.Base(d.Package)
+	}
+	var served []string
+	for _, op := range esitest.Operations {
+		served = append(served, descriptors[key(op.Route.String(), op.Route.InputType(), op.Route.OutputType())])
+	}
+	sort.Strings(served)
+
+	if diff := cmp.Diff(packages, served); diff != "" {
+		t.Errorf("served packages mismatch (-generated +served):\n%s", diff)
+	}
+}
+
+// TestNewServer sends a request with the required parameters of every operation, and decodes its example.
+func TestEsitest_newServer(t *testing.T) {
+	srv := esitest.NewServer()
+	statuses := make(map[string]int)
+	for _, op := range esitest.Operations {
+		statuses[op.Route.String()] = op.Status
+	}
+
+	for _, d := range registry.All() {
+		t.Run(d.OperationID, func(t *testing.T) {
+			var input []byte
+			if d.Input != nil {
+				value, ok := requiredInput(d.Input)
+				if !ok {
+					t.Skip("the request package cannot encode the parameters yet")
+				}
+				var err error
+				if input, err = json.Marshal(value.Interface()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			resp, err := d.Invoke(t.Context(), srv, input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != statuses[d.Route()] {
+				t.Errorf("status = %d %+v, want %d", resp.StatusCode, resp.ErrorData, statuses[d.Route()])
+			}
+		})
+	}
+}
+
+func TestEsitest_registered(t *testing.T) {
+	srv := esitest.NewServer()
+	assets := []*getcharacterscharacteridassets.Output{{ItemId: 1000000016991, LocationFlag: "Hangar", Quantity: 1}}
+	srv.On(getcharacterscharacteridassets.Route).Return(assets).Pages(2)
+
+	resp, err := getcharacterscharacteridassets.Request(t.Context(), srv, &getcharacterscharacteridassets.Input{Character: 90000001})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Pages") != "2" {
+		t.Errorf("unexpected response %d with X-Pages %q", resp.StatusCode, resp.Header.Get("X-Pages"))
+	}
+	if diff := cmp.Diff(assets, resp.Data); diff != "" {
+		t.Errorf("data mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// requiredInput returns an input with its path, required and body fields set. It reports false if the input
+// has a required parameter of a type the request package cannot encode.
+func requiredInput(typ reflect.Type) (reflect.Value, bool) {
+	input := reflect.New(typ).Elem()
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		_, path := field.Tag.Lookup("path")
+		_, body := field.Tag.Lookup("body")
+		if !path && !body && field.Tag.Get("required") != "true" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.Bool, reflect.Float64, reflect.Slice:
+			if !body {
+				return reflect.Value{}, false
+			}
+		}
+		input.Field(i).Set(filled(field.Type))
+	}
+	return input, true
+}
+
+// filled returns a value of the type without zero values, which every required parameter needs.
+func filled(typ reflect.Type) reflect.Value {
+	value := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.Pointer:
+		value.Set(reflect.New(typ.Elem()))
+		value.Elem().Set(filled(typ.Elem()))
+	case reflect.Slice:
+		value.Set(reflect.Append(value, filled(typ.Elem())))
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			value.Field(i).Set(filled(typ.Field(i).Type))
+		}
+	case reflect.String:
+		value.SetString("a")
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		value.SetInt(1)
+	case reflect.Float64:
+		value.SetFloat(1)
+	case reflect.Bool:
+		value.SetBool(true)
+	}
+	return value
+}
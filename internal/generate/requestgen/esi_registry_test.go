@@ -0,0 +1,169 @@
+package requestgen_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridassets"
+	"github.com/xaroth/lib-esi-go/esi/getsovereigntymap"
+	"github.com/xaroth/lib-esi-go/esi/registry"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+// esiDir returns the directory of the committed operation packages.
+func esiDir(t *testing.T) string {
+	t.Helper()
+	root, err := openapi.ModuleRoot(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(root, "esi")
+}
+
+// senderFunc adapts a function to request.RequestSender.
+type senderFunc func(req *http.Request) (*http.Response, error)
+
+func (f senderFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// respond answers every request with the given body, recording the last request.
+func respond(last **http.Request, body string) senderFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		*last = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+}
+
+func TestRegistry_everyPackageRegistered(t *testing.T) {
+	dir := esiDir(t)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every directory is either an operation package, or one of the packages generated next to them.
+	extra := map[string]bool{
+		requestgen.RegistryPackage:   true,
+		requestgen.ScopesPackage:     true,
+		requestgen.FakeServerPackage: true,
+		requestgen.ClientPackage:     true,
+	}
+	var packages []string
+	for _, entry := range entries {
+		if !entry.IsDir() || extra[entry.Name()] {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name(), "request.go"))
+		if err != nil || !strings.HasPrefix(string(data), "// Code generated by cmd/generate-request; DO NOT EDIT.") {
+			t.Errorf("%s: not a generated operation package", entry.Name())
+			continue
+		}
+		packages = append(packages, entry.Name())
+	}
+	if len(packages) == 0 {
+		t.Fatal("no generated packages found")
+	}
+
+	var registered []string
+	for _, d := range registry.All() {
+		registered = append(registered, path.Base(d.Package))
+
+		if other, ok := registry.Lookup(d.OperationID); !ok || other.Package != d.Package {
+			t.Errorf("Lookup(%q) = %s, %v", d.OperationID, other.Package, ok)
+		}
+		if d.Invoke == nil || d.Output == nil {
+			t.Errorf("%s: incomplete descriptor", d.OperationID)
+		}
+	}
+	sort.Strings(registered)
+
+	if diff := cmp.Diff(packages, registered); diff != "" {
+		t.Errorf("registered packages mismatch (-generated +registered):\n%s", diff)
+	}
+}
+
+func TestRegistry_descriptor(t *testing.T) {
+	d, ok := registry.Lookup("GetCharactersCharacterIdAssets")
+	if !ok {
+		t.Fatal("GetCharactersCharacterIdAssets not registered")
+	}
+	if d.Route() != "GET /characters/{character_id}/assets" {
+		t.Errorf("Route() = %q", d.Route())
+	}
+	if diff := cmp.Diff([][]string{{"esi-assets.read_assets.v1"}}, d.Security); diff != "" {
+		t.Errorf("Security mismatch (-want +got):\n%s", diff)
+	}
+	if d.Input != reflect.TypeFor[getcharacterscharacteridassets.Input]() {
+		t.Errorf("Input = %v", d.Input)
+	}
+	if d.Output != reflect.TypeFor[[]*getcharacterscharacteridassets.Output]() {
+		t.Errorf("Output = %v", d.Output)
+	}
+	if !d.Paginated {
+		t.Error("expected the assets to be paginated")
+	}
+
+	if _, ok := registry.Lookup("GetNoSuchOperation"); ok {
+		t.Error("expected unknown operations not to be found")
+	}
+}
+
+func TestRegistry_invoke(t *testing.T) {
+	t.Run("input", func(t *testing.T) {
+		var last *http.Request
+		d, _ := registry.Lookup("GetCharactersCharacterIdAssets")
+		resp, err := d.Invoke(t.Context(), respond(&last, `[{"item_id": 7}]`), json.RawMessage(`{"Character": 42, "Page": 2}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last.URL.Path != "/characters/42/assets" || last.URL.Query().Get("page") != "2" {
+			t.Errorf("unexpected request %s", last.URL)
+		}
+		assets, ok := resp.Data.([]*getcharacterscharacteridassets.Output)
+		if !ok || len(assets) != 1 || assets[0].ItemId != 7 {
+			t.Errorf("unexpected data %#v", resp.Data)
+		}
+	})
+
+	t.Run("static", func(t *testing.T) {
+		var last *http.Request
+		d, _ := registry.Lookup("GetSovereigntyMap")
+		resp, err := d.Invoke(t.Context(), respond(&last, `[{"system_id": 30000142}]`), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last.URL.Path != "/sovereignty/map" {
+			t.Errorf("unexpected request %s", last.URL)
+		}
+		if systems, ok := resp.Data.([]*getsovereigntymap.Output); !ok || len(systems) != 1 {
+			t.Errorf("unexpected data %#v", resp.Data)
+		}
+	})
+
+	t.Run("error: invalid input", func(t *testing.T) {
+		var last *http.Request
+		d, _ := registry.Lookup("GetCharactersCharacterIdAssets")
+		if _, err := d.Invoke(t.Context(), respond(&last, `[]`), json.RawMessage(`{"Character": "x"}`)); err == nil {
+			t.Fatal("expected an error")
+		}
+		if last != nil {
+			t.Error("expected no request to be sent")
+		}
+	})
+}
@@ -0,0 +1,306 @@
+package requestgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"net/http"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+const (
+	// FakeServerPackage is the directory, relative to the output directory, of the generated fake server.
+	FakeServerPackage = "esitest"
+
+	// FakeServerFile is the name of the generated fake server.
+	FakeServerFile = "esitest.go"
+)
+
+// Values of synthesized examples of string formats.
+const (
+	exampleDateTime = "2003-05-06T11:00:00Z"
+	exampleDate     = "2003-05-06"
+	exampleUUID     = "3868eaed-8278-4cb7-9709-7d7de9c20dc7"
+)
+
+type fakeEnumData struct {
+	Location string
+	Values   string
+}
+
+type fakeOperationData struct {
+	PackageName  string
+	Import       string
+	Status       string
+	CacheSeconds int
+	Enums        []fakeEnumData
+	Example      string
+}
+
+type fakeServerTemplateData struct {
+	FakeESIImport     string
+	RateLimitsImport  string
+	RateLimitsPackage string
+	Operations        []fakeOperationData
+}
+
+func (c Config) fakeESIImport() string {
+	return c.LibModule + "/fakeesi"
+}
+
+// GenerateFakeServer renders the esitest package, which serves every operation from a fake server.
+// packagesImport is the import path the operation packages and their rate limit groups are generated under.
+func GenerateFakeServer(packagesImport, packagesName string, packages []PackageModel, cfg Config) ([]byte, error) {
+	operations := make([]fakeOperationData, 0, len(packages))
+	for _, pkg := range packages {
+		data := fakeOperationData{
+			PackageName:  pkg.PackageName,
+			Import:       path.Join(packagesImport, pkg.PackageName),
+			Status:       statusLiteral(pkg.Status),
+			CacheSeconds: pkg.CacheSeconds,
+			Example:      stringLiteral(pkg.Example),
+		}
+		for _, location := range sortedKeys(pkg.ParameterEnums) {
+			values := make([]string, len(pkg.ParameterEnums[location]))
+			for i, value := range pkg.ParameterEnums[location] {
+				values[i] = strconv.Quote(value)
+			}
+			data.Enums = append(data.Enums, fakeEnumData{
+				Location: strconv.Quote(location),
+				Values:   strings.Join(values, ", "),
+			})
+		}
+		operations = append(operations, data)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].PackageName < operations[j].PackageName
+	})
+
+	src, err := executeTemplate("esitest.go.tmpl", fakeServerTemplateData{
+		FakeESIImport:     cfg.fakeESIImport(),
+		RateLimitsImport:  packagesImport,
+		RateLimitsPackage: packagesName,
+		Operations:        operations,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source([]byte(generatedBy + src))
+	if err != nil {
+		return nil, fmt.Errorf("format fake server: %w", err)
+	}
+	return out, nil
+}
+
+// WriteFakeServer writes the esitest package into outDir.
+func WriteFakeServer(outDir string, packages []PackageModel, cfg Config, check bool) error {
+	moduleRoot, err := openapi.ModuleRoot(outDir)
+	if err != nil {
+		return err
+	}
+	modulePath, err := openapi.ModulePath(outDir)
+	if err != nil {
+		return err
+	}
+	importBase, err := openapi.ImportBase(moduleRoot, outDir)
+	if err != nil {
+		return err
+	}
+
+	src, err := GenerateFakeServer(path.Join(modulePath, importBase), registryPackageName(outDir), packages, cfg)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(outDir, FakeServerPackage, FakeServerFile), src, check)
+}
+
+// successStatus returns the status of the response the output is decoded from.
+func successStatus(op Operation) int {
+	for _, code := range successResponseCodes(op.Method) {
+		if resp, ok := op.Spec.Responses[code]; ok {
+			if _, ok := resp.Content["application/json"]; ok {
+				status, _ := strconv.Atoi(code)
+				return status
+			}
+		}
+	}
+	return http.StatusNoContent
+}
+
+// responseExample returns the JSON example of the success response, or "" if it has no content.
+func responseExample(op Operation, resolver *openapi.Resolver) (string, error) {
+	for _, code := range successResponseCodes(op.Method) {
+		mt, ok := op.Spec.Responses[code].Content["application/json"]
+		if !ok {
+			continue
+		}
+		value, err := exampleValue(resolver, mt.Schema, nil)
+		if err != nil {
+			return "", err
+		}
+		out, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	}
+	return "", nil
+}
+
+// exampleValue synthesizes an example of a schema from its example or examples, or else its first enum value,
+// its first oneOf variant, or a value of its type. Components already seen are left null, to stop at cycles.
+func exampleValue(resolver *openapi.Resolver, ref openapi.SchemaRef, seen []string) (any, error) {
+	schema, name, err := resolver.ResolveSchemaRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		if slices.Contains(seen, name) {
+			return nil, nil
+		}
+		seen = append(seen, name)
+	}
+
+	for _, raw := range []json.RawMessage{ref.Example, schema.Example} {
+		if len(raw) > 0 {
+			var example any
+			err := json.Unmarshal(raw, &example)
+			return example, err
+		}
+	}
+	for _, raw := range []json.RawMessage{ref.Examples, schema.Examples} {
+		if example, ok, err := firstExample(raw); err != nil || ok {
+			return example, err
+		}
+	}
+	if enum := enumOf(ref, schema); len(enum) > 0 {
+		return enum[0], nil
+	}
+	if len(schema.OneOf) > 0 {
+		return exampleValue(resolver, schema.OneOf[0], seen)
+	}
+
+	switch schema.Type {
+	case "object":
+		object := make(map[string]any, len(schema.Properties))
+		for prop, propRef := range schema.Properties {
+			if object[prop], err = exampleValue(resolver, propRef, seen); err != nil {
+				return nil, err
+			}
+		}
+		return object, nil
+	case "array":
+		if schema.Items == nil {
+			return []any{}, nil
+		}
+		item, err := exampleValue(resolver, *schema.Items, seen)
+		if err != nil {
+			return nil, err
+		}
+		return []any{item}, nil
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			return exampleDateTime, nil
+		case "date":
+			return exampleDate, nil
+		case "uuid":
+			return exampleUUID, nil
+		}
+		return "", nil
+	case "integer", "number":
+		return 0, nil
+	case "boolean":
+		return false, nil
+	default:
+		return nil, nil
+	}
+}
+
+// firstExample returns the first value of the examples keyword.
+func firstExample(raw json.RawMessage) (any, bool, error) {
+	if len(raw) == 0 {
+		return nil, false, nil
+	}
+	var examples any
+	if err := json.Unmarshal(raw, &examples); err != nil {
+		return nil, false, err
+	}
+	if list, ok := examples.([]any); ok {
+		if len(list) == 0 {
+			return nil, false, nil
+		}
+		return list[0], true, nil
+	}
+	return examples, true, nil
+}
+
+// enumValues returns the allowed values of a parameter, or of its items if it is an array.
+func enumValues(resolver *openapi.Resolver, ref openapi.SchemaRef) ([]string, error) {
+	schema, _, err := resolver.ResolveSchemaRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	enum := enumOf(ref, schema)
+	if len(enum) == 0 && schema.Type == "array" && schema.Items != nil {
+		return enumValues(resolver, *schema.Items)
+	}
+	values := make([]string, len(enum))
+	for i, value := range enum {
+		if f, ok := value.(float64); ok {
+			values[i] = strconv.FormatFloat(f, 'f', -1, 64)
+		} else {
+			values[i] = fmt.Sprint(value)
+		}
+	}
+	return values, nil
+}
+
+// enumOf returns the enum of a schema, or of the reference to it.
+func enumOf(ref openapi.SchemaRef, schema openapi.Schema) []any {
+	if len(schema.Enum) > 0 {
+		return schema.Enum
+	}
+	return ref.Enum
+}
+
+func statusLiteral(status int) string {
+	switch status {
+	case http.StatusOK:
+		return "http.StatusOK"
+	case http.StatusCreated:
+		return "http.StatusCreated"
+	case http.StatusNoContent:
+		return "http.StatusNoContent"
+	default:
+		return strconv.Itoa(status)
+	}
+}
+
+// stringLiteral quotes s as a raw string literal where possible, which keeps JSON readable.
+func stringLiteral(s string) string {
+	if s == "" {
+		return ""
+	}
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
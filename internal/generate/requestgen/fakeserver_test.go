@@ -0,0 +1,143 @@
+package requestgen_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+// fakeServerSpec has enum parameters, example and examples keywords, and responses of every success status.
+const fakeServerSpec = `{
+  "paths": {
+    "/markets/{region_id}/orders": {
+      "get": {
+        "operationId": "GetMarketsRegionIdOrders",
+        "x-cached-seconds": 300,
+        "parameters": [
+          { "name": "region_id", "in": "path", "required": true, "schema": { "type": "integer", "format": "int64" } },
+          { "name": "order_type", "in": "query", "required": true, "schema": { "type": "string", "enum": ["buy", "sell", "all"] } },
+          { "name": "flags", "in": "query", "schema": { "type": "array", "items": { "type": "integer", "enum": [1, 5000000] } } }
+        ],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "issued": { "type": "string", "format": "date-time" },
+              "is_buy_order": { "type": "boolean" },
+              "range": { "type": "string", "enum": ["station", "region"] },
+              "price": { "type": "number", "examples": [4.5] },
+              "location": { "$ref": "#/components/schemas/Location" }
+            }
+          }
+        } } } } }
+      }
+    },
+    "/characters/{character_id}/fittings": {
+      "post": {
+        "operationId": "PostCharactersCharacterIdFittings",
+        "parameters": [
+          { "name": "character_id", "in": "path", "required": true, "schema": { "type": "integer", "format": "int64" } }
+        ],
+        "responses": { "201": { "content": { "application/json": { "schema": {
+          "type": "object",
+          "properties": { "fitting_id": { "type": "integer", "format": "int64", "example": 12 } }
+        } } } } }
+      }
+    },
+    "/characters/{character_id}/fittings/{fitting_id}": {
+      "delete": {
+        "operationId": "DeleteCharactersCharacterIdFittingsFittingId",
+        "parameters": [
+          { "name": "character_id", "in": "path", "required": true, "schema": { "type": "integer", "format": "int64" } },
+          { "name": "fitting_id", "in": "path", "required": true, "schema": { "type": "integer", "format": "int64" } }
+        ],
+        "responses": { "204": {} }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Location": {
+        "type": "object",
+        "examples": [{ "location_id": 60003760, "name": "Jita IV - Moon 4" }],
+        "properties": {
+          "location_id": { "type": "integer", "format": "int64" },
+          "name": { "type": "string" }
+        }
+      }
+    }
+  }
+}`
+
+func TestGenerateFakeServer(t *testing.T) {
+	var spec openapi.Spec
+	if err := json.Unmarshal([]byte(fakeServerSpec), &spec); err != nil {
+		t.Fatal(err)
+	}
+	ops, err := requestgen.FindOperations(&spec, []string{"ALL_PATHS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	packages := make(map[string]requestgen.PackageModel)
+	var models []requestgen.PackageModel
+	for _, op := range ops {
+		pkg, err := requestgen.BuildPackage(op, &spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages[pkg.PackageName] = pkg
+		models = append(models, pkg)
+	}
+
+	orders := packages["getmarketsregionidorders"]
+	if diff := cmp.Diff(map[string][]string{
+		"query.order_type": {"buy", "sell", "all"},
+		"query.flags":      {"1", "5000000"},
+	}, orders.ParameterEnums); diff != "" {
+		t.Errorf("enums mismatch (-want +got):\n%s", diff)
+	}
+	const example = `[{"is_buy_order":false,"issued":"2003-05-06T11:00:00Z",` +
+		`"location":{"location_id":60003760,"name":"Jita IV - Moon 4"},"price":4.5,"range":"station"}]`
+	if orders.Example != example {
+		t.Errorf("Example = %s, want %s", orders.Example, example)
+	}
+
+	for name, expected := range map[string]int{
+		"getmarketsregionidorders":                     200,
+		"postcharacterscharacteridfittings":            201,
+		"deletecharacterscharacteridfittingsfittingid": 204,
+	} {
+		if packages[name].Status != expected {
+			t.Errorf("%s: Status = %d, want %d", name, packages[name].Status, expected)
+		}
+	}
+	if packages["deletecharacterscharacteridfittingsfittingid"].Example != "" {
+		t.Error("expected no example for a response without content")
+	}
+
+	src, err := requestgen.GenerateFakeServer("github.com/xaroth/lib-esi-go/esi", "esi", models, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ignore the alignment of gofmt.
+	out := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"package esitest",
+		`"github.com/xaroth/lib-esi-go/esi/getmarketsregionidorders"`,
+		"{ Route: deletecharacterscharacteridfittingsfittingid.Route, Status: http.StatusNoContent, },",
+		`{ Route: getmarketsregionidorders.Route, Status: http.StatusOK, CacheSeconds: 300, Enums: map[string][]string{ ` +
+			`"query.flags": {"1", "5000000"}, "query.order_type": {"buy", "sell", "all"}, }, Example: ` + "`" + example + "`, },",
+		"{ Route: postcharacterscharacteridfittings.Route, Status: http.StatusCreated, Example: `{\"fitting_id\":12}`, },",
+		"fakeesi.WithRateLimitGroups(esi.RateLimitGroups)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("fake server missing %q:\n%s", want, src)
+		}
+	}
+}
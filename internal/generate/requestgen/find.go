@@ -2,6 +2,8 @@ package requestgen
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -21,26 +23,172 @@ var httpMethods = map[string]bool{
 	"get": true, "post": true, "put": true, "patch": true, "delete": true, "head": true, "options": true,
 }
 
+// Prefixes of operation selectors.
+const (
+	// ExcludePrefix excludes the operations matched by the rest of the selector, e.g. !tag:Wallet.
+	ExcludePrefix = "!"
+	// TagPrefix selects the operations listed under an OpenAPI tag, e.g. tag:Market.
+	TagPrefix = "tag:"
+	// RegexpPrefix selects the operations whose operationId or route matches a regular expression, e.g. re:^GetMarkets.
+	RegexpPrefix = "re:"
+)
+
 // FindOperations resolves selectors against spec paths.
-// When selectors is exactly ALL_PATHS (case-insensitive), every HTTP operation is returned.
+//
+// A selector is an operationId or a route such as "GET /universe/factions", both case-insensitive. A selector
+// with * or ? is a glob matched against both, where * also matches slashes, e.g. GetMarkets* or "GET /markets/*".
+// Selectors prefixed with TagPrefix or RegexpPrefix select by tag or regular expression, and selectors prefixed
+// with ExcludePrefix remove the operations they match from the rest.
+// When the only other selector is ALL_PATHS (case-insensitive), every HTTP operation is selected.
 func FindOperations(spec *openapi.Spec, selectors []string) ([]Operation, error) {
-	if len(selectors) == 0 {
-		return nil, fmt.Errorf("no operations specified")
+	var include, exclude []string
+	for _, sel := range selectors {
+		if rest, ok := strings.CutPrefix(sel, ExcludePrefix); ok {
+			exclude = append(exclude, rest)
+		} else {
+			include = append(include, sel)
+		}
 	}
-	if len(selectors) == 1 && strings.EqualFold(selectors[0], cmdutil.SelectorAllPaths) {
-		return findAllOperations(spec)
+	if len(include) == 0 {
+		return nil, fmt.Errorf("no operations specified")
 	}
+
 	var out []Operation
-	for _, sel := range selectors {
-		op, err := findOne(spec, sel)
+	if len(include) == 1 && strings.EqualFold(include[0], cmdutil.SelectorAllPaths) {
+		all, err := findAllOperations(spec)
+		if err != nil {
+			return nil, err
+		}
+		out = all
+	} else {
+		seen := make(map[string]bool)
+		for _, sel := range include {
+			if strings.EqualFold(sel, cmdutil.SelectorAllPaths) {
+				return nil, fmt.Errorf("%s cannot be combined with other selectors, except exclusions", cmdutil.SelectorAllPaths)
+			}
+			ops, err := findSelected(spec, sel)
+			if err != nil {
+				return nil, err
+			}
+			for _, op := range ops {
+				if key := op.Method + " " + op.Path; !seen[key] {
+					seen[key] = true
+					out = append(out, op)
+				}
+			}
+		}
+	}
+
+	for _, sel := range exclude {
+		match, err := selectorMatcher(sel)
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, op)
+		if _, err := findMatching(spec, sel, match); err != nil {
+			return nil, fmt.Errorf("exclusion: %w", err)
+		}
+		out = slices.DeleteFunc(out, match)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("every selected operation is excluded")
 	}
 	return out, nil
 }
 
+// findSelected returns the operation of an exact selector, or every operation a pattern selector matches.
+func findSelected(spec *openapi.Spec, selector string) ([]Operation, error) {
+	if !isPatternSelector(selector) {
+		op, err := findOne(spec, selector)
+		if err != nil {
+			return nil, err
+		}
+		return []Operation{op}, nil
+	}
+	match, err := selectorMatcher(selector)
+	if err != nil {
+		return nil, err
+	}
+	return findMatching(spec, selector, match)
+}
+
+// findMatching returns the operations of the spec that match, sorted by operationId.
+func findMatching(spec *openapi.Spec, selector string, match func(Operation) bool) ([]Operation, error) {
+	all, err := findAllOperations(spec)
+	if err != nil {
+		return nil, err
+	}
+	var out []Operation
+	for _, op := range all {
+		if match(op) {
+			out = append(out, op)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("selector %q matches no operations", selector)
+	}
+	return out, nil
+}
+
+func isPatternSelector(selector string) bool {
+	return hasPrefixFold(selector, TagPrefix) || hasPrefixFold(selector, RegexpPrefix) || strings.ContainsAny(selector, "*?")
+}
+
+// selectorMatcher returns a function that reports whether an operation matches the selector.
+func selectorMatcher(selector string) (func(Operation) bool, error) {
+	switch {
+	case hasPrefixFold(selector, TagPrefix):
+		tag := selector[len(TagPrefix):]
+		return func(op Operation) bool {
+			return slices.ContainsFunc(op.Spec.Tags, func(t string) bool { return strings.EqualFold(t, tag) })
+		}, nil
+	case hasPrefixFold(selector, RegexpPrefix):
+		re, err := regexp.Compile("(?i)" + selector[len(RegexpPrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("selector %q: %w", selector, err)
+		}
+		return matchIDOrRoute(re), nil
+	case strings.ContainsAny(selector, "*?"):
+		return matchIDOrRoute(globRegexp(selector)), nil
+	}
+	if method, path, ok := parseMethodPath(selector); ok {
+		return func(op Operation) bool {
+			return strings.EqualFold(op.Method, method) && op.Path == path
+		}, nil
+	}
+	return func(op Operation) bool {
+		return strings.EqualFold(op.OperationID, selector)
+	}, nil
+}
+
+func matchIDOrRoute(re *regexp.Regexp) func(Operation) bool {
+	return func(op Operation) bool {
+		return re.MatchString(op.OperationID) || re.MatchString(op.Method+" "+op.Path)
+	}
+}
+
+// globRegexp compiles a glob, where * matches any characters and ? a single one, to a case-insensitive regexp
+// of the whole string.
+func globRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?i)^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
 func findAllOperations(spec *openapi.Spec) ([]Operation, error) {
 	var out []Operation
 	for path, item := range spec.Paths {
@@ -3,6 +3,7 @@ package requestgen_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
 	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
 )
@@ -63,3 +64,71 @@ func TestFindOperations_allPathsNotExclusive(t *testing.T) {
 		t.Fatal("expected error when ALL_PATHS is combined with other selectors")
 	}
 }
+
+func TestFindOperations_selectors(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+
+	testCases := []struct {
+		name      string
+		selectors []string
+		expected  []string
+	}{
+		{
+			name:      "glob of operationIds",
+			selectors: []string{"GetAlliances*"},
+			expected:  []string{"GetAlliances", "GetAlliancesAllianceId"},
+		},
+		{
+			name:      "glob of routes",
+			selectors: []string{"get /universe/*"},
+			expected:  []string{"GetUniverseFactions", "GetUniverseStargatesStargateId"},
+		},
+		{
+			name:      "regexp",
+			selectors: []string{"re:^(post|delete)"},
+			expected:  []string{"DeleteCharactersCharacterIdFittingsFittingId", "PostCharactersAffiliation"},
+		},
+		{
+			name:      "tags without duplicates",
+			selectors: []string{"tag:universe", "GetUniverseFactions", "tag:Alliance"},
+			expected:  []string{"GetUniverseFactions", "GetUniverseStargatesStargateId", "GetAlliances", "GetAlliancesAllianceId"},
+		},
+		{
+			name:      "exclusions",
+			selectors: []string{"ALL_PATHS", "!tag:Universe", "!re:Alliance", "!POST /characters/affiliation"},
+			expected:  []string{"DeleteCharactersCharacterIdFittingsFittingId", "GetCorporationsProjectsDetail"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ops, err := requestgen.FindOperations(spec, tc.selectors)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var actual []string
+			for _, op := range ops {
+				actual = append(actual, op.OperationID)
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("operations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindOperations_selectorErrors(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+
+	for _, selectors := range [][]string{
+		{"GetMarkets*"},
+		{"tag:Market"},
+		{"re:("},
+		{"!GetUniverseFactions"},
+		{"GetUniverseFactions", "!get /universe/*"},
+		{"ALL_PATHS", "!tag:Market"},
+	} {
+		if _, err := requestgen.FindOperations(spec, selectors); err == nil {
+			t.Errorf("FindOperations(%q): expected error", selectors)
+		}
+	}
+}
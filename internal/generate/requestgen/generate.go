@@ -3,6 +3,7 @@ package requestgen
 import (
 	"fmt"
 	"go/format"
+	"sort"
 )
 
 const generatedBy = "// Code generated by cmd/generate-request; DO NOT EDIT.\n\n"
@@ -22,6 +23,11 @@ func GeneratePackage(m PackageModel, cfg Config) (GeneratedFiles, error) {
 	if !m.Static {
 		inFields := append(m.InputFields, allStructFields(m.InputNested)...)
 		commonIn, otherIn := fileImportsForFields(inFields, cfg, fieldsNeedTime(inFields))
+		validations := validationStatements("i", m.InputFields, m.InputNested)
+		if len(validations) > 0 {
+			otherIn = append(otherIn, cfg.requestImport())
+			sort.Strings(otherIn)
+		}
 		src, err := executeTemplate("input.go.tmpl", fileTemplateData{
 			PackageName:   m.PackageName,
 			RootName:      "Input",
@@ -30,6 +36,7 @@ func GeneratePackage(m PackageModel, cfg Config) (GeneratedFiles, error) {
 			HasImports:    len(commonIn)+len(otherIn) > 0,
 			Fields:        m.InputFields,
 			Nested:        m.InputNested,
+			Validations:   validations,
 		})
 		if err != nil {
 			return out, err
@@ -76,14 +83,15 @@ func GeneratePackage(m PackageModel, cfg Config) (GeneratedFiles, error) {
 	}
 
 	reqSrc, err := executeTemplate("request.go.tmpl", requestTemplateData{
-		PackageName:           m.PackageName,
-		RequestImport:         cfg.requestImport(),
-		MethodConst:           methodConstFixed(m.Method),
-		PathLiteral:           pathLiteral(m.Path),
-		OutputType:            m.OutputType,
-		Static:                m.Static,
-		HasRequiredScopes:     len(m.RequiredScopes) > 0,
-		RequiredScopesLiteral: scopesLiteral(m.RequiredScopes),
+		Doc:              PackageDoc(m),
+		PackageName:      m.PackageName,
+		RequestImport:    cfg.requestImport(),
+		MethodConst:      methodConstFixed(m.Method),
+		PathLiteral:      pathLiteral(m.Path),
+		OutputType:       m.OutputType,
+		Static:           m.Static,
+		SecurityLiterals: securityLiterals(m.Security),
+		CacheSeconds:     m.CacheSeconds,
 	})
 	if err != nil {
 		return out, err
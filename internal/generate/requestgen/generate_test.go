@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
 	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
 )
 
@@ -50,6 +51,9 @@ func TestGeneratePackage_alliance(t *testing.T) {
 	if !strings.Contains(req, `"/alliances/{alliance_id}"`) {
 		t.Errorf("request path: %s", req)
 	}
+	if !strings.Contains(req, "request.WithCacheDuration(3600*time.Second),") || !strings.Contains(req, `"time"`) {
+		t.Errorf("request missing cache duration: %s", req)
+	}
 }
 
 func TestGeneratePackage_arrayIDs(t *testing.T) {
@@ -136,6 +140,26 @@ func TestGeneratePackage_postAffiliation(t *testing.T) {
 	if !strings.Contains(string(files.Request), "[]*Output") {
 		t.Errorf("request: %s", files.Request)
 	}
+	// Inline ID properties of the output get the identifier types other properties of their name reference,
+	// and keep their names.
+	output := strings.Join(strings.Fields(string(files.Output)), " ")
+	for _, want := range []string{
+		"AllianceId *alliance.Identifier `json:\"alliance_id\"`",
+		"CharacterId character.Identifier `json:\"character_id\"`",
+		"CorporationId corporation.Identifier `json:\"corporation_id\"`",
+		"FactionId *faction.Identifier `json:\"faction_id\"`",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, files.Output)
+		}
+	}
+	// Input bodies are left as is.
+	if !strings.Contains(string(files.Input), "Body []int64") {
+		t.Errorf("input: %s", files.Input)
+	}
+	if !strings.Contains(string(files.Input), `v.MaxItems("body", len(i.Body), 1000)`) {
+		t.Errorf("input missing validation: %s", files.Input)
+	}
 }
 
 func TestGeneratePackage_nestedObject(t *testing.T) {
@@ -165,6 +189,60 @@ func TestGeneratePackage_nestedObject(t *testing.T) {
 	}
 }
 
+func TestGeneratePackage_date(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	spec.Paths["/markets/{region_id}/history"] = openapi.PathItem{
+		"get": {
+			OperationID: "GetMarketsRegionIdHistory",
+			Responses: map[string]openapi.Response{
+				"200": {
+					Content: map[string]openapi.MediaTypeObject{
+						"application/json": {
+							Schema: openapi.SchemaRef{
+								Type: "array",
+								Items: &openapi.SchemaRef{
+									Type:     "object",
+									Required: []string{"date", "volume"},
+									Properties: map[string]openapi.SchemaRef{
+										"date":   {Type: "string", Format: "date"},
+										"volume": {Type: "integer", Format: "int64"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ops, err := requestgen.FindOperations(spec, []string{"GetMarketsRegionIdHistory"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	pkg, err := requestgen.BuildPackage(ops[0], spec, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := requestgen.GeneratePackage(pkg, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := strings.Join(strings.Fields(string(files.Output)), " ")
+	for _, want := range []string{
+		`import ( "github.com/xaroth/lib-esi-go/civil" )`,
+		"Date civil.Date `json:\"date\"`",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q: %s", want, output)
+		}
+	}
+	if strings.Contains(output, `"time"`) {
+		t.Errorf("output should not import time: %s", output)
+	}
+}
+
 func TestGeneratePackage_noContent204(t *testing.T) {
 	spec := gentest.LoadMinimalSpec(t)
 	ops, err := requestgen.FindOperations(spec, []string{"DeleteCharactersCharacterIdFittingsFittingId"})
@@ -219,4 +297,7 @@ func TestGeneratePackage_noRequiredScope(t *testing.T) {
 	if strings.Contains(string(files.Request), "WithRequiredScope") {
 		t.Errorf("public request should not declare scopes: %s", files.Request)
 	}
+	if strings.Contains(string(files.Request), "WithCacheDuration") || strings.Contains(string(files.Request), `"time"`) {
+		t.Errorf("uncached request should not declare a cache duration: %s", files.Request)
+	}
 }
@@ -0,0 +1,96 @@
+package requestgen
+
+import (
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+)
+
+// identifierSchema is an integer x-common-model, which inline properties of the same wire name are typed with.
+type identifierSchema struct {
+	Name   string
+	Format string
+}
+
+// identifierSchemasByWire returns the integer x-common-model every wire name refers to, learned from the
+// parameters and properties of the spec that reference one with $ref. Only wire names ending in _id are
+// considered, as names like from or to mean different things in different operations. Wire names that
+// refer to more than one model, like id, are left out.
+func identifierSchemasByWire(spec *openapi.Spec) map[string]identifierSchema {
+	if spec == nil {
+		return nil
+	}
+	resolver := openapi.NewResolver(spec)
+	isIdentifier := func(name string) bool {
+		schema, ok := spec.Components.Schemas[name]
+		return ok && schema.XCommonModel.IsTrue() && schema.Type == "integer" && len(schema.Enum) == 0
+	}
+
+	found := make(map[string]map[string]bool)
+	add := func(wire string, ref openapi.SchemaRef) {
+		if ref.Ref == "" || !strings.HasSuffix(wire, "_id") {
+			return
+		}
+		if _, name, err := resolver.ResolveSchemaRef(ref); err == nil && isIdentifier(name) {
+			if found[wire] == nil {
+				found[wire] = make(map[string]bool)
+			}
+			found[wire][name] = true
+		}
+	}
+
+	var walk func(ref openapi.SchemaRef)
+	walk = func(ref openapi.SchemaRef) {
+		// Referenced schemas are walked as components.
+		if ref.Ref != "" {
+			return
+		}
+		for wire, prop := range ref.Properties {
+			add(wire, prop)
+			walk(prop)
+		}
+		if ref.Items != nil {
+			walk(*ref.Items)
+		}
+		for _, variant := range ref.OneOf {
+			walk(variant)
+		}
+	}
+
+	for _, schema := range spec.Components.Schemas {
+		walk(schemaFromSchema(schema))
+	}
+	for _, item := range spec.Paths {
+		for _, op := range item {
+			for _, p := range op.Parameters {
+				param, _, err := resolver.ResolveParameterRef(p)
+				if err != nil || param.Schema == nil {
+					continue
+				}
+				add(param.Name, *param.Schema)
+				walk(*param.Schema)
+			}
+			if op.RequestBody != nil {
+				for _, media := range op.RequestBody.Content {
+					walk(media.Schema)
+				}
+			}
+			for _, resp := range op.Responses {
+				for _, media := range resp.Content {
+					walk(media.Schema)
+				}
+			}
+		}
+	}
+
+	byWire := make(map[string]identifierSchema, len(found))
+	for wire, names := range found {
+		if len(names) != 1 {
+			continue
+		}
+		for name := range names {
+			byWire[wire] = identifierSchema{Name: name, Format: spec.Components.Schemas[name].Format}
+		}
+	}
+	return byWire
+}
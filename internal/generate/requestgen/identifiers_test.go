@@ -0,0 +1,118 @@
+package requestgen_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+// identifiersSpec references identifiers by some wire names, and leaves others inline.
+const identifiersSpec = `{
+  "paths": {
+    "/markets/{region_id}/orders": {
+      "get": {
+        "operationId": "GetMarketsRegionIdOrders",
+        "parameters": [
+          { "name": "region_id", "in": "path", "required": true, "schema": { "$ref": "#/components/schemas/RegionID" } },
+          { "name": "type_id", "in": "query", "schema": { "type": "integer", "format": "int64" } },
+          { "name": "from", "in": "query", "schema": { "$ref": "#/components/schemas/SolarSystemID" } }
+        ],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["order_id", "region_id", "system_id", "type_id", "from"],
+            "properties": {
+              "order_id": { "type": "integer", "format": "int64" },
+              "region_id": { "type": "integer", "format": "int64" },
+              "system_id": { "type": "integer", "format": "int64" },
+              "type_id": { "type": "integer", "format": "int64" },
+              "station_id": { "type": "integer", "format": "int32" },
+              "id": { "type": "integer", "format": "int64" },
+              "from": { "type": "integer", "format": "int64" }
+            }
+          }
+        } } } } }
+      }
+    },
+    "/universe/systems/{system_id}": {
+      "get": {
+        "operationId": "GetUniverseSystemsSystemId",
+        "parameters": [
+          { "name": "system_id", "in": "path", "required": true, "schema": { "$ref": "#/components/schemas/SolarSystemID" } }
+        ],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "$ref": "#/components/schemas/UniverseSystemsSystemIdGet"
+        } } } } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "RegionID": { "x-common-model": true, "type": "integer", "format": "int64" },
+      "SolarSystemID": { "x-common-model": true, "type": "integer", "format": "int64" },
+      "StationID": { "x-common-model": true, "type": "integer", "format": "int64" },
+      "TypeID": { "x-common-model": true, "type": "integer", "format": "int64" },
+      "UniverseSystemsSystemIdGet": {
+        "type": "object",
+        "required": ["system_id", "star_id"],
+        "properties": {
+          "system_id": { "$ref": "#/components/schemas/SolarSystemID" },
+          "station_id": { "$ref": "#/components/schemas/StationID" },
+          "star_id": { "type": "integer", "format": "int64" },
+          "id": { "$ref": "#/components/schemas/SolarSystemID" },
+          "types": { "type": "array", "items": { "type": "object", "properties": {
+            "type_id": { "$ref": "#/components/schemas/TypeID" },
+            "id": { "$ref": "#/components/schemas/TypeID" }
+          } } }
+        }
+      }
+    }
+  }
+}`
+
+func TestGeneratePackage_identifiers(t *testing.T) {
+	var spec openapi.Spec
+	if err := json.Unmarshal([]byte(identifiersSpec), &spec); err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+
+	ops, err := requestgen.FindOperations(&spec, []string{"GetMarketsRegionIdOrders"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := requestgen.BuildPackage(ops[0], &spec, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := requestgen.GeneratePackage(pkg, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := strings.Join(strings.Fields(string(files.Output)), " ")
+	for _, want := range []string{
+		// Matched by the $ref of a parameter, or of a property of another operation.
+		"RegionId region.Identifier `json:\"region_id\"`",
+		"SystemId solarsystem.Identifier `json:\"system_id\"`",
+		"TypeId typeid.Identifier `json:\"type_id\"`",
+		// Never referenced, of another format, referencing more than one model, or not named as an ID.
+		"OrderId int64 `json:\"order_id\"`",
+		"From int64 `json:\"from\"`",
+		"StationId *int32 `json:\"station_id\"`",
+		"Id *int64 `json:\"id\"`",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, files.Output)
+		}
+	}
+
+	// The inline query parameter is left as is.
+	if input := strings.Join(strings.Fields(string(files.Input)), " "); !strings.Contains(input, "TypeId *int64 `query:\"type_id\"`") {
+		t.Errorf("unexpected input:\n%s", files.Input)
+	}
+}
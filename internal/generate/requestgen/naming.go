@@ -26,13 +26,9 @@ func PackageNameFromOperationID(operationID string) string {
 }
 
 // FieldNameFromWire converts a wire name to a Go field name.
+// Common models name the fields of their structs the same way.
 func FieldNameFromWire(wire string, commonSchema string) string {
-	if commonSchema != "" && commonmodels.TypeName(commonSchema) == "Identifier" {
-		if strings.HasSuffix(wire, "_id") {
-			wire = strings.TrimSuffix(wire, "_id")
-		}
-	}
-	return snakeToPascal(wire)
+	return commonmodels.FieldName(wire, commonSchema)
 }
 
 func snakeToPascal(s string) string {
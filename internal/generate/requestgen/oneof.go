@@ -73,6 +73,7 @@ func (sb *structBuilder) buildOneOfFields(variants []openapi.SchemaRef) ([]Struc
 				TagKey:       "json",
 				TagVal:       propWire,
 				TagOmitEmpty: true,
+				Doc:          fieldSchemaDoc(sb.resolver, sb.mapper, propRef.Description, propRef),
 			})
 			if nt {
 				needsTime = true
@@ -0,0 +1,95 @@
+package requestgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Prune deletes the generated operation packages under outDir that are not among ops, and returns their names.
+// A directory is an operation package if its request.go starts with the header of cmd/generate-request; only
+// files with that header are deleted, and the directory only if nothing else is left in it.
+// When check is true, stale packages are reported as an error instead.
+func Prune(outDir string, ops []Operation, check bool) ([]string, error) {
+	selected := make(map[string]bool, len(ops))
+	for _, op := range ops {
+		selected[PackageNameFromOperationID(op.OperationID)] = true
+	}
+
+	entries, err := os.ReadDir(outDir)
+	if err != nil {
+		return nil, err
+	}
+	var stale []string
+	for _, entry := range entries {
+		if !entry.IsDir() || selected[entry.Name()] {
+			continue
+		}
+		generated, err := isGeneratedFile(filepath.Join(outDir, entry.Name(), "request.go"))
+		if err != nil {
+			return nil, err
+		}
+		if generated {
+			stale = append(stale, entry.Name())
+		}
+	}
+	sort.Strings(stale)
+
+	if check {
+		if len(stale) > 0 {
+			return stale, fmt.Errorf("check: stale packages %s (would delete)", strings.Join(stale, ", "))
+		}
+		return nil, nil
+	}
+	for _, name := range stale {
+		if err := pruneDir(filepath.Join(outDir, name)); err != nil {
+			return nil, err
+		}
+	}
+	return stale, nil
+}
+
+// pruneDir deletes the generated files of a directory, and the directory if it is empty afterwards.
+func pruneDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	left := 0
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		generated := false
+		if !entry.IsDir() {
+			if generated, err = isGeneratedFile(path); err != nil {
+				return err
+			}
+		}
+		if !generated {
+			left++
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	if left > 0 {
+		return nil
+	}
+	return os.Remove(dir)
+}
+
+// isGeneratedFile reports whether the file starts with the header of cmd/generate-request.
+func isGeneratedFile(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return bytes.HasPrefix(data, []byte(strings.TrimSpace(generatedBy)+"\n")), nil
+}
@@ -0,0 +1,62 @@
+package requestgen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestPrune(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	dir := moduleTempDir(t)
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	all, err := requestgen.FindOperations(spec, []string{"tag:Universe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := requestgen.BuildAndWrite(spec, all, dir, cfg, false); err != nil {
+		t.Fatal(err)
+	}
+	// Hand-written files are kept, and so is the directory holding them.
+	handWritten := filepath.Join(dir, "getuniversestargatesstargateid", "request_test.go")
+	if err := os.WriteFile(handWritten, []byte("package getuniversestargatesstargateid_test\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "handwritten"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	ops, err := requestgen.FindOperations(spec, []string{"GetAlliances"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := requestgen.Prune(dir, ops, true); err == nil {
+		t.Fatal("expected check error for stale packages")
+	}
+	pruned, err := requestgen.Prune(dir, ops, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"getuniversefactions", "getuniversestargatesstargateid"}, pruned); diff != "" {
+		t.Errorf("pruned mismatch (-want +got):\n%s", diff)
+	}
+
+	for path, exists := range map[string]bool{
+		filepath.Join(dir, "getuniversefactions"):                          false,
+		filepath.Join(dir, "getuniversestargatesstargateid", "request.go"): false,
+		handWritten:                                    true,
+		filepath.Join(dir, "handwritten"):              true,
+		filepath.Join(dir, requestgen.RegistryPackage): true,
+	} {
+		if _, err := os.Stat(path); (err == nil) != exists {
+			t.Errorf("%s: exists = %v, want %v", path, err == nil, exists)
+		}
+	}
+	if _, err := requestgen.Prune(dir, ops, true); err != nil {
+		t.Errorf("check after pruning: %v", err)
+	}
+}
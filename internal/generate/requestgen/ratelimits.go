@@ -0,0 +1,100 @@
+package requestgen
+
+import (
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+const (
+	// RateLimitsFile is the name of the generated rate limit group registry.
+	RateLimitsFile = "ratelimits.go"
+
+	// DefaultRegistryPackage is used when the output directory name is not a valid package name.
+	DefaultRegistryPackage = "esi"
+)
+
+var nonPackageChars = regexp.MustCompile(`[^a-z0-9]`)
+
+type rateLimitGroupData struct {
+	Route      string
+	Group      string
+	BucketSize int
+	WindowSize string
+}
+
+type rateLimitsTemplateData struct {
+	PackageName        string
+	RateLimitingImport string
+	Groups             []rateLimitGroupData
+}
+
+// GenerateRateLimits renders the registry mapping each operation route to its rate limit group.
+func GenerateRateLimits(packageName string, packages []PackageModel, cfg Config) ([]byte, error) {
+	var groups []rateLimitGroupData
+	for _, pkg := range packages {
+		if pkg.RateLimit == nil {
+			continue
+		}
+		groups = append(groups, rateLimitGroupData{
+			Route:      strconv.Quote(pkg.Method + " " + pkg.Path),
+			Group:      strconv.Quote(pkg.RateLimit.Group),
+			BucketSize: pkg.RateLimit.BucketSize,
+			WindowSize: durationLiteral(pkg.RateLimit.WindowSize),
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Route < groups[j].Route
+	})
+
+	src, err := executeTemplate("ratelimits.go.tmpl", rateLimitsTemplateData{
+		PackageName:        packageName,
+		RateLimitingImport: cfg.rateLimitingImport(),
+		Groups:             groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source([]byte(generatedBy + src))
+	if err != nil {
+		return nil, fmt.Errorf("format rate limits: %w", err)
+	}
+	return out, nil
+}
+
+// WriteRateLimits writes the rate limit group registry into outDir, using the directory name as package name.
+func WriteRateLimits(outDir string, packages []PackageModel, cfg Config, check bool) error {
+	src, err := GenerateRateLimits(registryPackageName(outDir), packages, cfg)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(outDir, RateLimitsFile), src, check)
+}
+
+func registryPackageName(outDir string) string {
+	name := nonPackageChars.ReplaceAllString(strings.ToLower(filepath.Base(outDir)), "")
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return DefaultRegistryPackage
+	}
+	return name
+}
+
+func durationLiteral(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d)
+	}
+}
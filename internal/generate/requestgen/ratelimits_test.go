@@ -0,0 +1,69 @@
+package requestgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestGenerateRateLimits(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	ops, err := requestgen.FindOperations(spec, []string{"ALL_PATHS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	var packages []requestgen.PackageModel
+	for _, op := range ops {
+		pkg, err := requestgen.BuildPackage(op, spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	src, err := requestgen.GenerateRateLimits("esi", packages, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(src)
+	for _, want := range []string{
+		"package esi",
+		`"github.com/xaroth/lib-esi-go/middleware/ratelimiting"`,
+		`{Route: "GET /alliances", Group: "alliance", BucketSize: 3600, WindowSize: 15 * time.Minute},`,
+		`{Route: "GET /alliances/{alliance_id}", Group: "alliance", BucketSize: 3600, WindowSize: 15 * time.Minute},`,
+		`{Route: "GET /universe/factions", Group: "universe", BucketSize: 600, WindowSize: 1 * time.Minute},`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rate limits missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "/characters/affiliation") {
+		t.Errorf("operations without x-rate-limit should be skipped:\n%s", out)
+	}
+
+	empty, err := requestgen.GenerateRateLimits("esi", nil, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(empty), `"time"`) {
+		t.Errorf("empty registry should not import time:\n%s", empty)
+	}
+}
+
+func TestBuildPackage_invalidRateLimit(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	ops, err := requestgen.FindOperations(spec, []string{"GetUniverseFactions"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops[0].Spec.XRateLimit = &openapi.RateLimit{Group: "universe", MaxTokens: 600, WindowSize: "soon"}
+
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	if _, err := requestgen.BuildPackage(ops[0], spec, cfg); err == nil {
+		t.Fatal("expected error for invalid window-size")
+	}
+}
@@ -0,0 +1,115 @@
+package requestgen
+
+import (
+	"fmt"
+	"go/format"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+const (
+	// RegistryPackage is the directory, relative to the output directory, of the generated operation registry.
+	RegistryPackage = "registry"
+
+	// RegistryFile is the name of the generated operation registry.
+	RegistryFile = "registry.go"
+)
+
+type registryOperationData struct {
+	OperationID string
+	Method      string
+	Path        string
+	PackageName string
+	Import      string
+	Security    []string
+	Static      bool
+	OutputType  string
+	Paginated   bool
+}
+
+type registryTemplateData struct {
+	RequestImport string
+	Operations    []registryOperationData
+}
+
+// GenerateRegistry renders the registry describing every operation, where packagesImport is the import path
+// the operation packages are generated under.
+func GenerateRegistry(packagesImport string, packages []PackageModel, cfg Config) ([]byte, error) {
+	operations := make([]registryOperationData, 0, len(packages))
+	for _, pkg := range packages {
+		operations = append(operations, registryOperationData{
+			OperationID: strconv.Quote(pkg.OperationID),
+			Method:      strconv.Quote(pkg.Method),
+			Path:        strconv.Quote(pkg.Path),
+			PackageName: pkg.PackageName,
+			Import:      path.Join(packagesImport, pkg.PackageName),
+			Security:    securityLiterals(pkg.Security),
+			Static:      pkg.Static,
+			OutputType:  qualifiedOutputType(pkg.PackageName, pkg.OutputType),
+			Paginated:   IsPaginated(pkg),
+		})
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].OperationID < operations[j].OperationID
+	})
+
+	src, err := executeTemplate("registry.go.tmpl", registryTemplateData{
+		RequestImport: cfg.requestImport(),
+		Operations:    operations,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source([]byte(generatedBy + src))
+	if err != nil {
+		return nil, fmt.Errorf("format registry: %w", err)
+	}
+	return out, nil
+}
+
+// WriteRegistry writes the operation registry into the registry package of outDir.
+func WriteRegistry(outDir string, packages []PackageModel, cfg Config, check bool) error {
+	moduleRoot, err := openapi.ModuleRoot(outDir)
+	if err != nil {
+		return err
+	}
+	modulePath, err := openapi.ModulePath(outDir)
+	if err != nil {
+		return err
+	}
+	importBase, err := openapi.ImportBase(moduleRoot, outDir)
+	if err != nil {
+		return err
+	}
+
+	src, err := GenerateRegistry(path.Join(modulePath, importBase), packages, cfg)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(outDir, RegistryPackage, RegistryFile), src, check)
+}
+
+// IsPaginated reports whether the input of an operation has a page query parameter.
+func IsPaginated(pkg PackageModel) bool {
+	for _, f := range pkg.InputFields {
+		if f.TagKey == "query" && f.TagVal == "page" {
+			return true
+		}
+	}
+	return false
+}
+
+// qualifiedOutputType qualifies the output type of a package for use outside of it, e.g. []*Output → []*pkg.Output.
+func qualifiedOutputType(packageName, outputType string) string {
+	base := strings.TrimLeft(outputType, "[]*")
+	if base != "Output" {
+		return outputType
+	}
+	return strings.TrimSuffix(outputType, base) + packageName + "." + base
+}
@@ -0,0 +1,82 @@
+package requestgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestGenerateRegistry(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	ops, err := requestgen.FindOperations(spec, []string{"ALL_PATHS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	var packages []requestgen.PackageModel
+	for _, op := range ops {
+		pkg, err := requestgen.BuildPackage(op, spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	src, err := requestgen.GenerateRegistry("github.com/xaroth/lib-esi-go/esi", packages, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ignore the alignment of gofmt.
+	out := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"package registry",
+		`"github.com/xaroth/lib-esi-go/esi/getuniversefactions"`,
+		`"GetUniverseFactions": { OperationID: "GetUniverseFactions", Method: "GET", Path: "/universe/factions", ` +
+			`Package: "github.com/xaroth/lib-esi-go/esi/getuniversefactions", ` +
+			`Output: reflect.TypeFor[[]*getuniversefactions.Output](), Invoke: invokeStatic(getuniversefactions.Request), },`,
+		`Input: reflect.TypeFor[getalliancesallianceid.Input](), Output: reflect.TypeFor[*getalliancesallianceid.Output](), ` +
+			`Invoke: invoke(getalliancesallianceid.Request),`,
+		`Output: reflect.TypeFor[getalliances.Output](),`,
+		`Security: [][]string{{"esi-fittings.write_fittings.v1"}}, Input: reflect.TypeFor[deletecharacterscharacteridfittingsfittingid.Input](), ` +
+			`Output: reflect.TypeFor[struct{}](),`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("registry missing %q:\n%s", want, src)
+		}
+	}
+	if strings.Contains(out, "Paginated: true") {
+		t.Errorf("no operation in the spec is paginated:\n%s", src)
+	}
+}
+
+func TestIsPaginated(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fields   []requestgen.StructField
+		expected bool
+	}{
+		{
+			name:   "no input",
+			fields: nil,
+		},
+		{
+			name:   "page header",
+			fields: []requestgen.StructField{{Name: "Page", TagKey: "header", TagVal: "page"}},
+		},
+		{
+			name:     "page query parameter",
+			fields:   []requestgen.StructField{{Name: "Page", TagKey: "query", TagVal: "page"}},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := requestgen.IsPaginated(requestgen.PackageModel{InputFields: testCase.fields}); got != testCase.expected {
+				t.Errorf("IsPaginated = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package requestgen
+
+import (
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+// RolesFile is the name of the generated required role registry.
+const RolesFile = "roles.go"
+
+type requiredRolesData struct {
+	Route string
+	Roles string
+}
+
+type rolesTemplateData struct {
+	PackageName string
+	Routes      []requiredRolesData
+}
+
+// GenerateRequiredRoles renders the registry mapping each operation route to the corporation roles it needs.
+func GenerateRequiredRoles(packageName string, packages []PackageModel) ([]byte, error) {
+	var routes []requiredRolesData
+	for _, pkg := range packages {
+		if len(pkg.RequiredRoles) == 0 {
+			continue
+		}
+		routes = append(routes, requiredRolesData{
+			Route: strconv.Quote(pkg.Method + " " + pkg.Path),
+			Roles: scopesLiteral(pkg.RequiredRoles),
+		})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Route < routes[j].Route
+	})
+
+	src, err := executeTemplate("roles.go.tmpl", rolesTemplateData{
+		PackageName: packageName,
+		Routes:      routes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source([]byte(generatedBy + src))
+	if err != nil {
+		return nil, fmt.Errorf("format required roles: %w", err)
+	}
+	return out, nil
+}
+
+// WriteRequiredRoles writes the required role registry into outDir, using the directory name as package name.
+func WriteRequiredRoles(outDir string, packages []PackageModel, check bool) error {
+	src, err := GenerateRequiredRoles(registryPackageName(outDir), packages)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(outDir, RolesFile), src, check)
+}
@@ -0,0 +1,43 @@
+package requestgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestGenerateRequiredRoles(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	ops, err := requestgen.FindOperations(spec, []string{"ALL_PATHS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	var packages []requestgen.PackageModel
+	for _, op := range ops {
+		pkg, err := requestgen.BuildPackage(op, spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	src, err := requestgen.GenerateRequiredRoles("esi", packages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(src)
+	for _, want := range []string{
+		"package esi",
+		`"GET /corporations/{corporation_id}/projects/{project_id}": {"Director", "Project_Manager"},`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("required roles missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "/alliances") {
+		t.Errorf("operations without x-required-roles should be skipped:\n%s", out)
+	}
+}
@@ -0,0 +1,107 @@
+package requestgen
+
+import (
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+const (
+	// ScopesPackage is the directory, relative to the output directory, of the generated scope catalog.
+	ScopesPackage = "scopes"
+
+	// ScopesFile is the name of the generated scope catalog.
+	ScopesFile = "scopes.go"
+)
+
+type scopeData struct {
+	Name    string
+	Literal string
+	Routes  string
+}
+
+type scopeRouteData struct {
+	Route    string
+	Security []string
+}
+
+type scopesTemplateData struct {
+	Scopes []scopeData
+	Routes []scopeRouteData
+}
+
+// GenerateScopes renders the catalog of every scope, the routes that need it, and the security requirements of each route.
+func GenerateScopes(packages []PackageModel) ([]byte, error) {
+	routesByScope := make(map[string][]string)
+	var routes []scopeRouteData
+	for _, pkg := range packages {
+		if len(pkg.Security) == 0 {
+			continue
+		}
+		route := pkg.Method + " " + pkg.Path
+		for _, scope := range pkg.RequiredScopes {
+			routesByScope[scope] = append(routesByScope[scope], route)
+		}
+		routes = append(routes, scopeRouteData{
+			Route:    strconv.Quote(route),
+			Security: securityLiterals(pkg.Security),
+		})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Route < routes[j].Route
+	})
+
+	names := make(map[string]string)
+	scopes := make([]scopeData, 0, len(routesByScope))
+	for scope, scopeRoutes := range routesByScope {
+		name := ScopeConstName(scope)
+		if other, ok := names[name]; ok {
+			return nil, fmt.Errorf("scopes %q and %q both map to %s", other, scope, name)
+		}
+		names[name] = scope
+
+		sort.Strings(scopeRoutes)
+		scopes = append(scopes, scopeData{
+			Name:    name,
+			Literal: strconv.Quote(scope),
+			Routes:  scopesLiteral(scopeRoutes),
+		})
+	}
+	sort.Slice(scopes, func(i, j int) bool {
+		return scopes[i].Name < scopes[j].Name
+	})
+
+	src, err := executeTemplate("scopes.go.tmpl", scopesTemplateData{
+		Scopes: scopes,
+		Routes: routes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source([]byte(generatedBy + src))
+	if err != nil {
+		return nil, fmt.Errorf("format scopes: %w", err)
+	}
+	return out, nil
+}
+
+// WriteScopes writes the scope catalog into the scopes package of outDir.
+func WriteScopes(outDir string, packages []PackageModel, check bool) error {
+	src, err := GenerateScopes(packages)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(outDir, ScopesPackage, ScopesFile), src, check)
+}
+
+// ScopeConstName returns the constant name of a scope (e.g. esi-location.read_location.v1 → LocationReadLocationV1).
+func ScopeConstName(scope string) string {
+	name := strings.TrimPrefix(scope, "esi-")
+	name = strings.NewReplacer("-", "_", ".", "_").Replace(name)
+	return snakeToPascal(name)
+}
@@ -0,0 +1,89 @@
+package requestgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+func TestGenerateScopes(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	ops, err := requestgen.FindOperations(spec, []string{"ALL_PATHS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+	var packages []requestgen.PackageModel
+	for _, op := range ops {
+		if op.OperationID == "GetCorporationsProjectsDetail" {
+			// Either scope grants access, or both of the alternative requirement.
+			op.Spec.Security = []openapi.SecurityRequirement{
+				{"OAuth2": {"esi-corporations.read_projects.v1"}},
+				{"OAuth2": {"esi-corporations.read_structures.v1", "esi-fittings.write_fittings.v1"}},
+			}
+		}
+		pkg, err := requestgen.BuildPackage(op, spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	src, err := requestgen.GenerateScopes(packages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ignore the alignment of gofmt.
+	out := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"package scopes",
+		`FittingsWriteFittingsV1 = "esi-fittings.write_fittings.v1"`,
+		`CorporationsReadProjectsV1 = "esi-corporations.read_projects.v1"`,
+		`FittingsWriteFittingsV1: {"DELETE /characters/{character_id}/fittings/{fitting_id}", "GET /corporations/{corporation_id}/projects/{project_id}"},`,
+		`"DELETE /characters/{character_id}/fittings/{fitting_id}": {{"esi-fittings.write_fittings.v1"}},`,
+		`"GET /corporations/{corporation_id}/projects/{project_id}": {{"esi-corporations.read_projects.v1"}, {"esi-corporations.read_structures.v1", "esi-fittings.write_fittings.v1"}},`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("scopes missing %q:\n%s", want, src)
+		}
+	}
+	if strings.Contains(out, `"GET /alliances"`) {
+		t.Errorf("public operations should be skipped:\n%s", out)
+	}
+
+	var projects requestgen.PackageModel
+	for _, pkg := range packages {
+		if pkg.OperationID == "GetCorporationsProjectsDetail" {
+			projects = pkg
+		}
+	}
+	files, err := requestgen.GeneratePackage(projects, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := string(files.Request)
+	for _, want := range []string{
+		`request.WithRequiredScope("esi-corporations.read_projects.v1"),`,
+		`request.WithRequiredScope("esi-corporations.read_structures.v1", "esi-fittings.write_fittings.v1"),`,
+	} {
+		if !strings.Contains(req, want) {
+			t.Errorf("request missing %q:\n%s", want, req)
+		}
+	}
+}
+
+func TestScopeConstName(t *testing.T) {
+	tests := map[string]string{
+		"esi-location.read_location.v1":            "LocationReadLocationV1",
+		"esi-characters.read_corporation_roles.v1": "CharactersReadCorporationRolesV1",
+		"publicData": "Publicdata",
+	}
+	for scope, want := range tests {
+		if got := requestgen.ScopeConstName(scope); got != want {
+			t.Errorf("ScopeConstName(%q) = %q, want %q", scope, got, want)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package requestgen
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/writefile"
+)
+
+const (
+	// SharedPackage is the package, under the common directory, of nested structs shared by operation packages.
+	SharedPackage = "shared"
+
+	// SharedFile is the name of the generated shared structs.
+	SharedFile = "shared.go"
+)
+
+// builtinTypes are the types that do not need a package, and can be used from the shared package as is.
+var builtinTypes = map[string]bool{
+	"any": true, "bool": true, "float64": true, "int32": true, "int64": true, "string": true, "struct{}": true,
+}
+
+func (c Config) sharedImport() string {
+	return c.LibModule + "/" + c.CommonSuffix + "/" + SharedPackage
+}
+
+// PromoteSharedStructs moves nested output structs that recur with the same name and shape in several packages
+// into the shared package. It returns the packages referencing the shared structs instead, either through aliases
+// that keep the local names (Config.StructAliases) or directly, and the shared structs sorted by name.
+//
+// Only structs without references to other nested structs are promoted,
+// and a name used by structs of different shapes is not promoted at all.
+func PromoteSharedStructs(packages []PackageModel, cfg Config) ([]PackageModel, []StructDef) {
+	type candidate struct {
+		def      StructDef
+		packages int
+	}
+	candidates := make(map[string]map[string]*candidate)
+	for _, pkg := range packages {
+		for _, def := range pkg.OutputNested {
+			if def.Alias != nil || !isLeafStruct(def) {
+				continue
+			}
+			shapes, ok := candidates[def.Name]
+			if !ok {
+				shapes = make(map[string]*candidate)
+				candidates[def.Name] = shapes
+			}
+			shape := structShape(def.Fields, nil)
+			if c, ok := shapes[shape]; ok {
+				c.packages++
+			} else {
+				shapes[shape] = &candidate{def: def, packages: 1}
+			}
+		}
+	}
+
+	promoted := make(map[string]string)
+	var shared []StructDef
+	for name, shapes := range candidates {
+		if len(shapes) != 1 {
+			continue
+		}
+		for shape, c := range shapes {
+			if c.packages > 1 {
+				promoted[name] = shape
+				shared = append(shared, c.def)
+			}
+		}
+	}
+	sort.Slice(shared, func(i, j int) bool {
+		return shared[i].Name < shared[j].Name
+	})
+	if len(shared) == 0 {
+		return packages, nil
+	}
+
+	out := make([]PackageModel, len(packages))
+	for i, pkg := range packages {
+		renamed := make(map[string]bool)
+		nested := make([]StructDef, 0, len(pkg.OutputNested))
+		for _, def := range pkg.OutputNested {
+			if shape, ok := promoted[def.Name]; !ok || def.Alias != nil || structShape(def.Fields, nil) != shape {
+				nested = append(nested, def)
+				continue
+			}
+			if cfg.StructAliases {
+				alias := sharedType(def.Name, cfg)
+				nested = append(nested, StructDef{Name: def.Name, Alias: &alias})
+				continue
+			}
+			renamed[def.Name] = true
+		}
+		if len(renamed) > 0 {
+			pkg.OutputFields = sharedFields(pkg.OutputFields, renamed, cfg)
+			for j, def := range nested {
+				nested[j].Fields = sharedFields(def.Fields, renamed, cfg)
+			}
+		}
+		pkg.OutputNested = nested
+		out[i] = pkg
+	}
+	return out, shared
+}
+
+// GenerateSharedStructs renders the shared package with the given structs.
+func GenerateSharedStructs(defs []StructDef, cfg Config) ([]byte, error) {
+	fields := allStructFields(defs)
+	common, other := fileImportsForFields(fields, cfg, fieldsNeedTime(fields))
+	src, err := executeTemplate("shared.go.tmpl", fileTemplateData{
+		PackageName:   SharedPackage,
+		CommonImports: common,
+		OtherImports:  other,
+		HasImports:    len(common)+len(other) > 0,
+		Nested:        defs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := formatGeneratedGo(cfg, generatedBy+src)
+	if err != nil {
+		return nil, fmt.Errorf("format shared structs: %w", err)
+	}
+	return out, nil
+}
+
+// WriteSharedStructs writes the shared package into the common directory of the module outDir is in.
+func WriteSharedStructs(outDir string, defs []StructDef, cfg Config, check bool) error {
+	moduleRoot, err := openapi.ModuleRoot(outDir)
+	if err != nil {
+		return err
+	}
+	src, err := GenerateSharedStructs(defs, cfg)
+	if err != nil {
+		return err
+	}
+	return writefile.Write(filepath.Join(moduleRoot, cfg.CommonSuffix, SharedPackage, SharedFile), src, check)
+}
+
+// isLeafStruct reports whether a struct only references builtin types and types of other packages.
+func isLeafStruct(def StructDef) bool {
+	for _, f := range def.Fields {
+		base := strings.TrimLeft(f.Type.Type, "[]*")
+		if !builtinTypes[base] && !strings.Contains(base, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+func sharedType(name string, cfg Config) GoType {
+	return GoType{Type: SharedPackage + "." + name, Import: cfg.sharedImport(), Package: SharedPackage}
+}
+
+// sharedFields points the fields referencing promoted structs at the shared package, keeping slices and pointers.
+func sharedFields(fields []StructField, promoted map[string]bool, cfg Config) []StructField {
+	out := make([]StructField, len(fields))
+	for i, f := range fields {
+		base := strings.TrimLeft(f.Type.Type, "[]*")
+		if promoted[base] {
+			shared := sharedType(base, cfg)
+			shared.Type = strings.TrimSuffix(f.Type.Type, base) + shared.Type
+			f.Type = shared
+		}
+		out[i] = f
+	}
+	return out
+}
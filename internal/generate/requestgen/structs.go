@@ -13,6 +13,9 @@ import (
 type StructDef struct {
 	Name   string
 	Fields []StructField
+
+	// Alias, when set, renders the struct as an alias of that type instead, e.g. of a struct with the same shape.
+	Alias *GoType
 }
 
 type structBuilder struct {
@@ -66,6 +69,7 @@ func (sb *structBuilder) buildFields(schema openapi.Schema, schemaName string, r
 			TagKey:      tagKey,
 			TagVal:      wire,
 			TagRequired: required && tagKey != "path",
+			Doc:         fieldSchemaDoc(sb.resolver, sb.mapper, propRef.Description, propRef),
 		})
 	}
 	return fields, needsTime, nil
@@ -106,6 +110,10 @@ func (sb *structBuilder) mapField(ref openapi.SchemaRef, wire string, required b
 		if err != nil {
 			return GoType{}, "", false, err
 		}
+		if itemName != "" && sb.mapper.commonSchemas[itemName] {
+			goType, _, err := sb.mapper.MapSchema(schema, schemaName, required)
+			return goType, "", strings.Contains(goType.Type, "time.Time"), err
+		}
 		if variants := oneOfVariants(itemSchema, *schema.Items); len(variants) > 0 {
 			goType, nt, err := sb.mapOneOf(variants, wire, itemName, true, true)
 			return goType, "", nt, err
@@ -121,6 +129,13 @@ func (sb *structBuilder) mapField(ref openapi.SchemaRef, wire string, required b
 		}
 	}
 
+	// Inline integers get the identifier type that other properties of their wire name reference.
+	// Their field keeps the name of the wire, as it is not named after the model.
+	if id, ok := sb.mapper.identifiers[wire]; ok && schemaName == "" && schema.Type == "integer" && schema.Format == id.Format {
+		goType, _, err := sb.mapper.MapSchema(schema, id.Name, required)
+		return goType, "", false, err
+	}
+
 	goType, commonName, err := sb.mapper.MapSchemaRef(ref, required)
 	return goType, commonName, strings.Contains(goType.Type, "time.Time"), err
 }
@@ -162,7 +177,10 @@ func isObjectSchema(schema openapi.Schema) bool {
 
 // schemaYieldsStruct reports whether a schema is rendered as a generated struct
 // (object, oneOf union, etc.) rather than a primitive or common-model alias.
-func schemaYieldsStruct(schema openapi.Schema, schemaName string, ref openapi.SchemaRef) bool {
+func (m *TypeMapper) schemaYieldsStruct(schema openapi.Schema, schemaName string, ref openapi.SchemaRef) bool {
+	if schemaName != "" && m.commonSchemas[schemaName] {
+		return false
+	}
 	if isOneOfSchema(schema, ref) {
 		return true
 	}
@@ -179,6 +197,9 @@ func objectStructName(schemaName, wire string) string {
 func allStructFields(defs []StructDef) []StructField {
 	var out []StructField
 	for _, d := range defs {
+		if d.Alias != nil {
+			out = append(out, StructField{Type: *d.Alias})
+		}
 		out = append(out, d.Fields...)
 	}
 	return out
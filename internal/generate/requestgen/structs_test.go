@@ -1,9 +1,12 @@
 package requestgen_test
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/xaroth/lib-esi-go/internal/generate/gentest"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
 	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
 )
 
@@ -31,3 +34,116 @@ func TestBuildPackage_nestedObject(t *testing.T) {
 		t.Fatalf("expected Position field, got %+v", pkg.OutputFields)
 	}
 }
+
+// commonObjectSpec refers to an object common model from properties, array items, responses and bodies.
+const commonObjectSpec = `{
+  "paths": {
+    "/universe/stations/{station_id}": {
+      "get": {
+        "operationId": "GetUniverseStationsStationId",
+        "parameters": [{ "name": "station_id", "in": "path", "required": true, "schema": { "type": "integer", "format": "int64" } }],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "object",
+          "required": ["position"],
+          "properties": {
+            "position": { "$ref": "#/components/schemas/Position" },
+            "docking": { "$ref": "#/components/schemas/Position" },
+            "waypoints": { "type": "array", "items": { "$ref": "#/components/schemas/Position" } }
+          }
+        } } } } }
+      }
+    },
+    "/universe/positions": {
+      "get": {
+        "operationId": "GetUniversePositions",
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "array", "items": { "$ref": "#/components/schemas/Position" }
+        } } } } }
+      },
+      "post": {
+        "operationId": "PostUniversePositions",
+        "requestBody": { "required": true, "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Position" } } } },
+        "responses": { "200": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Position" } } } } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Position": {
+        "x-common-model": true,
+        "type": "object",
+        "required": ["x", "y", "z"],
+        "properties": {
+          "x": { "type": "number", "format": "double" },
+          "y": { "type": "number", "format": "double" },
+          "z": { "type": "number", "format": "double" }
+        }
+      }
+    }
+  }
+}`
+
+func TestGeneratePackage_commonObject(t *testing.T) {
+	var spec openapi.Spec
+	if err := json.Unmarshal([]byte(commonObjectSpec), &spec); err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+
+	testCases := []struct {
+		operationID string
+		file        func(requestgen.GeneratedFiles) []byte
+		contains    []string
+	}{
+		{
+			operationID: "GetUniverseStationsStationId",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Output },
+			contains: []string{
+				`"github.com/xaroth/lib-esi-go/common/position"`,
+				"Docking *position.Position `json:\"docking\"`",
+				"Position position.Position `json:\"position\"`",
+				"Waypoints []position.Position `json:\"waypoints\"`",
+			},
+		},
+		{
+			operationID: "GetUniversePositions",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Output },
+			contains:    []string{"type Output = []position.Position"},
+		},
+		{
+			operationID: "PostUniversePositions",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Output },
+			contains:    []string{"type Output = position.Position"},
+		},
+		{
+			operationID: "PostUniversePositions",
+			file:        func(files requestgen.GeneratedFiles) []byte { return files.Input },
+			contains:    []string{"Body position.Position `body:\"json\" required:\"true\"`"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ops, err := requestgen.FindOperations(&spec, []string{testCase.operationID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkg, err := requestgen.BuildPackage(ops[0], &spec, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files, err := requestgen.GeneratePackage(pkg, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Ignore the alignment of gofmt.
+		out := strings.Join(strings.Fields(string(testCase.file(files))), " ")
+		for _, want := range testCase.contains {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s: output missing %q:\n%s", testCase.operationID, want, out)
+			}
+		}
+		if strings.Contains(out, "type Position struct") {
+			t.Errorf("%s: output inlines Position:\n%s", testCase.operationID, out)
+		}
+	}
+}
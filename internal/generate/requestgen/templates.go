@@ -54,17 +54,19 @@ type fileTemplateData struct {
 	HasImports    bool
 	Fields        []StructField
 	Nested        []StructDef
+	Validations   []string // statements of the Validate method of inputs
 }
 
 type requestTemplateData struct {
-	PackageName           string
-	RequestImport         string
-	MethodConst           string
-	PathLiteral           string
-	OutputType            string
-	Static                bool
-	HasRequiredScopes     bool
-	RequiredScopesLiteral string
+	Doc              []string // package doc comment lines
+	PackageName      string
+	RequestImport    string
+	MethodConst      string
+	PathLiteral      string
+	OutputType       string
+	Static           bool
+	SecurityLiterals []string // one WithRequiredScope argument list per security requirement
+	CacheSeconds     int
 }
 
 func fileImportsForFields(fields []StructField, cfg Config, needsTime bool) (common, other []string) {
@@ -104,6 +106,14 @@ func pathLiteral(path string) string {
 	return strconv.Quote(path)
 }
 
+func securityLiterals(security [][]string) []string {
+	literals := make([]string, len(security))
+	for i, scopes := range security {
+		literals[i] = scopesLiteral(scopes)
+	}
+	return literals
+}
+
 func scopesLiteral(scopes []string) string {
 	parts := make([]string, len(scopes))
 	for i, scope := range scopes {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/xaroth/lib-esi-go/internal/generate/commonmodels"
 	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
@@ -13,12 +14,31 @@ import (
 type Config struct {
 	LibModule    string // e.g. github.com/xaroth/lib-esi-go
 	CommonSuffix string // e.g. common
+
+	// StructAliases keeps the names of nested output structs that collapse into one of identical shape,
+	// as type aliases of that struct.
+	StructAliases bool
+	// SharedStructs promotes nested output structs that recur across packages into shared types under common.
+	SharedStructs bool
+	// FakeServer generates the esitest package, a fake server of every operation for integration tests.
+	FakeServer bool
+	// Client generates the client package, which calls every operation through an interface per domain.
+	Client bool
 }
 
 func (c Config) requestImport() string {
 	return c.LibModule + "/request"
 }
 
+// civilImport is the package of the civil.Date type, which properties of format date map to.
+func (c Config) civilImport() string {
+	return c.LibModule + "/civil"
+}
+
+func (c Config) rateLimitingImport() string {
+	return c.LibModule + "/middleware/ratelimiting"
+}
+
 func (c Config) commonImport(schemaName string) string {
 	pkg := commonmodels.PackageName(schemaName)
 	return c.LibModule + "/" + c.CommonSuffix + "/" + pkg
@@ -36,6 +56,7 @@ type TypeMapper struct {
 	cfg           Config
 	resolver      *openapi.Resolver
 	commonSchemas map[string]bool
+	identifiers   map[string]identifierSchema // by the wire name of inline properties, see identifierSchemasByWire
 }
 
 func NewTypeMapper(cfg Config, spec *openapi.Spec) *TypeMapper {
@@ -48,6 +69,7 @@ func NewTypeMapper(cfg Config, spec *openapi.Spec) *TypeMapper {
 		cfg:           cfg,
 		resolver:      openapi.NewResolver(spec),
 		commonSchemas: set,
+		identifiers:   identifierSchemasByWire(spec),
 	}
 }
 
@@ -99,31 +121,37 @@ func (m *TypeMapper) MapSchema(schema openapi.Schema, schemaName string, require
 		return GoType{}, "", err
 	}
 	if !required {
-		base = "*" + base
+		base.Type = "*" + base.Type
 	}
-	return GoType{Type: base}, "", nil
+	return base, "", nil
 }
 
-func (m *TypeMapper) primitiveType(schema openapi.Schema) (string, error) {
+func (m *TypeMapper) primitiveType(schema openapi.Schema) (GoType, error) {
 	switch schema.Type {
 	case "integer":
 		switch schema.Format {
 		case "int32":
-			return "int32", nil
+			return GoType{Type: "int32"}, nil
 		default:
-			return "int64", nil
+			return GoType{Type: "int64"}, nil
 		}
 	case "number":
-		return "float64", nil
+		return GoType{Type: "float64"}, nil
 	case "boolean":
-		return "bool", nil
+		return GoType{Type: "bool"}, nil
 	case "string":
-		if schema.Format == "date-time" {
-			return "time.Time", nil
+		switch schema.Format {
+		case "date-time":
+			return GoType{Type: "time.Time"}, nil
+		case "date":
+			return GoType{Type: "civil.Date", Import: m.cfg.civilImport(), Package: "civil"}, nil
+		case "duration":
+			return GoType{Type: "civil.Duration", Import: m.cfg.civilImport(), Package: "civil"}, nil
+		default:
+			return GoType{Type: "string"}, nil
 		}
-		return "string", nil
 	default:
-		return "", fmt.Errorf("unsupported schema type %q format %q", schema.Type, schema.Format)
+		return GoType{}, fmt.Errorf("unsupported schema type %q format %q", schema.Type, schema.Format)
 	}
 }
 
@@ -135,13 +163,19 @@ type StructField struct {
 	TagVal       string
 	TagOmitEmpty bool // append ,omitempty to JSON tag (oneOf unions)
 	TagRequired  bool // append required:"true" on input fields
+	Doc          []string // comment lines from the parameter or schema description
+	Validation   *Validation // constraints checked by the Validate method of inputs
 }
 
 // PackageModel is everything needed to render one operation package.
 type PackageModel struct {
+	OperationID   string
 	PackageName   string
 	Method        string
 	Path          string
+	Summary       string
+	Description   string
+	CacheSeconds  int // from x-cached-seconds; 0 when not cached
 	InputFields   []StructField
 	InputNested   []StructDef
 	OutputFields  []StructField
@@ -153,6 +187,23 @@ type PackageModel struct {
 	Static        bool
 	NeedsTime     bool
 	RequiredScopes []string
+	Security       [][]string // security requirements; any one grants access, and needs all of its scopes
+	RateLimit      *RateLimitModel // nil when the operation has no x-rate-limit extension
+	RequiredRoles  []string        // corporation roles of which any one is needed, from x-required-roles
+
+	// Status is the status of the response the output is decoded from, e.g. 200, or 204 without content.
+	Status int
+	// ParameterEnums lists the allowed values of enum parameters by location, e.g. query.order_type.
+	ParameterEnums map[string][]string
+	// Example is the JSON response synthesized from the schema and its examples, for the fake server.
+	Example string
+}
+
+// RateLimitModel is the rate limit group of an operation.
+type RateLimitModel struct {
+	Group      string
+	BucketSize int
+	WindowSize time.Duration
 }
 
 func collectImports(fields []StructField, cfg Config, needsTime bool) []string {
@@ -34,3 +34,36 @@ func TestTypeMapper_optionalPointer(t *testing.T) {
 		t.Errorf("got %q", gt.Type)
 	}
 }
+
+func TestTypeMapper_formats(t *testing.T) {
+	spec := gentest.LoadMinimalSpec(t)
+	mapper := requestgen.NewTypeMapper(requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}, spec)
+
+	testCases := []struct {
+		name     string
+		schema   openapi.Schema
+		required bool
+		expected requestgen.GoType
+	}{
+		{name: "date", schema: openapi.Schema{Type: "string", Format: "date"}, required: true, expected: requestgen.GoType{Type: "civil.Date", Import: "github.com/xaroth/lib-esi-go/civil", Package: "civil"}},
+		{name: "optional date", schema: openapi.Schema{Type: "string", Format: "date"}, expected: requestgen.GoType{Type: "*civil.Date", Import: "github.com/xaroth/lib-esi-go/civil", Package: "civil"}},
+		{name: "date-time", schema: openapi.Schema{Type: "string", Format: "date-time"}, required: true, expected: requestgen.GoType{Type: "time.Time"}},
+		{name: "duration", schema: openapi.Schema{Type: "string", Format: "duration"}, required: true, expected: requestgen.GoType{Type: "civil.Duration", Import: "github.com/xaroth/lib-esi-go/civil", Package: "civil"}},
+		{name: "optional duration", schema: openapi.Schema{Type: "string", Format: "duration"}, expected: requestgen.GoType{Type: "*civil.Duration", Import: "github.com/xaroth/lib-esi-go/civil", Package: "civil"}},
+		{name: "uuid", schema: openapi.Schema{Type: "string", Format: "uuid"}, required: true, expected: requestgen.GoType{Type: "string"}},
+		{name: "int32", schema: openapi.Schema{Type: "integer", Format: "int32"}, expected: requestgen.GoType{Type: "*int32"}},
+		{name: "dates", schema: openapi.Schema{Type: "array", Items: &openapi.SchemaRef{Type: "string", Format: "date"}}, required: true, expected: requestgen.GoType{Type: "[]civil.Date", Import: "github.com/xaroth/lib-esi-go/civil", Package: "civil"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, _, err := mapper.MapSchema(testCase.schema, "", testCase.required)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != testCase.expected {
+				t.Errorf("MapSchema = %+v, want %+v", got, testCase.expected)
+			}
+		})
+	}
+}
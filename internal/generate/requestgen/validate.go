@@ -0,0 +1,215 @@
+package requestgen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+)
+
+// Validation is what the generated Validate method checks of an input field.
+type Validation struct {
+	Location    string // where violations are reported, e.g. query.page, body.name, or body
+	Kind        string // number, string, array, or object
+	Constraints openapi.Constraints
+	Items       *Validation            // the items of an array
+	Properties  map[string]*Validation // the properties of an object by wire name, located relative to it
+}
+
+// validationFor returns the constraints of a schema that can be checked before sending a request,
+// or nil if there are none. Patterns Go's regexp package cannot compile are left to ESI.
+// The items of arrays and the properties of objects are checked as deep as they are nested.
+func validationFor(resolver *openapi.Resolver, ref openapi.SchemaRef, location string) (*Validation, error) {
+	return nestedValidationFor(resolver, ref, location, map[string]bool{})
+}
+
+// nestedValidationFor is validationFor, which does not descend into the schemas named in seen again,
+// so recursive schemas end.
+func nestedValidationFor(resolver *openapi.Resolver, ref openapi.SchemaRef, location string, seen map[string]bool) (*Validation, error) {
+	schema, name, err := resolver.ResolveSchemaRefDeep(ref)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		if seen[name] {
+			return nil, nil
+		}
+		seen[name] = true
+		defer delete(seen, name)
+	}
+
+	c := schema.Constraints
+	v := &Validation{Location: location}
+	switch schema.Type {
+	case "integer", "number":
+		v.Kind = "number"
+		v.Constraints = openapi.Constraints{Minimum: c.Minimum, Maximum: c.Maximum}
+	case "string":
+		if schema.Format == "date" || schema.Format == "date-time" {
+			return nil, nil
+		}
+		v.Kind = "string"
+		v.Constraints = openapi.Constraints{MinLength: c.MinLength, MaxLength: c.MaxLength}
+		if _, err := regexp.Compile(c.Pattern); err == nil {
+			v.Constraints.Pattern = c.Pattern
+		}
+	case "array":
+		v.Kind = "array"
+		v.Constraints = openapi.Constraints{MinItems: c.MinItems, MaxItems: c.MaxItems}
+		if schema.Items != nil {
+			item, _, err := resolver.ResolveSchemaRefDeep(*schema.Items)
+			if err != nil {
+				return nil, err
+			}
+			// Items of objects are not compared.
+			if isScalarSchema(item) {
+				v.Constraints.UniqueItems = c.UniqueItems
+			}
+			if v.Items, err = nestedValidationFor(resolver, *schema.Items, "", seen); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		if !isObjectSchema(schema) || len(schema.OneOf) > 0 {
+			return nil, nil
+		}
+		v.Kind = "object"
+		for wire, prop := range schema.Properties {
+			pv, err := nestedValidationFor(resolver, prop, wire, seen)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", wire, err)
+			}
+			if pv != nil {
+				if v.Properties == nil {
+					v.Properties = make(map[string]*Validation)
+				}
+				v.Properties[wire] = pv
+			}
+		}
+	}
+	if v.Constraints.IsZero() && v.Items == nil && len(v.Properties) == 0 {
+		return nil, nil
+	}
+	return v, nil
+}
+
+func isScalarSchema(s openapi.Schema) bool {
+	switch s.Type {
+	case "integer", "number", "string", "boolean":
+		return len(s.OneOf) == 0
+	default:
+		return false
+	}
+}
+
+// validationStatements returns the statements of the Validate method of a struct with the given fields,
+// which report violations to a request.Validator named v. Fields of the nested structs are checked through
+// the validations of objects.
+func validationStatements(receiver string, fields []StructField, nested []StructDef) []string {
+	g := validationGenerator{structs: make(map[string][]StructField, len(nested))}
+	for _, def := range nested {
+		if def.Alias == nil {
+			g.structs[def.Name] = def.Fields
+		}
+	}
+
+	var lines []string
+	for _, f := range fields {
+		if f.Validation != nil {
+			lines = append(lines, g.lines(receiver+"."+f.Name, f.Type.Type, strconv.Quote(f.Validation.Location), f.Validation, 0)...)
+		}
+	}
+	return lines
+}
+
+type validationGenerator struct {
+	structs map[string][]StructField // the nested structs of the input by name
+}
+
+// lines checks the value of expr, of Go type typ, and reports violations at the location expression.
+// depth is the number of enclosing loops, which name their variables apart.
+func (g validationGenerator) lines(expr, typ, location string, v *Validation, depth int) []string {
+	if elem, ok := strings.CutPrefix(typ, "*"); ok {
+		inner := g.lines("*"+expr, elem, location, v, depth)
+		if len(inner) == 0 {
+			return nil
+		}
+		return append(append([]string{"if " + expr + " != nil {"}, inner...), "}")
+	}
+
+	var lines []string
+	c := v.Constraints
+	switch v.Kind {
+	case "number":
+		value := expr
+		if typ != "float64" {
+			value = "float64(" + expr + ")"
+		}
+		if c.Minimum != nil {
+			lines = append(lines, fmt.Sprintf("v.Minimum(%s, %s, %s)", location, value, formatFloat(*c.Minimum)))
+		}
+		if c.Maximum != nil {
+			lines = append(lines, fmt.Sprintf("v.Maximum(%s, %s, %s)", location, value, formatFloat(*c.Maximum)))
+		}
+	case "string":
+		value := expr
+		if typ != "string" {
+			value = "string(" + expr + ")"
+		}
+		if c.MinLength != nil {
+			lines = append(lines, fmt.Sprintf("v.MinLength(%s, %s, %d)", location, value, *c.MinLength))
+		}
+		if c.MaxLength != nil {
+			lines = append(lines, fmt.Sprintf("v.MaxLength(%s, %s, %d)", location, value, *c.MaxLength))
+		}
+		if c.Pattern != "" {
+			lines = append(lines, fmt.Sprintf("v.Pattern(%s, %s, %s)", location, value, strconv.Quote(c.Pattern)))
+		}
+	case "array":
+		if c.MinItems != nil {
+			lines = append(lines, fmt.Sprintf("v.MinItems(%s, len(%s), %d)", location, expr, *c.MinItems))
+		}
+		if c.MaxItems != nil {
+			lines = append(lines, fmt.Sprintf("v.MaxItems(%s, len(%s), %d)", location, expr, *c.MaxItems))
+		}
+		if c.UniqueItems {
+			lines = append(lines, fmt.Sprintf("request.UniqueItems(&v, %s, %s)", location, expr))
+		}
+		if v.Items != nil {
+			index, item := "n", "item"
+			if depth > 0 {
+				index, item = fmt.Sprintf("n%d", depth+1), fmt.Sprintf("item%d", depth+1)
+			}
+			items := g.lines(item, strings.TrimPrefix(typ, "[]"), "request.Index("+location+", "+index+")", v.Items, depth+1)
+			if len(items) > 0 {
+				lines = append(lines, "for "+index+", "+item+" := range "+expr+" {")
+				lines = append(lines, items...)
+				lines = append(lines, "}")
+			}
+		}
+	case "object":
+		// Objects of common models and unions have no nested struct, and are left to ESI.
+		// Selectors dereference pointers to structs themselves.
+		base := strings.TrimPrefix(expr, "*")
+		for _, f := range g.structs[typ] {
+			if pv := v.Properties[f.TagVal]; pv != nil {
+				lines = append(lines, g.lines(base+"."+f.Name, f.Type.Type, joinLocation(location, f.TagVal), pv, depth)...)
+			}
+		}
+	}
+	return lines
+}
+
+// joinLocation returns the location expression of a property of the object at location, e.g. body.items[2].type_id.
+func joinLocation(location, wire string) string {
+	if unquoted, err := strconv.Unquote(location); err == nil {
+		return strconv.Quote(unquoted + "." + wire)
+	}
+	return location + " + " + strconv.Quote("."+wire)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
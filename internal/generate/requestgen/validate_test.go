@@ -0,0 +1,168 @@
+package requestgen_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/requestgen"
+)
+
+// validateSpec has constraints on parameters and bodies.
+const validateSpec = `{
+  "paths": {
+    "/universe/ids": {
+      "post": {
+        "operationId": "PostUniverseIds",
+        "parameters": [
+          { "name": "language", "in": "query", "schema": { "type": "string", "pattern": "^[a-z]{2}(-[a-z]{2})?$" } },
+          { "name": "datasource", "in": "query", "schema": { "type": "string", "pattern": "^(?!test)" } }
+        ],
+        "requestBody": { "required": true, "content": { "application/json": { "schema": {
+          "type": "array",
+          "minItems": 1,
+          "maxItems": 500,
+          "uniqueItems": true,
+          "items": { "type": "string", "minLength": 1, "maxLength": 100 }
+        } } } },
+        "responses": { "204": {} }
+      }
+    },
+    "/characters/{character_id}/assets": {
+      "get": {
+        "operationId": "GetCharactersCharacterIdAssets",
+        "parameters": [
+          { "name": "character_id", "in": "path", "required": true, "schema": { "$ref": "#/components/schemas/CharacterID", "minimum": 1 } },
+          { "name": "page", "in": "query", "schema": { "type": "integer", "format": "int32", "minimum": 1, "maximum": 10000 } },
+          { "name": "since", "in": "query", "schema": { "type": "string", "format": "date", "maxLength": 10 } }
+        ],
+        "responses": { "204": {} }
+      }
+    },
+    "/characters/{character_id}/fittings": {
+      "post": {
+        "operationId": "PostCharactersCharacterIdFittings",
+        "requestBody": { "required": true, "content": { "application/json": { "schema": {
+          "type": "object",
+          "required": ["name", "items"],
+          "properties": {
+            "name": { "type": "string", "maxLength": 50 },
+            "items": { "type": "array", "maxItems": 512, "uniqueItems": true, "items": {
+              "type": "object",
+              "required": ["quantity"],
+              "properties": {
+                "type_id": { "type": "integer", "format": "int64" },
+                "quantity": { "type": "integer", "format": "int32", "minimum": 1 },
+                "charges": { "type": "array", "items": {
+                  "type": "object",
+                  "properties": { "flag": { "type": "string", "maxLength": 20 } }
+                } }
+              }
+            } },
+            "ship": { "type": "object", "properties": { "name": { "type": "string", "minLength": 1 } } }
+          }
+        } } } },
+        "responses": { "204": {} }
+      }
+    },
+    "/status": {
+      "get": {
+        "operationId": "GetStatus",
+        "parameters": [
+          { "name": "region_id", "in": "query", "schema": { "type": "integer", "format": "int64" } }
+        ],
+        "responses": { "204": {} }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "CharacterID": { "x-common-model": true, "type": "integer", "format": "int64" }
+    }
+  }
+}`
+
+func TestGeneratePackage_validate(t *testing.T) {
+	var spec openapi.Spec
+	if err := json.Unmarshal([]byte(validateSpec), &spec); err != nil {
+		t.Fatal(err)
+	}
+	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
+
+	testCases := []struct {
+		operationID string
+		contains    []string
+		notContains []string
+	}{
+		{
+			operationID: "PostUniverseIds",
+			contains: []string{
+				`"github.com/xaroth/lib-esi-go/request"`,
+				"func (i Input) Validate() error { var v request.Validator",
+				`if i.Language != nil { v.Pattern("query.language", *i.Language, "^[a-z]{2}(-[a-z]{2})?$") }`,
+				`v.MinItems("body", len(i.Body), 1)`,
+				`v.MaxItems("body", len(i.Body), 500)`,
+				`request.UniqueItems(&v, "body", i.Body)`,
+				`for n, item := range i.Body { v.MinLength(request.Index("body", n), item, 1) v.MaxLength(request.Index("body", n), item, 100) }`,
+				"return v.Err() }",
+			},
+			// Go's regexp package does not support lookaheads.
+			notContains: []string{"query.datasource"},
+		},
+		{
+			operationID: "GetCharactersCharacterIdAssets",
+			contains: []string{
+				`v.Minimum("path.character_id", float64(i.Character), 1)`,
+				`if i.Page != nil { v.Minimum("query.page", float64(*i.Page), 1) v.Maximum("query.page", float64(*i.Page), 10000) }`,
+			},
+			notContains: []string{"query.since"},
+		},
+		{
+			operationID: "PostCharactersCharacterIdFittings",
+			contains: []string{
+				`v.MaxLength("body.name", i.Name, 50)`,
+				`v.MaxItems("body.items", len(i.Items), 512)`,
+				// Arrays of objects and nested objects are checked as deep as they go.
+				`for n, item := range i.Items { for n2, item2 := range item.Charges { if item2.Flag != nil { ` +
+					`v.MaxLength(request.Index(request.Index("body.items", n)+".charges", n2)+".flag", *item2.Flag, 20) } } ` +
+					`v.Minimum(request.Index("body.items", n)+".quantity", float64(item.Quantity), 1) }`,
+				`if i.Ship != nil { if i.Ship.Name != nil { v.MinLength("body.ship.name", *i.Ship.Name, 1) } }`,
+			},
+			// Items of objects are not compared.
+			notContains: []string{"UniqueItems", "item.TypeId"},
+		},
+		{
+			operationID: "GetStatus",
+			notContains: []string{"Validate", `"github.com/xaroth/lib-esi-go/request"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.operationID, func(t *testing.T) {
+			ops, err := requestgen.FindOperations(&spec, []string{testCase.operationID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			pkg, err := requestgen.BuildPackage(ops[0], &spec, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			files, err := requestgen.GeneratePackage(pkg, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			input := strings.Join(strings.Fields(string(files.Input)), " ")
+			for _, want := range testCase.contains {
+				if !strings.Contains(input, want) {
+					t.Errorf("input missing %q:\n%s", want, files.Input)
+				}
+			}
+			for _, unwanted := range testCase.notContains {
+				if strings.Contains(input, unwanted) {
+					t.Errorf("input contains %q:\n%s", unwanted, files.Input)
+				}
+			}
+		})
+	}
+}
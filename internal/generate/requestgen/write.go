@@ -49,5 +49,49 @@ func BuildAndWrite(spec *openapi.Spec, ops []Operation, outDir string, cfg Confi
 		}
 		packages = append(packages, pkg)
 	}
-	return WritePackages(outDir, packages, cfg, check)
+	written := 0
+	if cfg.SharedStructs {
+		var shared []StructDef
+		packages, shared = PromoteSharedStructs(packages, cfg)
+		if len(shared) > 0 {
+			if err := WriteSharedStructs(outDir, shared, cfg, check); err != nil {
+				return written, err
+			}
+			written++
+		}
+	}
+	n, err := WritePackages(outDir, packages, cfg, check)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	if err := WriteRateLimits(outDir, packages, cfg, check); err != nil {
+		return written, err
+	}
+	written++
+	if err := WriteRequiredRoles(outDir, packages, check); err != nil {
+		return written, err
+	}
+	written++
+	if err := WriteScopes(outDir, packages, check); err != nil {
+		return written, err
+	}
+	written++
+	if err := WriteRegistry(outDir, packages, cfg, check); err != nil {
+		return written, err
+	}
+	written++
+	if cfg.Client {
+		if err := WriteClient(outDir, packages, cfg, check); err != nil {
+			return written, err
+		}
+		written++
+	}
+	if cfg.FakeServer {
+		if err := WriteFakeServer(outDir, packages, cfg, check); err != nil {
+			return written, err
+		}
+		written++
+	}
+	return written, nil
 }
@@ -39,11 +39,14 @@ func TestWritePackages_check(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	dir := t.TempDir()
+	dir := moduleTempDir(t)
 	cfg := requestgen.Config{LibModule: "github.com/xaroth/lib-esi-go", CommonSuffix: "common"}
 	if _, err := requestgen.BuildAndWrite(spec, ops, dir, cfg, false); err != nil {
 		t.Fatal(err)
 	}
+	if _, err := os.Stat(filepath.Join(dir, requestgen.RegistryPackage, requestgen.RegistryFile)); err != nil {
+		t.Fatal(err)
+	}
 	if _, err := requestgen.BuildAndWrite(spec, ops, dir, cfg, true); err != nil {
 		t.Fatal(err)
 	}
@@ -52,3 +55,12 @@ func TestWritePackages_check(t *testing.T) {
 		t.Fatal("expected check error for missing file")
 	}
 }
+
+func moduleTempDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module github.com/xaroth/lib-esi-go\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
@@ -0,0 +1,404 @@
+// Package specdiff compares two OpenAPI specs, e.g. those of two compatibility dates, by operation.
+//
+// A change is breaking when code using the packages generated from the old spec stops compiling or working
+// with the new one: removed operations, parameters and fields, new required inputs, type changes,
+// fields changing between required and optional (and therefore between values and pointers),
+// removed enum values, and scopes that are no longer enough to call an operation.
+package specdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+)
+
+// Kind is the kind of a change.
+type Kind string
+
+const (
+	OperationAdded   Kind = "operation-added"
+	OperationRemoved Kind = "operation-removed"
+	OperationRenamed Kind = "operation-renamed"
+	FieldAdded       Kind = "field-added"
+	FieldRemoved     Kind = "field-removed"
+	TypeChanged      Kind = "type-changed"
+	RequiredChanged  Kind = "required-changed"
+	EnumValueAdded   Kind = "enum-value-added"
+	EnumValueRemoved Kind = "enum-value-removed"
+	SecurityAdded    Kind = "security-added"
+	SecurityRemoved  Kind = "security-removed"
+)
+
+// Change is a difference between the specs.
+type Change struct {
+	Kind        Kind   `json:"kind"`
+	Route       string `json:"route"` // e.g. GET /characters/{character_id}/assets
+	OperationID string `json:"operation_id"`
+	// Location is the parameter or field that changed, e.g. query.page, body.name, or response[].type_id,
+	// or security for scope changes.
+	Location string `json:"location,omitempty"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+// String describes the change, without its route.
+func (c Change) String() string {
+	switch c.Kind {
+	case OperationAdded:
+		return "operation " + c.OperationID + " added"
+	case OperationRemoved:
+		return "operation " + c.OperationID + " removed"
+	case OperationRenamed:
+		return fmt.Sprintf("operation renamed from %s to %s", c.Old, c.New)
+	case FieldAdded:
+		return fmt.Sprintf("%s added (%s)", c.Location, c.New)
+	case FieldRemoved:
+		return fmt.Sprintf("%s removed (%s)", c.Location, c.Old)
+	case TypeChanged:
+		return fmt.Sprintf("%s changed type from %s to %s", c.Location, c.Old, c.New)
+	case RequiredChanged:
+		return fmt.Sprintf("%s is now %s", c.Location, c.New)
+	case EnumValueAdded:
+		return fmt.Sprintf("%s has the new enum value %q", c.Location, c.New)
+	case EnumValueRemoved:
+		return fmt.Sprintf("%s lost the enum value %q", c.Location, c.Old)
+	case SecurityAdded:
+		return "security requirement added: " + c.New
+	case SecurityRemoved:
+		return "security requirement removed: " + c.Old
+	default:
+		return string(c.Kind)
+	}
+}
+
+// Report lists the changes between two specs, sorted by route and location.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Breaking returns the number of breaking changes.
+func (r Report) Breaking() int {
+	n := 0
+	for _, c := range r.Changes {
+		if c.Breaking {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText writes the report with one change per line, marking the breaking ones.
+func (r Report) WriteText(w io.Writer) error {
+	for _, c := range r.Changes {
+		marker := "         "
+		if c.Breaking {
+			marker = "BREAKING "
+		}
+		if _, err := fmt.Fprintf(w, "%s%s: %s\n", marker, c.Route, c); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d changes, %d breaking\n", len(r.Changes), r.Breaking())
+	return err
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// operation is an operation of a spec, with its parameters and fields flattened by location.
+type operation struct {
+	id       string
+	fields   map[string]field
+	security []string // the scopes of each security requirement, space separated
+}
+
+// field is a parameter, or a property of a request body or response.
+type field struct {
+	typ      string // e.g. integer/int64, string, array, object, or the name of a common model
+	input    bool
+	required bool
+	enum     []string
+}
+
+// Diff compares the operations of two specs.
+func Diff(oldSpec, newSpec *openapi.Spec) (Report, error) {
+	oldOps, err := operations(oldSpec)
+	if err != nil {
+		return Report{}, fmt.Errorf("old spec: %w", err)
+	}
+	newOps, err := operations(newSpec)
+	if err != nil {
+		return Report{}, fmt.Errorf("new spec: %w", err)
+	}
+
+	var changes []Change
+	for _, route := range sortedKeys(oldOps, newOps) {
+		oldOp, inOld := oldOps[route]
+		newOp, inNew := newOps[route]
+		switch {
+		case !inNew:
+			changes = append(changes, Change{Kind: OperationRemoved, Route: route, OperationID: oldOp.id, Breaking: true})
+		case !inOld:
+			changes = append(changes, Change{Kind: OperationAdded, Route: route, OperationID: newOp.id})
+		default:
+			changes = append(changes, diffOperation(route, oldOp, newOp)...)
+		}
+	}
+	return Report{Changes: changes}, nil
+}
+
+func diffOperation(route string, oldOp, newOp operation) []Change {
+	var changes []Change
+	change := func(c Change) {
+		c.Route = route
+		c.OperationID = newOp.id
+		changes = append(changes, c)
+	}
+
+	if oldOp.id != newOp.id {
+		// The operation ID names the generated package.
+		change(Change{Kind: OperationRenamed, Old: oldOp.id, New: newOp.id, Breaking: true})
+	}
+
+	var added, removed []string
+	for _, location := range sortedKeys(oldOp.fields, newOp.fields) {
+		oldField, inOld := oldOp.fields[location]
+		newField, inNew := newOp.fields[location]
+		switch {
+		case !inNew:
+			// Fields of a removed object or array are removed with it.
+			if !withinAny(location, removed) {
+				change(Change{Kind: FieldRemoved, Location: location, Old: oldField.typ, Breaking: true})
+			}
+			removed = append(removed, location)
+		case !inOld:
+			if !withinAny(location, added) {
+				change(Change{Kind: FieldAdded, Location: location, New: newField.typ, Breaking: newField.input && newField.required})
+			}
+			added = append(added, location)
+		default:
+			changes = append(changes, diffField(route, newOp.id, location, oldField, newField)...)
+		}
+	}
+
+	for _, requirement := range newOp.security {
+		if !slices.Contains(oldOp.security, requirement) {
+			// A new alternative is only breaking when the operation did not need authentication before.
+			change(Change{Kind: SecurityAdded, Location: "security", New: requirement, Breaking: len(oldOp.security) == 0})
+		}
+	}
+	for _, requirement := range oldOp.security {
+		if !slices.Contains(newOp.security, requirement) {
+			change(Change{Kind: SecurityRemoved, Location: "security", Old: requirement, Breaking: len(newOp.security) > 0})
+		}
+	}
+	return changes
+}
+
+func diffField(route, operationID, location string, oldField, newField field) []Change {
+	var changes []Change
+	change := func(c Change) {
+		c.Route = route
+		c.OperationID = operationID
+		c.Location = location
+		changes = append(changes, c)
+	}
+
+	if oldField.typ != newField.typ {
+		change(Change{Kind: TypeChanged, Old: oldField.typ, New: newField.typ, Breaking: true})
+	}
+	if oldField.required != newField.required {
+		change(Change{Kind: RequiredChanged, Old: requiredness(oldField.required), New: requiredness(newField.required), Breaking: true})
+	}
+	for _, value := range newField.enum {
+		if !slices.Contains(oldField.enum, value) {
+			change(Change{Kind: EnumValueAdded, New: value})
+		}
+	}
+	for _, value := range oldField.enum {
+		if !slices.Contains(newField.enum, value) {
+			change(Change{Kind: EnumValueRemoved, Old: value, Breaking: true})
+		}
+	}
+	return changes
+}
+
+func requiredness(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
+
+// withinAny reports whether location is a field of an object or the items of an array at any of the parents.
+func withinAny(location string, parents []string) bool {
+	for _, parent := range parents {
+		if strings.HasPrefix(location, parent+".") || strings.HasPrefix(location, parent+"[]") {
+			return true
+		}
+	}
+	return false
+}
+
+// operations returns the operations of a spec by route, e.g. GET /status.
+func operations(spec *openapi.Spec) (map[string]operation, error) {
+	resolver := openapi.NewResolver(spec)
+	commons := make(map[string]bool)
+	for _, name := range openapi.CommonSchemaNames(spec) {
+		commons[name] = true
+	}
+
+	ops := make(map[string]operation)
+	for path, item := range spec.Paths {
+		for method, op := range item {
+			route := strings.ToUpper(method) + " " + path
+			f := flattener{resolver: resolver, commons: commons, fields: make(map[string]field)}
+
+			for _, ref := range op.Parameters {
+				p, _, err := resolver.ResolveParameterRef(ref)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", route, err)
+				}
+				if p.Schema == nil {
+					continue
+				}
+				if err := f.add(p.In+"."+p.Name, *p.Schema, true, p.Required, nil); err != nil {
+					return nil, fmt.Errorf("%s parameter %q: %w", route, p.Name, err)
+				}
+			}
+			if op.RequestBody != nil {
+				if mt, ok := op.RequestBody.Content["application/json"]; ok {
+					if err := f.add("body", mt.Schema, true, op.RequestBody.Required, nil); err != nil {
+						return nil, fmt.Errorf("%s request body: %w", route, err)
+					}
+				}
+			}
+			if schema, ok := responseSchema(op); ok {
+				if err := f.add("response", schema, false, true, nil); err != nil {
+					return nil, fmt.Errorf("%s response: %w", route, err)
+				}
+			}
+
+			var security []string
+			for _, scopes := range openapi.SecurityScopes(op.Security) {
+				security = append(security, strings.Join(scopes, " "))
+			}
+			ops[route] = operation{id: op.OperationID, fields: f.fields, security: security}
+		}
+	}
+	return ops, nil
+}
+
+// responseSchema returns the JSON schema of the first successful response of an operation.
+func responseSchema(op openapi.Operation) (openapi.SchemaRef, bool) {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		if mt, ok := op.Responses[code].Content["application/json"]; ok {
+			return mt.Schema, true
+		}
+	}
+	return openapi.SchemaRef{}, false
+}
+
+// flattener collects the fields of a schema by location.
+type flattener struct {
+	resolver *openapi.Resolver
+	commons  map[string]bool
+	fields   map[string]field
+}
+
+// add records the field at location, and the properties and items within it. seen guards against recursive schemas.
+func (f *flattener) add(location string, ref openapi.SchemaRef, input, required bool, seen []string) error {
+	schema, name, err := f.resolver.ResolveSchemaRefDeep(ref)
+	if err != nil {
+		return err
+	}
+	f.fields[location] = field{
+		typ:      typeName(schema, name, f.commons),
+		input:    input,
+		required: required,
+		enum:     enumValues(schema.Enum),
+	}
+	if f.commons[name] || slices.Contains(seen, name) {
+		return nil
+	}
+	if name != "" {
+		seen = append(slices.Clip(seen), name)
+	}
+
+	if schema.Type == "array" && schema.Items != nil {
+		return f.add(location+"[]", *schema.Items, input, true, seen)
+	}
+	for _, variant := range schema.OneOf {
+		resolved, _, err := f.resolver.ResolveSchemaRef(variant)
+		if err != nil {
+			return err
+		}
+		for prop, propRef := range resolved.Properties {
+			if err := f.add(location+"."+prop, propRef, input, false, seen); err != nil {
+				return err
+			}
+		}
+	}
+	for prop, propRef := range schema.Properties {
+		if err := f.add(location+"."+prop, propRef, input, slices.Contains(schema.Required, prop), seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func typeName(schema openapi.Schema, name string, commons map[string]bool) string {
+	switch {
+	case commons[name]:
+		return name
+	case len(schema.OneOf) > 0:
+		return "oneOf"
+	case schema.Type == "":
+		return "any"
+	case schema.Format != "":
+		return string(schema.Type) + "/" + schema.Format
+	default:
+		return string(schema.Type)
+	}
+}
+
+func enumValues(enum []any) []string {
+	values := make([]string, len(enum))
+	for i, v := range enum {
+		values[i] = fmt.Sprint(v)
+	}
+	return values
+}
+
+// sortedKeys returns the keys of both maps, sorted.
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,238 @@
+package specdiff_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/internal/generate/openapi"
+	"github.com/xaroth/lib-esi-go/internal/generate/specdiff"
+)
+
+const oldSpec = `{
+  "paths": {
+    "/characters/{character_id}/assets": {
+      "get": {
+        "operationId": "GetCharactersCharacterIdAssets",
+        "security": [{ "OAuth2": ["esi-assets.read_assets.v1"] }],
+        "parameters": [
+          { "name": "character_id", "in": "path", "required": true, "schema": { "$ref": "#/components/schemas/CharacterID" } },
+          { "name": "page", "in": "query", "schema": { "type": "integer", "format": "int32" } }
+        ],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["item_id", "location_flag", "quantity"],
+            "properties": {
+              "item_id": { "type": "integer", "format": "int64" },
+              "location_flag": { "type": "string", "enum": ["Hangar", "Cargo", "Wardrobe"] },
+              "quantity": { "type": "integer", "format": "int32" },
+              "is_blueprint_copy": { "type": "boolean" },
+              "location": { "$ref": "#/components/schemas/Location" }
+            }
+          }
+        } } } } }
+      }
+    },
+    "/characters/{character_id}/location": {
+      "get": {
+        "operationId": "GetCharactersCharacterIdLocation",
+        "security": [{ "OAuth2": ["esi-location.read_location.v1"] }],
+        "responses": { "200": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Location" } } } } }
+      }
+    },
+    "/incursions": {
+      "get": {
+        "operationId": "GetIncursions",
+        "responses": { "200": { "content": { "application/json": { "schema": { "type": "array", "items": { "type": "string" } } } } } }
+      }
+    },
+    "/status": {
+      "get": {
+        "operationId": "GetStatus",
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "object",
+          "required": ["players"],
+          "properties": { "players": { "type": "integer", "format": "int32" } }
+        } } } } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "CharacterID": { "x-common-model": true, "type": "integer", "format": "int64" },
+      "Location": {
+        "type": "object",
+        "required": ["solar_system_id"],
+        "properties": {
+          "solar_system_id": { "type": "integer", "format": "int64" },
+          "station_id": { "type": "integer", "format": "int64" }
+        }
+      }
+    }
+  }
+}`
+
+const newSpec = `{
+  "paths": {
+    "/characters/{character_id}/assets": {
+      "get": {
+        "operationId": "GetCharactersCharacterIdAssets",
+        "security": [{ "OAuth2": ["esi-assets.read_assets.v1"] }, { "OAuth2": ["esi-assets.read_all_assets.v1"] }],
+        "parameters": [
+          { "name": "character_id", "in": "path", "required": true, "schema": { "$ref": "#/components/schemas/CharacterID" } },
+          { "name": "page", "in": "query", "schema": { "type": "integer", "format": "int64" } },
+          { "name": "location_flag", "in": "query", "required": true, "schema": { "type": "string" } }
+        ],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["item_id", "location_flag"],
+            "properties": {
+              "item_id": { "type": "integer", "format": "int64" },
+              "location_flag": { "type": "string", "enum": ["Hangar", "Cargo", "Deliveries"] },
+              "quantity": { "type": "integer", "format": "int32" },
+              "is_singleton": { "type": "boolean" }
+            }
+          }
+        } } } } }
+      }
+    },
+    "/characters/{character_id}/location": {
+      "get": {
+        "operationId": "GetCharacterLocation",
+        "security": [{ "OAuth2": ["esi-location.read_location.v1", "esi-location.read_online.v1"] }],
+        "responses": { "200": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Location" } } } } }
+      }
+    },
+    "/sovereignty/map": {
+      "get": {
+        "operationId": "GetSovereigntyMap",
+        "responses": { "200": { "content": { "application/json": { "schema": { "type": "array", "items": { "type": "string" } } } } } }
+      }
+    },
+    "/status": {
+      "get": {
+        "operationId": "GetStatus",
+        "security": [{ "OAuth2": ["esi-status.read_status.v1"] }],
+        "responses": { "200": { "content": { "application/json": { "schema": {
+          "type": "object",
+          "required": ["players"],
+          "properties": { "players": { "type": "integer", "format": "int32" }, "vip": { "type": "boolean" } }
+        } } } } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "CharacterID": { "x-common-model": true, "type": "integer", "format": "int64" },
+      "Location": {
+        "type": "object",
+        "required": ["solar_system_id"],
+        "properties": {
+          "solar_system_id": { "type": "integer", "format": "int64" },
+          "station_id": { "type": "integer", "format": "int64" }
+        }
+      }
+    }
+  }
+}`
+
+func loadSpecs(t *testing.T) (*openapi.Spec, *openapi.Spec) {
+	t.Helper()
+	var oldParsed, newParsed openapi.Spec
+	if err := json.Unmarshal([]byte(oldSpec), &oldParsed); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(newSpec), &newParsed); err != nil {
+		t.Fatal(err)
+	}
+	return &oldParsed, &newParsed
+}
+
+func TestDiff(t *testing.T) {
+	oldParsed, newParsed := loadSpecs(t)
+	report, err := specdiff.Diff(oldParsed, newParsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const assets = "GET /characters/{character_id}/assets"
+	const location = "GET /characters/{character_id}/location"
+	expected := []specdiff.Change{
+		{Kind: specdiff.FieldAdded, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "query.location_flag", New: "string", Breaking: true},
+		{Kind: specdiff.TypeChanged, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "query.page", Old: "integer/int32", New: "integer/int64", Breaking: true},
+		{Kind: specdiff.FieldRemoved, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "response[].is_blueprint_copy", Old: "boolean", Breaking: true},
+		{Kind: specdiff.FieldAdded, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "response[].is_singleton", New: "boolean"},
+		// The fields of the location are not listed separately.
+		{Kind: specdiff.FieldRemoved, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "response[].location", Old: "object", Breaking: true},
+		{Kind: specdiff.EnumValueAdded, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "response[].location_flag", New: "Deliveries"},
+		{Kind: specdiff.EnumValueRemoved, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "response[].location_flag", Old: "Wardrobe", Breaking: true},
+		{Kind: specdiff.RequiredChanged, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "response[].quantity", Old: "required", New: "optional", Breaking: true},
+		{Kind: specdiff.SecurityAdded, Route: assets, OperationID: "GetCharactersCharacterIdAssets", Location: "security", New: "esi-assets.read_all_assets.v1"},
+		{Kind: specdiff.OperationRenamed, Route: location, OperationID: "GetCharacterLocation", Old: "GetCharactersCharacterIdLocation", New: "GetCharacterLocation", Breaking: true},
+		{Kind: specdiff.SecurityAdded, Route: location, OperationID: "GetCharacterLocation", Location: "security", New: "esi-location.read_location.v1 esi-location.read_online.v1"},
+		{Kind: specdiff.SecurityRemoved, Route: location, OperationID: "GetCharacterLocation", Location: "security", Old: "esi-location.read_location.v1", Breaking: true},
+		{Kind: specdiff.OperationRemoved, Route: "GET /incursions", OperationID: "GetIncursions", Breaking: true},
+		{Kind: specdiff.OperationAdded, Route: "GET /sovereignty/map", OperationID: "GetSovereigntyMap"},
+		{Kind: specdiff.FieldAdded, Route: "GET /status", OperationID: "GetStatus", Location: "response.vip", New: "boolean"},
+		{Kind: specdiff.SecurityAdded, Route: "GET /status", OperationID: "GetStatus", Location: "security", New: "esi-status.read_status.v1", Breaking: true},
+	}
+	if diff := cmp.Diff(expected, report.Changes); diff != "" {
+		t.Errorf("changes mismatch (-want +got):\n%s", diff)
+	}
+	if report.Breaking() != 10 {
+		t.Errorf("Breaking() = %d, want 10", report.Breaking())
+	}
+
+	same, err := specdiff.Diff(oldParsed, oldParsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(same.Changes) != 0 {
+		t.Errorf("expected no changes between equal specs, got %+v", same.Changes)
+	}
+}
+
+func TestReport_write(t *testing.T) {
+	oldParsed, newParsed := loadSpecs(t)
+	report, err := specdiff.Diff(oldParsed, newParsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var text bytes.Buffer
+	if err := report.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"BREAKING GET /characters/{character_id}/assets: query.page changed type from integer/int32 to integer/int64\n",
+		"         GET /characters/{character_id}/assets: response[].location_flag has the new enum value \"Deliveries\"\n",
+		"BREAKING GET /characters/{character_id}/location: operation renamed from GetCharactersCharacterIdLocation to GetCharacterLocation\n",
+		"         GET /sovereignty/map: operation GetSovereigntyMap added\n",
+		"16 changes, 10 breaking\n",
+	} {
+		if !strings.Contains(text.String(), want) {
+			t.Errorf("text missing %q:\n%s", want, text.String())
+		}
+	}
+
+	var out bytes.Buffer
+	if err := report.WriteJSON(&out); err != nil {
+		t.Fatal(err)
+	}
+	var decoded specdiff.Report
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(report, decoded); diff != "" {
+		t.Errorf("JSON round trip mismatch (-want +got):\n%s", diff)
+	}
+	if !strings.Contains(out.String(), `"kind": "operation-removed"`) {
+		t.Errorf("unexpected JSON:\n%s", out.String())
+	}
+}
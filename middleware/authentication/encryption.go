@@ -0,0 +1,124 @@
+package authentication
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// encryptedPrefix marks encrypted values, so tokens stored before enabling encryption can still be read.
+const encryptedPrefix = "enc:v1:"
+
+var (
+	ErrDecryptionFailed = errors.New("failed to decrypt token")
+)
+
+type encryptedStore struct {
+	store TokenStore
+	aead  cipher.AEAD
+}
+
+// NewEncryptedStore wraps a TokenStore, encrypting refresh and access tokens at rest with AES-GCM.
+// The key must be 16, 24, or 32 bytes long. Tokens stored without encryption are read as-is,
+// and encrypted the next time they are stored.
+func NewEncryptedStore(store TokenStore, key []byte) (TokenStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedStore{store: store, aead: aead}, nil
+}
+
+func (s *encryptedStore) Get(ctx context.Context, characterID int64) (*StoredToken, error) {
+	token, err := s.store.Get(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.decrypt(token)
+}
+
+func (s *encryptedStore) List(ctx context.Context) ([]*StoredToken, error) {
+	tokens, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, token := range tokens {
+		if tokens[i], err = s.decrypt(token); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
+}
+
+func (s *encryptedStore) Put(ctx context.Context, token *StoredToken) error {
+	encrypted := cloneToken(*token)
+
+	var err error
+	if encrypted.RefreshToken, err = s.seal(token.RefreshToken, token.CharacterID); err != nil {
+		return err
+	}
+	if encrypted.AccessToken, err = s.seal(token.AccessToken, token.CharacterID); err != nil {
+		return err
+	}
+	return s.store.Put(ctx, encrypted)
+}
+
+func (s *encryptedStore) Delete(ctx context.Context, characterID int64) error {
+	return s.store.Delete(ctx, characterID)
+}
+
+func (s *encryptedStore) decrypt(token *StoredToken) (*StoredToken, error) {
+	var err error
+	if token.RefreshToken, err = s.open(token.RefreshToken, token.CharacterID); err != nil {
+		return nil, err
+	}
+	if token.AccessToken, err = s.open(token.AccessToken, token.CharacterID); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (s *encryptedStore) seal(value string, characterID int64) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	// The character ID is authenticated, so tokens can't be swapped between characters.
+	sealed := s.aead.Seal(nonce, nonce, []byte(value), additionalData(characterID))
+	return encryptedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *encryptedStore) open(value string, characterID int64) (string, error) {
+	encoded, ok := strings.CutPrefix(value, encryptedPrefix)
+	if !ok {
+		return value, nil
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return "", ErrDecryptionFailed
+	}
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, additionalData(characterID))
+	if err != nil {
+		return "", ErrDecryptionFailed
+	}
+	return string(plaintext), nil
+}
+
+func additionalData(characterID int64) []byte {
+	return []byte(strconv.FormatInt(characterID, 10))
+}
@@ -0,0 +1,306 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRefreshMargin = time.Minute
+)
+
+var (
+	ErrInvalidGrant = errors.New("invalid grant")
+	ErrTokenInvalid = errors.New("token invalid")
+)
+
+// Grant is the result of logging in, or of refreshing a token.
+type Grant struct {
+	Owner int64
+	// The corporation of the character, or 0 if unknown. Used to select tokens for corporation routes.
+	CorporationID int64
+	Scopes        []string
+	AccessToken   string
+	RefreshToken  string
+	ExpiresAt     time.Time
+}
+
+// Refresher exchanges a refresh token for a new grant, e.g. sso.Client.
+// It returns an error matching ErrInvalidGrant if the refresh token was rejected.
+type Refresher interface {
+	RefreshGrant(ctx context.Context, refreshToken string) (*Grant, error)
+}
+
+type managerConfig struct {
+	refreshMargin time.Duration
+	revoker       Revoker
+	revokeHooks   []RevokeHook
+}
+
+type ManagerOption func(*managerConfig)
+
+func NewManagerConfig(opts ...ManagerOption) *managerConfig {
+	c := &managerConfig{
+		refreshMargin: DefaultRefreshMargin,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithRefreshMargin sets how long before it expires a token is refreshed.
+func WithRefreshMargin(margin time.Duration) ManagerOption {
+	return func(c *managerConfig) {
+		c.refreshMargin = margin
+	}
+}
+
+// WithRevoker sets how refresh tokens are revoked. Defaults to the refresher, if it is a Revoker.
+func WithRevoker(revoker Revoker) ManagerOption {
+	return func(c *managerConfig) {
+		c.revoker = revoker
+	}
+}
+
+// WithRevokeHooks adds hooks that are called after every revocation, see Manager.OnRevoke.
+func WithRevokeHooks(hooks ...RevokeHook) ManagerOption {
+	return func(c *managerConfig) {
+		c.revokeHooks = append(c.revokeHooks, hooks...)
+	}
+}
+
+// Manager keeps the tokens of many characters in a TokenStore, and refreshes them when they are used.
+type Manager struct {
+	store         TokenStore
+	refresher     Refresher
+	refreshMargin time.Duration
+	revoker       Revoker
+
+	revokeHooks []RevokeHook
+	hooksMu     sync.RWMutex
+
+	// Refreshes are serialized per character, the SSO rotates refresh tokens on every refresh.
+	locks   map[int64]*sync.Mutex
+	locksMu sync.Mutex
+}
+
+func NewManager(store TokenStore, refresher Refresher, opts ...ManagerOption) *Manager {
+	config := NewManagerConfig(opts...)
+
+	revoker := config.revoker
+	if revoker == nil {
+		revoker, _ = refresher.(Revoker)
+	}
+
+	return &Manager{
+		store:         store,
+		refresher:     refresher,
+		refreshMargin: config.refreshMargin,
+		revoker:       revoker,
+		revokeHooks:   config.revokeHooks,
+		locks:         make(map[int64]*sync.Mutex),
+	}
+}
+
+func (m *Manager) lock(characterID int64) func() {
+	m.locksMu.Lock()
+	mu, ok := m.locks[characterID]
+	if !ok {
+		mu = &sync.Mutex{}
+		m.locks[characterID] = mu
+	}
+	m.locksMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// Add stores the grant of a character that logged in, replacing its previous token.
+func (m *Manager) Add(ctx context.Context, grant *Grant) error {
+	unlock := m.lock(grant.Owner)
+	defer unlock()
+
+	return m.store.Put(ctx, &StoredToken{
+		CharacterID:   grant.Owner,
+		CorporationID: grant.CorporationID,
+		Scopes:        slices.Clone(grant.Scopes),
+		RefreshToken:  grant.RefreshToken,
+		AccessToken:   grant.AccessToken,
+		ExpiresAt:     grant.ExpiresAt,
+		UpdatedAt:     time.Now(),
+	})
+}
+
+// Remove deletes the token of a character, without revoking it. See Revoke.
+func (m *Manager) Remove(ctx context.Context, characterID int64) error {
+	unlock := m.lock(characterID)
+	defer unlock()
+
+	return m.store.Delete(ctx, characterID)
+}
+
+// List returns all stored tokens, including invalid ones.
+func (m *Manager) List(ctx context.Context) ([]*StoredToken, error) {
+	return m.store.List(ctx)
+}
+
+// TokenFor returns the token of a character, if it is valid and has all required scopes.
+// The token is refreshed through the manager when used for a request.
+func (m *Manager) TokenFor(ctx context.Context, characterID int64, requiredScopes []string) (RefreshableToken, error) {
+	stored, err := m.store.Get(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if stored.Invalid {
+		return nil, fmt.Errorf("%w: character %d", ErrTokenInvalid, characterID)
+	}
+	if !hasAllScopes(stored.Scopes, requiredScopes) {
+		return nil, fmt.Errorf("%w: character %d is missing %v", ErrMissingScopes, characterID, requiredScopes)
+	}
+	return &managedToken{manager: m, current: stored}, nil
+}
+
+// ProvideToken implements TokenProvider. Character routes use the token of that character,
+// corporation routes use the first valid token of a character in that corporation that satisfies the route's security.
+func (m *Manager) ProvideToken(ctx context.Context, req TokenRequest) (Token, error) {
+	if req.CharacterID != 0 {
+		stored, err := m.store.Get(ctx, req.CharacterID)
+		if err != nil {
+			return nil, err
+		}
+		if stored.Invalid {
+			return nil, fmt.Errorf("%w: character %d", ErrTokenInvalid, req.CharacterID)
+		}
+		if !SatisfiesSecurity(stored.Scopes, req.Security) {
+			return nil, fmt.Errorf("%w: character %d does not have %v", ErrMissingScopes, req.CharacterID, req.Security)
+		}
+		return &managedToken{manager: m, current: stored}, nil
+	}
+	if req.CorporationID == 0 {
+		return nil, fmt.Errorf("%w: %s has no character or corporation", ErrTokenNotFound, req.Route)
+	}
+
+	tokens, err := m.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, stored := range tokens {
+		if stored.CorporationID != req.CorporationID || stored.Invalid || !SatisfiesSecurity(stored.Scopes, req.Security) {
+			continue
+		}
+		return &managedToken{manager: m, current: stored}, nil
+	}
+	return nil, fmt.Errorf("%w: no character in corporation %d", ErrTokenNotFound, req.CorporationID)
+}
+
+// refresh refreshes the token of a character if it expires within the refresh margin.
+// The stored token is read again while holding the lock, it may have been refreshed in the meantime.
+func (m *Manager) refresh(ctx context.Context, characterID int64) (*StoredToken, error) {
+	unlock := m.lock(characterID)
+	defer unlock()
+
+	stored, err := m.store.Get(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if stored.Invalid {
+		return nil, fmt.Errorf("%w: character %d", ErrTokenInvalid, characterID)
+	}
+	if time.Until(stored.ExpiresAt) > m.refreshMargin {
+		return stored, nil
+	}
+
+	grant, err := m.refresher.RefreshGrant(ctx, stored.RefreshToken)
+	if errors.Is(err, ErrInvalidGrant) {
+		// The refresh token was revoked or expired, the character has to log in again.
+		stored.Invalid = true
+		stored.UpdatedAt = time.Now()
+		if putErr := m.store.Put(ctx, stored); putErr != nil {
+			return nil, errors.Join(err, putErr)
+		}
+		return nil, errors.Join(fmt.Errorf("%w: character %d", ErrTokenInvalid, characterID), err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stored.AccessToken = grant.AccessToken
+	if grant.RefreshToken != "" {
+		stored.RefreshToken = grant.RefreshToken
+	}
+	stored.ExpiresAt = grant.ExpiresAt
+	if grant.Scopes != nil {
+		stored.Scopes = slices.Clone(grant.Scopes)
+	}
+	stored.UpdatedAt = time.Now()
+
+	if err := m.store.Put(ctx, stored); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+var (
+	_ RefreshableToken = (*managedToken)(nil)
+	_ ScopedToken      = (*managedToken)(nil)
+)
+
+// managedToken is a token handed out by a Manager.
+type managedToken struct {
+	manager *Manager
+
+	mu      sync.RWMutex
+	current *StoredToken
+}
+
+func (t *managedToken) Owner() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.current.CharacterID
+}
+
+func (t *managedToken) Token() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.current.AccessToken
+}
+
+func (t *managedToken) Scopes() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.current.Scopes
+}
+
+func (t *managedToken) RefreshIfNeeded(ctx context.Context) error {
+	t.mu.RLock()
+	current := t.current
+	t.mu.RUnlock()
+
+	if time.Until(current.ExpiresAt) > t.manager.refreshMargin {
+		return nil
+	}
+
+	refreshed, err := t.manager.refresh(ctx, current.CharacterID)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	t.current = refreshed
+	t.mu.Unlock()
+	return nil
+}
+
+func hasAllScopes(scopes []string, requiredScopes []string) bool {
+	for _, scope := range requiredScopes {
+		if !slices.Contains(scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
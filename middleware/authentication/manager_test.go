@@ -0,0 +1,323 @@
+package authentication_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+// fakeRefresher rotates the refresh token on every refresh, and rejects refresh tokens it did not issue last.
+type fakeRefresher struct {
+	refreshes atomic.Int64
+
+	mu     sync.Mutex
+	issued map[string]bool
+}
+
+func newFakeRefresher(refreshTokens ...string) *fakeRefresher {
+	r := &fakeRefresher{issued: make(map[string]bool)}
+	for _, token := range refreshTokens {
+		r.issued[token] = true
+	}
+	return r
+}
+
+func (r *fakeRefresher) RefreshGrant(ctx context.Context, refreshToken string) (*authentication.Grant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.issued[refreshToken] {
+		return nil, fmt.Errorf("token endpoint: %w", authentication.ErrInvalidGrant)
+	}
+	delete(r.issued, refreshToken)
+
+	n := r.refreshes.Add(1)
+	next := fmt.Sprintf("refresh-%d", n+1)
+	r.issued[next] = true
+
+	return &authentication.Grant{
+		Owner:        1,
+		Scopes:       []string{"esi-location.read_location.v1"},
+		AccessToken:  fmt.Sprintf("access-%d", n+1),
+		RefreshToken: next,
+		ExpiresAt:    time.Now().Add(20 * time.Minute),
+	}, nil
+}
+
+// RevokeToken makes the refresh token unusable, and rejects refresh tokens it did not issue.
+func (r *fakeRefresher) RevokeToken(ctx context.Context, refreshToken string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.issued[refreshToken] {
+		return fmt.Errorf("revoke endpoint: unknown token %q", refreshToken)
+	}
+	delete(r.issued, refreshToken)
+	return nil
+}
+
+func expiredGrant(owner int64, refreshToken string) *authentication.Grant {
+	return &authentication.Grant{
+		Owner:        owner,
+		Scopes:       []string{"esi-location.read_location.v1"},
+		AccessToken:  "access-1",
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Now().Add(-time.Minute),
+	}
+}
+
+func TestManager_serializesRefreshes(t *testing.T) {
+	t.Parallel()
+
+	store := authentication.NewMemoryStore()
+	refresher := newFakeRefresher("refresh-1")
+	manager := authentication.NewManager(store, refresher)
+
+	if err := manager.Add(t.Context(), expiredGrant(1, "refresh-1")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every request holds its own token, but they share the refresh of the character.
+	var wg sync.WaitGroup
+	for range 10 {
+		token, err := manager.TokenFor(t.Context(), 1, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Go(func() {
+			if err := token.RefreshIfNeeded(t.Context()); err != nil {
+				t.Errorf("failed to refresh: %v", err)
+			}
+			if token.Token() != "access-2" {
+				t.Errorf("expected the refreshed access token, got %q", token.Token())
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := refresher.refreshes.Load(); got != 1 {
+		t.Fatalf("expected a single refresh, got %d", got)
+	}
+	stored, err := store.Get(t.Context(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.RefreshToken != "refresh-2" {
+		t.Fatalf("expected the rotated refresh token to be stored, got %q", stored.RefreshToken)
+	}
+}
+
+func TestManager_invalidGrant(t *testing.T) {
+	t.Parallel()
+
+	store := authentication.NewMemoryStore()
+	manager := authentication.NewManager(store, newFakeRefresher())
+
+	if err := manager.Add(t.Context(), expiredGrant(1, "revoked")); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := manager.TokenFor(t.Context(), 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = token.RefreshIfNeeded(t.Context())
+	if !errors.Is(err, authentication.ErrTokenInvalid) || !errors.Is(err, authentication.ErrInvalidGrant) {
+		t.Fatalf("expected ErrTokenInvalid and ErrInvalidGrant, got %v", err)
+	}
+
+	stored, err := store.Get(t.Context(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stored.Invalid {
+		t.Fatal("expected the token to be marked invalid")
+	}
+	if _, err := manager.TokenFor(t.Context(), 1, nil); !errors.Is(err, authentication.ErrTokenInvalid) {
+		t.Fatalf("expected ErrTokenInvalid, got %v", err)
+	}
+
+	// Logging in again replaces the invalid token.
+	if err := manager.Add(t.Context(), expiredGrant(1, "refresh-1")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manager.TokenFor(t.Context(), 1, nil); err != nil {
+		t.Fatalf("expected a valid token after logging in again, got %v", err)
+	}
+}
+
+func TestManager_TokenFor(t *testing.T) {
+	t.Parallel()
+
+	manager := authentication.NewManager(authentication.NewMemoryStore(), newFakeRefresher())
+	if err := manager.Add(t.Context(), &authentication.Grant{
+		Owner:        1,
+		Scopes:       []string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"},
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(20 * time.Minute),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name           string
+		characterID    int64
+		requiredScopes []string
+		expectedError  error
+	}{
+		{name: "no scopes required", characterID: 1},
+		{name: "has scopes", characterID: 1, requiredScopes: []string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"}},
+		{name: "missing scope", characterID: 1, requiredScopes: []string{"esi-wallet.read_character_wallet.v1"}, expectedError: authentication.ErrMissingScopes},
+		{name: "unknown character", characterID: 2, expectedError: authentication.ErrTokenNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := manager.TokenFor(t.Context(), tc.characterID, tc.requiredScopes)
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("expected %v, got %v", tc.expectedError, err)
+			}
+			if err != nil {
+				return
+			}
+			if token.Owner() != tc.characterID || token.Token() != "access" {
+				t.Fatalf("unexpected token for %d: %q", token.Owner(), token.Token())
+			}
+			// The token is still valid, so it is not refreshed.
+			if err := token.RefreshIfNeeded(t.Context()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestManager_Revoke(t *testing.T) {
+	t.Parallel()
+
+	store := authentication.NewMemoryStore()
+	refresher := newFakeRefresher("refresh-1")
+
+	var events []authentication.RevokeEvent
+	hookErr := errors.New("hook failed")
+	manager := authentication.NewManager(store, refresher, authentication.WithRevokeHooks(
+		func(ctx context.Context, event authentication.RevokeEvent) error {
+			events = append(events, event)
+			return nil
+		},
+	))
+	// Hooks added later are called as well, and their errors are returned.
+	manager.OnRevoke(func(ctx context.Context, event authentication.RevokeEvent) error {
+		return hookErr
+	})
+
+	for _, grant := range []*authentication.Grant{
+		{Owner: 1, CorporationID: 100, Scopes: []string{"esi-location.read_location.v1"}, RefreshToken: "refresh-1"},
+		{Owner: 2, RefreshToken: "unknown"},
+	} {
+		if err := manager.Add(t.Context(), grant); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := manager.Revoke(t.Context(), 1); !errors.Is(err, hookErr) {
+		t.Fatalf("expected the hook error, got %v", err)
+	}
+	if refresher.issued["refresh-1"] {
+		t.Fatal("expected the refresh token to be revoked")
+	}
+	if _, err := store.Get(t.Context(), 1); !errors.Is(err, authentication.ErrTokenNotFound) {
+		t.Fatalf("expected the token to be deleted, got %v", err)
+	}
+
+	// The token is forgotten even if the revoke endpoint fails.
+	if err := manager.Revoke(t.Context(), 2); err == nil || errors.Is(err, authentication.ErrTokenNotFound) {
+		t.Fatalf("expected the revoke error, got %v", err)
+	}
+	if _, err := store.Get(t.Context(), 2); !errors.Is(err, authentication.ErrTokenNotFound) {
+		t.Fatalf("expected the token to be deleted, got %v", err)
+	}
+
+	if err := manager.Revoke(t.Context(), 1); !errors.Is(err, authentication.ErrTokenNotFound) {
+		t.Fatalf("expected ErrTokenNotFound, got %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	if events[0].CharacterID != 1 || events[0].CorporationID != 100 || events[0].Err != nil || events[0].RevokedAt.IsZero() {
+		t.Fatalf("unexpected event: %+v", events[0])
+	}
+	if diff := cmp.Diff([]string{"esi-location.read_location.v1"}, events[0].Scopes); diff != "" {
+		t.Fatalf("scopes mismatch (-want +got): %s", diff)
+	}
+	if events[1].CharacterID != 2 || events[1].Err == nil {
+		t.Fatalf("expected the revoke error in the event: %+v", events[1])
+	}
+}
+
+func TestEncryptedStore(t *testing.T) {
+	t.Parallel()
+
+	backing := authentication.NewMemoryStore()
+	store, err := authentication.NewEncryptedStore(backing, []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := &authentication.StoredToken{
+		CharacterID:  1,
+		Scopes:       []string{"esi-location.read_location.v1"},
+		RefreshToken: "refresh",
+		AccessToken:  "access",
+	}
+	if err := store.Put(t.Context(), token); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := backing.Get(t.Context(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw.RefreshToken == "refresh" || raw.AccessToken == "access" {
+		t.Fatalf("expected tokens to be encrypted at rest, got %+v", raw)
+	}
+
+	decrypted, err := store.Get(t.Context(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted.RefreshToken != "refresh" || decrypted.AccessToken != "access" {
+		t.Fatalf("expected decrypted tokens, got %+v", decrypted)
+	}
+
+	// Encrypted tokens are bound to their character.
+	raw.CharacterID = 2
+	if err := backing.Put(t.Context(), raw); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(t.Context(), 2); !errors.Is(err, authentication.ErrDecryptionFailed) {
+		t.Fatalf("expected ErrDecryptionFailed, got %v", err)
+	}
+
+	// Tokens stored before enabling encryption are still readable.
+	if err := backing.Put(t.Context(), &authentication.StoredToken{CharacterID: 3, RefreshToken: "plain"}); err != nil {
+		t.Fatal(err)
+	}
+	plain, err := store.Get(t.Context(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain.RefreshToken != "plain" {
+		t.Fatalf("expected the plain refresh token, got %q", plain.RefreshToken)
+	}
+}
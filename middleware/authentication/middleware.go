@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"slices"
 
 	"github.com/xaroth/lib-esi-go/middleware"
 	"github.com/xaroth/lib-esi-go/request"
@@ -25,6 +24,18 @@ func Middleware(next http.RoundTripper) http.RoundTripper {
 		hasToken := false
 		var hasScopes []string
 
+		if _, ok := GetToken(ctx); !ok {
+			// Let the token provider select a token, before any network traffic happens.
+			token, err := provideToken(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if token != nil {
+				ctx = WithToken(token)(ctx)
+				req = req.WithContext(ctx)
+			}
+		}
+
 		if token, ok := GetToken(ctx); ok {
 			hasToken = true
 
@@ -42,14 +53,14 @@ func Middleware(next http.RoundTripper) http.RoundTripper {
 			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.Token()))
 		}
 
-		requiredScopes := request.GetRequiredScope(ctx)
-		if len(requiredScopes) > 0 {
+		security := request.GetSecurityRequirements(ctx)
+		if len(security) > 0 {
 			if !hasToken {
 				return nil, errors.Join(ErrMissingAuthentication, ErrMissingToken)
 			}
 
 			if hasScopes != nil {
-				if !hasAnyScope(hasScopes, requiredScopes) {
+				if !SatisfiesSecurity(hasScopes, security) {
 					return nil, errors.Join(ErrMissingAuthentication, ErrMissingScopes)
 				}
 			}
@@ -59,9 +70,14 @@ func Middleware(next http.RoundTripper) http.RoundTripper {
 	})
 }
 
-func hasAnyScope(scopes []string, requiredScopes []string) bool {
-	for _, scope := range requiredScopes {
-		if slices.Contains(scopes, scope) {
+// SatisfiesSecurity reports whether the scopes of a token meet any one of the security requirements of a route,
+// as returned by request.GetSecurityRequirements. A route without requirements is always satisfied.
+func SatisfiesSecurity(scopes []string, security [][]string) bool {
+	if len(security) == 0 {
+		return true
+	}
+	for _, requiredScopes := range security {
+		if hasAllScopes(scopes, requiredScopes) {
 			return true
 		}
 	}
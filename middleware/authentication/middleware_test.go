@@ -40,8 +40,10 @@ func TestMiddleware(t *testing.T) {
 		name           string
 		token          authentication.Token
 		requiredScopes []string
-		expectation    func(tb testing.TB, req *http.Request)
-		expectedError  error
+		// Alternative security requirement, any one requirement grants access.
+		alternativeScopes []string
+		expectation       func(tb testing.TB, req *http.Request)
+		expectedError     error
 	}{
 		{
 			name:  "success: token is present",
@@ -93,9 +95,16 @@ func TestMiddleware(t *testing.T) {
 			requiredScopes: []string{"scope1"},
 		},
 		{
-			name:           "success: token is scoped and at least one scope is present",
+			name:           "error: token is scoped and only one of the scopes is present",
 			token:          scoped,
 			requiredScopes: []string{"scope2", "scope3"},
+			expectedError:  authentication.ErrMissingScopes,
+		},
+		{
+			name:              "success: token is scoped and an alternative requirement is met",
+			token:             scoped,
+			requiredScopes:    []string{"scope2", "scope3"},
+			alternativeScopes: []string{"scope1"},
 		},
 		{
 			name:           "error: missing scopes but token is present",
@@ -113,6 +122,7 @@ func TestMiddleware(t *testing.T) {
 				http.MethodGet,
 				"/",
 				request.WithRequiredScope(testCase.requiredScopes...),
+				request.WithRequiredScope(testCase.alternativeScopes...),
 			)
 
 			ctx := request.BaseContext[any](t.Context(), reqInfo, "test", nil)
@@ -0,0 +1,108 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+// TokenRequest describes the token an authenticated request needs.
+type TokenRequest struct {
+	// The route of the request, as returned by request.GetRoute.
+	Route string
+
+	// Every scope named by the security requirements of the route.
+	RequiredScopes []string
+
+	// The security requirements of the route; the token has to satisfy any one of them, see SatisfiesSecurity.
+	Security [][]string
+
+	// The character_id path parameter of the request, or 0 if the route has none.
+	CharacterID int64
+
+	// The corporation_id path parameter of the request, or 0 if the route has none.
+	CorporationID int64
+}
+
+// TokenProvider selects the token for a request that requires scopes, but was not given a token.
+// It returns an error if no token qualifies.
+type TokenProvider interface {
+	ProvideToken(ctx context.Context, req TokenRequest) (Token, error)
+}
+
+type requestTokenProviderCtx struct{}
+
+// WithTokenProvider lets the authentication middleware select a token for the request, if none was given.
+func WithTokenProvider(provider TokenProvider) request.RequestOption {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, requestTokenProviderCtx{}, provider)
+	}
+}
+
+// GetTokenProvider extracts the TokenProvider from the context.
+func GetTokenProvider(ctx context.Context) (TokenProvider, bool) {
+	provider, ok := ctx.Value(requestTokenProviderCtx{}).(TokenProvider)
+	return provider, ok
+}
+
+// ProviderMiddleware sets the token provider for every request that does not have one.
+// It has to run before Middleware, see transport.WithTokenProvider.
+func ProviderMiddleware(provider TokenProvider) middleware.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return middleware.MiddlewareFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			if _, ok := GetTokenProvider(ctx); !ok {
+				req = req.WithContext(WithTokenProvider(provider)(ctx))
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// provideToken asks the token provider in the context for a token, if the request requires scopes.
+// Returns nil if no token is needed, or no provider is set.
+func provideToken(ctx context.Context) (Token, error) {
+	provider, ok := GetTokenProvider(ctx)
+	if !ok {
+		return nil, nil
+	}
+	security := request.GetSecurityRequirements(ctx)
+	if len(security) == 0 {
+		return nil, nil
+	}
+	route, _ := request.GetRoute(ctx)
+
+	token, err := provider.ProvideToken(ctx, TokenRequest{
+		Route:          route,
+		RequiredScopes: request.GetRequiredScope(ctx),
+		Security:       security,
+		CharacterID:    pathID(ctx, "character_id"),
+		CorporationID:  pathID(ctx, "corporation_id"),
+	})
+	if err != nil {
+		return nil, errors.Join(ErrMissingAuthentication, ErrMissingScopes, err)
+	}
+	if token == nil {
+		return nil, errors.Join(ErrMissingAuthentication, ErrMissingScopes)
+	}
+	return token, nil
+}
+
+// pathID returns an integer path parameter, such as character.Identifier, or 0 if it is not set.
+func pathID(ctx context.Context, name string) int64 {
+	value, ok := request.GetPathParameter(ctx, name)
+	if !ok {
+		return 0
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,175 @@
+package authentication_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/middleware/authentication/mock"
+	"github.com/xaroth/lib-esi-go/request"
+	"go.uber.org/mock/gomock"
+)
+
+type characterIdentifier int64
+
+type characterInput struct {
+	CharacterID characterIdentifier `path:"character_id"`
+	Page        int                 `query:"page"`
+}
+
+type corporationInput struct {
+	CorporationID int64 `path:"corporation_id"`
+}
+
+// recordingProvider records the requests it was asked for and delegates to the manager.
+type recordingProvider struct {
+	manager  *authentication.Manager
+	requests []authentication.TokenRequest
+}
+
+func (p *recordingProvider) ProvideToken(ctx context.Context, req authentication.TokenRequest) (authentication.Token, error) {
+	p.requests = append(p.requests, req)
+	return p.manager.ProvideToken(ctx, req)
+}
+
+func TestProviderMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const scope = "esi-wallet.read_character_wallet.v1"
+
+	manager := authentication.NewManager(authentication.NewMemoryStore(), newFakeRefresher())
+	for _, grant := range []*authentication.Grant{
+		{Owner: 1, CorporationID: 100, Scopes: []string{scope}, AccessToken: "access-1"},
+		{Owner: 2, CorporationID: 200, Scopes: []string{"esi-skills.read_skills.v1"}, AccessToken: "access-2"},
+		{Owner: 3, CorporationID: 200, Scopes: []string{scope}, AccessToken: "access-3"},
+	} {
+		grant.RefreshToken = "refresh"
+		grant.ExpiresAt = time.Now().Add(20 * time.Minute)
+		if err := manager.Add(t.Context(), grant); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		input            any
+		requiredScopes   []string
+		expectedRequests []authentication.TokenRequest
+		expectedHeader   string
+		expectedError    error
+	}{
+		{
+			name:           "success: character path parameter",
+			input:          &characterInput{CharacterID: 1},
+			requiredScopes: []string{scope},
+			expectedRequests: []authentication.TokenRequest{
+				{Route: "GET /test", RequiredScopes: []string{scope}, Security: [][]string{{scope}}, CharacterID: 1},
+			},
+			expectedHeader: "Bearer access-1",
+		},
+		{
+			name:           "success: corporation path parameter",
+			input:          &corporationInput{CorporationID: 200},
+			requiredScopes: []string{scope},
+			expectedRequests: []authentication.TokenRequest{
+				{Route: "GET /test", RequiredScopes: []string{scope}, Security: [][]string{{scope}}, CorporationID: 200},
+			},
+			expectedHeader: "Bearer access-3",
+		},
+		{
+			name:  "success: no scopes required",
+			input: &characterInput{CharacterID: 1},
+		},
+		{
+			name:           "error: character is missing scopes",
+			input:          &characterInput{CharacterID: 2},
+			requiredScopes: []string{scope},
+			expectedRequests: []authentication.TokenRequest{
+				{Route: "GET /test", RequiredScopes: []string{scope}, Security: [][]string{{scope}}, CharacterID: 2},
+			},
+			expectedError: authentication.ErrMissingScopes,
+		},
+		{
+			name:           "error: no owner in path",
+			requiredScopes: []string{scope},
+			expectedRequests: []authentication.TokenRequest{
+				{Route: "GET /test", RequiredScopes: []string{scope}, Security: [][]string{{scope}}},
+			},
+			expectedError: authentication.ErrMissingScopes,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &recordingProvider{manager: manager}
+
+			reqInfo := request.FakeRequestInfo(http.MethodGet, "/test", request.WithRequiredScope(testCase.requiredScopes...))
+			ctx := request.BaseContext(t.Context(), reqInfo, "test", testCase.input)
+			req, err := http.NewRequestWithContext(ctx, reqInfo.Method, reqInfo.Path, nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			called := false
+			next := middleware.NewFakeMiddleware(t, func(tb testing.TB, req *http.Request) {
+				tb.Helper()
+				called = true
+
+				if got := req.Header.Get("Authorization"); got != testCase.expectedHeader {
+					tb.Fatalf("expected authorization header %q, got %q", testCase.expectedHeader, got)
+				}
+				if _, ok := authentication.GetToken(req.Context()); ok != (testCase.expectedHeader != "") {
+					tb.Fatalf("expected token in context: %v", !ok)
+				}
+			})
+
+			chain := authentication.ProviderMiddleware(provider)(authentication.Middleware(next))
+			_, err = chain.RoundTrip(req)
+			if !errors.Is(err, testCase.expectedError) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedError, err)
+			}
+			if called != (testCase.expectedError == nil) {
+				t.Fatalf("expected next to be called: %v", !called)
+			}
+			if diff := cmp.Diff(testCase.expectedRequests, provider.requests); diff != "" {
+				t.Fatalf("unexpected token requests (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderMiddleware_explicitToken(t *testing.T) {
+	t.Parallel()
+
+	provider := &recordingProvider{manager: authentication.NewManager(authentication.NewMemoryStore(), newFakeRefresher())}
+
+	reqInfo := request.FakeRequestInfo(http.MethodGet, "/test", request.WithRequiredScope("scope"))
+	ctx := request.BaseContext(t.Context(), reqInfo, "test", &characterInput{CharacterID: 1})
+	token := mock.NewMockToken(gomock.NewController(t))
+	token.EXPECT().Token().Return("explicit").AnyTimes()
+	ctx = authentication.WithToken(token)(ctx)
+	req, err := http.NewRequestWithContext(ctx, reqInfo.Method, reqInfo.Path, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	next := middleware.NewFakeMiddleware(t, func(tb testing.TB, req *http.Request) {
+		tb.Helper()
+		if got := req.Header.Get("Authorization"); got != "Bearer explicit" {
+			tb.Fatalf("expected the explicit token, got %q", got)
+		}
+	})
+	if _, err := authentication.ProviderMiddleware(provider)(authentication.Middleware(next)).RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(provider.requests) != 0 {
+		t.Fatalf("expected the provider not to be asked, got %v", provider.requests)
+	}
+}
@@ -0,0 +1,72 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Revoker revokes a refresh token at the SSO, e.g. sso.Client.
+type Revoker interface {
+	RevokeToken(ctx context.Context, refreshToken string) error
+}
+
+// RevokeEvent describes a token that was revoked and forgotten by the Manager.
+type RevokeEvent struct {
+	CharacterID   int64
+	CorporationID int64
+	Scopes        []string
+	RevokedAt     time.Time
+
+	// The error returned by the revoke endpoint, if any. The token is forgotten either way.
+	Err error
+}
+
+// RevokeHook is called after a token was revoked, e.g. to purge cached responses of the character or to write an audit log.
+// Errors are returned from Manager.Revoke, but do not stop other hooks from running.
+type RevokeHook func(ctx context.Context, event RevokeEvent) error
+
+// OnRevoke adds a hook that is called after every revocation.
+func (m *Manager) OnRevoke(hook RevokeHook) {
+	m.hooksMu.Lock()
+	defer m.hooksMu.Unlock()
+
+	m.revokeHooks = append(m.revokeHooks, hook)
+}
+
+// Revoke revokes the refresh token of a character at the SSO, deletes it from the store, and calls the revoke hooks.
+// The token is forgotten even if the SSO could not be reached; that error is returned along with any errors of the hooks.
+func (m *Manager) Revoke(ctx context.Context, characterID int64) error {
+	unlock := m.lock(characterID)
+	defer unlock()
+
+	stored, err := m.store.Get(ctx, characterID)
+	if err != nil {
+		return err
+	}
+
+	event := RevokeEvent{
+		CharacterID:   stored.CharacterID,
+		CorporationID: stored.CorporationID,
+		Scopes:        slices.Clone(stored.Scopes),
+	}
+	// A refresh token that was rejected before is already unusable.
+	if m.revoker != nil && !stored.Invalid && stored.RefreshToken != "" {
+		event.Err = m.revoker.RevokeToken(ctx, stored.RefreshToken)
+	}
+	if err := m.store.Delete(ctx, characterID); err != nil {
+		return errors.Join(event.Err, err)
+	}
+	event.RevokedAt = time.Now()
+
+	m.hooksMu.RLock()
+	hooks := slices.Clone(m.revokeHooks)
+	m.hooksMu.RUnlock()
+
+	errs := []error{event.Err}
+	for _, hook := range hooks {
+		errs = append(errs, hook(ctx, event))
+	}
+	return errors.Join(errs...)
+}
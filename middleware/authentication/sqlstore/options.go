@@ -0,0 +1,56 @@
+package sqlstore
+
+import "database/sql"
+
+type config struct {
+	db        *sql.DB
+	driver    string
+	dsn       string
+	tableName string
+}
+
+type Option func(*config)
+
+func NewConfig(opts ...Option) *config {
+	c := &config{
+		driver:    DefaultDriver,
+		tableName: DefaultTableName,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithDB uses an existing database handle; the driver and dsn options are ignored.
+func WithDB(db *sql.DB) Option {
+	return func(c *config) {
+		c.db = db
+	}
+}
+
+func WithDriver(driver string) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+func WithDsn(dsn string) Option {
+	return func(c *config) {
+		c.dsn = dsn
+	}
+}
+
+func WithPath(path string) Option {
+	return WithDsn(path)
+}
+
+func WithMemoryStore() Option {
+	return WithDsn(":memory:")
+}
+
+func WithTableName(tableName string) Option {
+	return func(c *config) {
+		c.tableName = tableName
+	}
+}
@@ -0,0 +1,164 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+const (
+	DefaultDriver    = "sqlite"
+	DefaultTableName = "tokens"
+)
+
+// sqlStore keeps tokens in a database/sql database.
+type sqlStore struct {
+	db     *sql.DB
+	ownsDB bool
+	table  string
+}
+
+// New creates a TokenStore backed by a database/sql database, creating its table if needed.
+func New(opts ...Option) (authentication.TokenStore, error) {
+	config := NewConfig(opts...)
+
+	db := config.db
+	ownsDB := db == nil
+	if ownsDB {
+		var err error
+		db, err = sql.Open(config.driver, config.dsn)
+		if err != nil {
+			return nil, err
+		}
+		if config.dsn == ":memory:" {
+			// Every connection to an in-memory database gets its own database.
+			db.SetMaxOpenConns(1)
+		}
+	}
+
+	s := &sqlStore{
+		db:     db,
+		ownsDB: ownsDB,
+		table:  config.tableName,
+	}
+	if err := s.initialize(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *sqlStore) initialize() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS ` + s.table + ` (
+			character_id INTEGER PRIMARY KEY,
+			corporation_id INTEGER NOT NULL,
+			scopes TEXT NOT NULL,
+			refresh_token TEXT NOT NULL,
+			access_token TEXT NOT NULL,
+			expires_at INTEGER NOT NULL,
+			invalid INTEGER NOT NULL,
+			updated_at INTEGER NOT NULL
+		)
+	`)
+	return err
+}
+
+const columns = `character_id, corporation_id, scopes, refresh_token, access_token, expires_at, invalid, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanToken(row scanner) (*authentication.StoredToken, error) {
+	var (
+		token                authentication.StoredToken
+		scopes               string
+		expiresAt, updatedAt int64
+	)
+	if err := row.Scan(&token.CharacterID, &token.CorporationID, &scopes, &token.RefreshToken, &token.AccessToken, &expiresAt, &token.Invalid, &updatedAt); err != nil {
+		return nil, err
+	}
+	token.Scopes = strings.Fields(scopes)
+	token.ExpiresAt = unixTime(expiresAt)
+	token.UpdatedAt = unixTime(updatedAt)
+	return &token, nil
+}
+
+// unixNano converts a time to a stored timestamp, keeping the zero time as zero.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// unixTime converts a stored timestamp to a time, keeping zero as the zero time.
+func unixTime(nanoseconds int64) time.Time {
+	if nanoseconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanoseconds)
+}
+
+func (s *sqlStore) Get(ctx context.Context, characterID int64) (*authentication.StoredToken, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+columns+` FROM `+s.table+` WHERE character_id = ?`, characterID)
+	token, err := scanToken(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, authentication.ErrTokenNotFound
+	}
+	return token, err
+}
+
+func (s *sqlStore) List(ctx context.Context) ([]*authentication.StoredToken, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+columns+` FROM `+s.table+` ORDER BY character_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*authentication.StoredToken, 0)
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
+
+// Put replaces the token in a single statement, so a rotated refresh token is stored atomically.
+func (s *sqlStore) Put(ctx context.Context, token *authentication.StoredToken) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO `+s.table+` (`+columns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (character_id) DO UPDATE SET
+			corporation_id = excluded.corporation_id,
+			scopes = excluded.scopes,
+			refresh_token = excluded.refresh_token,
+			access_token = excluded.access_token,
+			expires_at = excluded.expires_at,
+			invalid = excluded.invalid,
+			updated_at = excluded.updated_at
+	`,
+		token.CharacterID, token.CorporationID, strings.Join(token.Scopes, " "), token.RefreshToken, token.AccessToken,
+		unixNano(token.ExpiresAt), token.Invalid, unixNano(token.UpdatedAt),
+	)
+	return err
+}
+
+func (s *sqlStore) Delete(ctx context.Context, characterID int64) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM `+s.table+` WHERE character_id = ?`, characterID)
+	return err
+}
+
+// Close closes the underlying database, unless it was provided using WithDB.
+func (s *sqlStore) Close() error {
+	if !s.ownsDB {
+		return nil
+	}
+	return s.db.Close()
+}
@@ -0,0 +1,74 @@
+package sqlstore_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	// This enables the sqlite driver for the token store.
+	_ "github.com/glebarez/go-sqlite"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/middleware/authentication/sqlstore"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "tokens.sqlite")
+	store, err := sqlstore.New(sqlstore.WithPath(path))
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	if _, err := store.Get(t.Context(), 1); !errors.Is(err, authentication.ErrTokenNotFound) {
+		t.Fatalf("expected ErrTokenNotFound, got %v", err)
+	}
+
+	expiresAt := time.Unix(1_800_000_000, 0)
+	tokens := []*authentication.StoredToken{
+		{CharacterID: 2, Scopes: []string{}, RefreshToken: "refresh-2", Invalid: true},
+		{CharacterID: 1, CorporationID: 98000001, Scopes: []string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"}, RefreshToken: "refresh-1", AccessToken: "access-1", ExpiresAt: expiresAt, UpdatedAt: expiresAt},
+	}
+	for _, token := range tokens {
+		if err := store.Put(t.Context(), token); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Tokens are kept when the store is opened again.
+	store, err = sqlstore.New(sqlstore.WithPath(path))
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	listed, err := store.List(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*authentication.StoredToken{tokens[1], tokens[0]}, listed); diff != "" {
+		t.Fatalf("tokens mismatch (-want +got): %s", diff)
+	}
+
+	// Put replaces the token of the character.
+	rotated := *tokens[1]
+	rotated.RefreshToken = "refresh-3"
+	if err := store.Put(t.Context(), &rotated); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Get(t.Context(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&rotated, got); diff != "" {
+		t.Fatalf("token mismatch (-want +got): %s", diff)
+	}
+
+	if err := store.Delete(t.Context(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(t.Context(), 1); !errors.Is(err, authentication.ErrTokenNotFound) {
+		t.Fatalf("expected ErrTokenNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package authentication
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+)
+
+// StoredToken is a character's token, as kept in a TokenStore.
+type StoredToken struct {
+	CharacterID int64
+	// The corporation of the character, or 0 if unknown.
+	CorporationID int64
+	Scopes        []string
+	RefreshToken  string
+	AccessToken   string
+	ExpiresAt     time.Time
+
+	// Set once the SSO rejected the refresh token, the character has to log in again.
+	Invalid bool
+
+	UpdatedAt time.Time
+}
+
+// TokenStore persists the tokens of a Manager.
+// Put must replace the stored token in a single atomic write, so a rotated refresh token is never lost.
+type TokenStore interface {
+	// Get returns the token of a character, or ErrTokenNotFound.
+	Get(ctx context.Context, characterID int64) (*StoredToken, error)
+
+	// List returns all stored tokens.
+	List(ctx context.Context) ([]*StoredToken, error)
+
+	// Put stores the token, replacing any existing token of the character.
+	Put(ctx context.Context, token *StoredToken) error
+
+	// Delete removes the token of a character.
+	Delete(ctx context.Context, characterID int64) error
+}
+
+type memoryStore struct {
+	tokens map[int64]StoredToken
+	mu     sync.RWMutex
+}
+
+// NewMemoryStore creates a TokenStore that keeps tokens in memory.
+func NewMemoryStore() TokenStore {
+	return &memoryStore{tokens: make(map[int64]StoredToken)}
+}
+
+func (s *memoryStore) Get(ctx context.Context, characterID int64) (*StoredToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.tokens[characterID]
+	if !ok {
+		return nil, ErrTokenNotFound
+	}
+	return cloneToken(token), nil
+}
+
+func (s *memoryStore) List(ctx context.Context) ([]*StoredToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tokens := make([]*StoredToken, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokens = append(tokens, cloneToken(token))
+	}
+	slices.SortFunc(tokens, func(a, b *StoredToken) int {
+		return cmp.Compare(a.CharacterID, b.CharacterID)
+	})
+	return tokens, nil
+}
+
+func (s *memoryStore) Put(ctx context.Context, token *StoredToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[token.CharacterID] = *cloneToken(*token)
+	return nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, characterID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, characterID)
+	return nil
+}
+
+func cloneToken(token StoredToken) *StoredToken {
+	token.Scopes = slices.Clone(token.Scopes)
+	return &token
+}
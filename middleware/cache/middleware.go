@@ -2,12 +2,73 @@ package cache
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
 
 	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
 
 	"github.com/bartventer/httpcache"
+	"github.com/bartventer/httpcache/pkg/urlkey"
 )
 
+var nextCacheID atomic.Int64
+
+// Cache is a response cache whose entries can be purged while its middleware is in use.
+type Cache struct {
+	storage *storage
+	id      string
+}
+
+// Open opens the cache at path, like Middleware. The cache is closed with Close.
+func Open(path string, opts ...Option) (*Cache, error) {
+	s, err := NewStorage(append([]Option{WithPath(path)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cache{
+		storage: s,
+		id:      strconv.FormatInt(nextCacheID.Add(1), 10),
+	}
+	sharedStorages.Store(c.id, s)
+	return c, nil
+}
+
+// Middleware caches responses in the cache, and records the owner of the token of every authenticated request,
+// so the responses of a character can be purged with RemoveOwner.
+func (c *Cache) Middleware() middleware.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		cached := httpcache.NewTransport(
+			CacheDriverName+"://?shared="+url.QueryEscape(c.id),
+			httpcache.WithUpstream(next),
+		)
+		return middleware.MiddlewareFunc(func(req *http.Request) (*http.Response, error) {
+			if token, ok := authentication.GetToken(req.Context()); ok {
+				if err := c.storage.SetOwner(urlkey.Normalize(req.URL), token.Owner()); err != nil {
+					return nil, err
+				}
+			}
+			return cached.RoundTrip(req)
+		})
+	}
+}
+
+// RemoveOwner deletes the cached responses of every URL that was requested with a token of the character.
+// Returns the number of deleted entries.
+func (c *Cache) RemoveOwner(owner int64) (int, error) {
+	return c.storage.RemoveOwner(owner)
+}
+
+// Close closes the cache. Its middleware can no longer be used afterwards.
+func (c *Cache) Close() error {
+	sharedStorages.Delete(c.id)
+	return c.storage.Close()
+}
+
+// Middleware caches responses in the cache at path.
+// Use Open instead to purge the responses of a character, e.g. when their token is revoked.
 func Middleware(path string) middleware.Middleware {
 	return func(next http.RoundTripper) http.RoundTripper {
 		return httpcache.NewTransport(
@@ -0,0 +1,15 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+// RevokeHook deletes the cached responses of characters whose token was revoked, see authentication.Manager.OnRevoke.
+func RevokeHook(c *Cache) authentication.RevokeHook {
+	return func(ctx context.Context, event authentication.RevokeEvent) error {
+		_, err := c.RemoveOwner(event.CharacterID)
+		return err
+	}
+}
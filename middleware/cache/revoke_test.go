@@ -0,0 +1,91 @@
+package cache_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/middleware/cache"
+)
+
+type ownerToken int64
+
+func (t ownerToken) Owner() int64 {
+	return int64(t)
+}
+
+func (t ownerToken) Token() string {
+	return "token"
+}
+
+func TestRevokeHook(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	responseCache, err := cache.Open(filepath.Join(t.TempDir(), "cache.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer responseCache.Close()
+	transport := responseCache.Middleware()(http.DefaultTransport)
+
+	// The owner of each path's token, 0 for requests without a token.
+	owners := map[string]int64{
+		"/characters/1/skills/":     1,
+		"/corporations/99/wallets/": 1,
+		"/characters/12/skills/":    12,
+		"/corporations/98/wallets/": 12,
+		"/universe/types/1/":        0,
+	}
+
+	status := func(path string) string {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		if owner := owners[path]; owner != 0 {
+			req = req.WithContext(authentication.WithToken(ownerToken(owner))(req.Context()))
+		}
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("failed to round trip request: %v", err)
+		}
+		defer resp.Body.Close()
+		return resp.Header.Get("X-Httpcache-Status")
+	}
+
+	paths := []string{"/characters/1/skills/", "/corporations/99/wallets/", "/characters/12/skills/", "/corporations/98/wallets/", "/universe/types/1/"}
+	for _, path := range paths {
+		if got := status(path); got != "MISS" {
+			t.Fatalf("expected %s to be a cache miss, got %q", path, got)
+		}
+	}
+
+	hook := cache.RevokeHook(responseCache)
+	if err := hook(t.Context(), authentication.RevokeEvent{CharacterID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"/characters/1/skills/":     "MISS",
+		"/corporations/99/wallets/": "MISS",
+		"/characters/12/skills/":    "HIT",
+		"/corporations/98/wallets/": "HIT",
+		"/universe/types/1/":        "HIT",
+	}
+	for _, path := range paths {
+		if got := status(path); got != expected[path] {
+			t.Fatalf("expected %s to be %q after revoking, got %q", path, expected[path], got)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package cache
 
 import (
 	"database/sql"
+	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/bartventer/httpcache/store"
 	"github.com/bartventer/httpcache/store/driver"
@@ -27,6 +29,9 @@ const (
 	DefaultEnableCompression = true
 )
 
+// sharedStorages holds the storage of every open Cache, by the id used in its DSN.
+var sharedStorages sync.Map
+
 func noopEncode(value []byte) []byte {
 	return value
 }
@@ -37,6 +42,14 @@ func noopDecode(value []byte) ([]byte, error) {
 
 func init() {
 	store.Register(CacheDriverName, driver.DriverFunc(func(u *url.URL) (driver.Conn, error) {
+		if id := u.Query().Get("shared"); id != "" {
+			// The storage of a Cache, shared between its middleware and the Cache itself.
+			if s, ok := sharedStorages.Load(id); ok {
+				return s.(*storage), nil
+			}
+			return nil, fmt.Errorf("unknown shared cache storage %q", id)
+		}
+
 		opts := make([]Option, 0)
 
 		switch {
@@ -99,9 +112,25 @@ func (s *storage) initialize() error {
 			value BLOB
 		)
 	`)
+	if err != nil {
+		return err
+	}
+
+	// The characters whose token was used to request a URL, so their responses can be purged.
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS ` + s.ownersTable() + ` (
+			key TEXT NOT NULL,
+			owner INTEGER NOT NULL,
+			PRIMARY KEY (key, owner)
+		)
+	`)
 	return err
 }
 
+func (s *storage) ownersTable() string {
+	return s.tableName + "_owners"
+}
+
 func (s *storage) Get(key string) ([]byte, error) {
 	row := s.db.QueryRow(`SELECT value FROM `+s.tableName+` WHERE key = ?`, key)
 	var value []byte
@@ -141,6 +170,49 @@ func (s *storage) Keys(prefix string) ([]string, error) {
 	return keys, nil
 }
 
+// SetOwner records that the responses of the URL key were requested with the token of the owner.
+func (s *storage) SetOwner(urlKey string, owner int64) error {
+	_, err := s.db.Exec(`INSERT OR IGNORE INTO `+s.ownersTable()+` (key, owner) VALUES (?, ?)`, urlKey, owner)
+	return err
+}
+
+// RemoveOwner deletes the cached responses of every URL requested with the token of the owner,
+// including all their variants. Returns the number of deleted entries.
+func (s *storage) RemoveOwner(owner int64) (int, error) {
+	rows, err := s.db.Query(`SELECT key FROM `+s.ownersTable()+` WHERE owner = ?`, owner)
+	if err != nil {
+		return 0, err
+	}
+	urlKeys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		urlKeys = append(urlKeys, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, urlKey := range urlKeys {
+		// The URL key holds the references to its variants, which are stored as "<url key>#<vary hash>".
+		result, err := s.db.Exec(`DELETE FROM `+s.tableName+` WHERE key = ? OR substr(key, 1, ?) = ?`, urlKey, len(urlKey)+1, urlKey+"#")
+		if err != nil {
+			return removed, err
+		}
+		if count, err := result.RowsAffected(); err == nil {
+			removed += int(count)
+		}
+	}
+
+	_, err = s.db.Exec(`DELETE FROM `+s.ownersTable()+` WHERE owner = ?`, owner)
+	return removed, err
+}
+
 func (s *storage) Close() error {
 	return s.db.Close()
 }
@@ -0,0 +1,365 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+const (
+	PagesHeader = "X-Pages"
+
+	DefaultWarmDelay        = 5 * time.Second
+	DefaultFallbackInterval = 5 * time.Minute
+	DefaultRetryInterval    = time.Minute
+	DefaultWarmConcurrency  = 1
+)
+
+var (
+	ErrNoPageField = errors.New("input has no page query parameter")
+	ErrWarmStatus  = errors.New("unexpected status")
+)
+
+type warmFunc func(ctx context.Context, sender request.RequestSender, page int32) (*http.Response, error)
+
+// WarmTarget is a single request, or paginated route, that a Warmer keeps fresh in the cache.
+type WarmTarget struct {
+	Name string
+
+	fetch     warmFunc
+	paginated bool
+
+	// err is set when the target cannot be fetched, e.g. a paginated route without a page field. Run returns it.
+	err error
+}
+
+// Warm creates a target for a generated request function and its input.
+func Warm[TInput any, TOutput any](name string, fn request.RequestFunc[TInput, TOutput], input *TInput, opts ...request.RequestOption) WarmTarget {
+	return WarmTarget{
+		Name: name,
+		fetch: func(ctx context.Context, sender request.RequestSender, _ int32) (*http.Response, error) {
+			resp, err := fn(ctx, sender, input, opts...)
+			if resp == nil {
+				return nil, err
+			}
+			return resp.Response, err
+		},
+	}
+}
+
+// WarmStatic creates a target for a generated request function without input.
+func WarmStatic[TOutput any](name string, fn request.StaticFunc[TOutput], opts ...request.RequestOption) WarmTarget {
+	return WarmTarget{
+		Name: name,
+		fetch: func(ctx context.Context, sender request.RequestSender, _ int32) (*http.Response, error) {
+			resp, err := fn(ctx, sender, opts...)
+			if resp == nil {
+				return nil, err
+			}
+			return resp.Response, err
+		},
+	}
+}
+
+// WarmPages creates a target for every page of a paginated route.
+// The first page is fetched to learn the page count from the X-Pages header, after which the remaining pages are fetched.
+//
+// If the input does not have a `query:"page"` field, Warmer.Run returns an error matching ErrNoPageField.
+func WarmPages[TInput any, TOutput any](name string, fn request.RequestFunc[TInput, TOutput], input *TInput, opts ...request.RequestOption) WarmTarget {
+	field, err := pageField[TInput]()
+	if err != nil {
+		return WarmTarget{Name: name, err: fmt.Errorf("warm %s: %w", name, err)}
+	}
+
+	return WarmTarget{
+		Name:      name,
+		paginated: true,
+		fetch: func(ctx context.Context, sender request.RequestSender, page int32) (*http.Response, error) {
+			paged := new(TInput)
+			if input != nil {
+				*paged = *input
+			}
+			setPage(reflect.ValueOf(paged).Elem().Field(field), page)
+
+			resp, err := fn(ctx, sender, paged, opts...)
+			if resp == nil {
+				return nil, err
+			}
+			return resp.Response, err
+		},
+	}
+}
+
+func pageField[TInput any]() (int, error) {
+	typ := reflect.TypeOf(new(TInput)).Elem()
+	if typ.Kind() != reflect.Struct {
+		return 0, ErrNoPageField
+	}
+
+	for i := range typ.NumField() {
+		if tag, ok := typ.Field(i).Tag.Lookup("query"); ok && tag == "page" {
+			kind := typ.Field(i).Type.Kind()
+			if kind == reflect.Pointer {
+				kind = typ.Field(i).Type.Elem().Kind()
+			}
+			if kind != reflect.Int32 && kind != reflect.Int64 && kind != reflect.Int {
+				return 0, fmt.Errorf("%w: unsupported type %s", ErrNoPageField, typ.Field(i).Type)
+			}
+			return i, nil
+		}
+	}
+	return 0, ErrNoPageField
+}
+
+func setPage(field reflect.Value, page int32) {
+	if field.Kind() == reflect.Pointer {
+		value := reflect.New(field.Type().Elem())
+		value.Elem().SetInt(int64(page))
+		field.Set(value)
+		return
+	}
+	field.SetInt(int64(page))
+}
+
+// WarmProgress is reported every time a target has been fetched.
+type WarmProgress struct {
+	// The name of the target
+	Target string
+
+	// The number of pages fetched, 1 for targets that are not paginated
+	Pages int
+
+	// The expiry time reported by the response, zero if the response did not include one
+	Expires time.Time
+
+	// The time the target will be fetched again
+	Next time.Time
+
+	// The error that occurred while fetching, if any
+	Err error
+}
+
+type WarmerOption func(*Warmer)
+
+// WithWarmDelay sets how long after a response expires the target is fetched again.
+func WithWarmDelay(delay time.Duration) WarmerOption {
+	return func(w *Warmer) {
+		w.delay = delay
+	}
+}
+
+// WithFallbackInterval sets the refresh interval for responses without an Expires header.
+func WithFallbackInterval(interval time.Duration) WarmerOption {
+	return func(w *Warmer) {
+		w.fallbackInterval = interval
+	}
+}
+
+// WithRetryInterval sets how long to wait before retrying a target that failed.
+func WithRetryInterval(interval time.Duration) WarmerOption {
+	return func(w *Warmer) {
+		w.retryInterval = interval
+	}
+}
+
+// WithWarmConcurrency sets the maximum number of targets that are fetched at the same time.
+func WithWarmConcurrency(concurrency int) WarmerOption {
+	return func(w *Warmer) {
+		w.concurrency = max(1, concurrency)
+	}
+}
+
+// WithProgress sets a callback that is called every time a target has been fetched.
+func WithProgress(progress func(WarmProgress)) WarmerOption {
+	return func(w *Warmer) {
+		w.progress = progress
+	}
+}
+
+type warmEntry struct {
+	target  WarmTarget
+	next    time.Time
+	running bool
+}
+
+// Warmer pre-fetches requests through the regular transport chain, so their responses are cached before they are needed.
+// Each target is fetched again shortly after its response expires.
+//
+// Requests are sent through the given sender; when its transport includes the rate limiting middleware,
+// the warmer is throttled like any other request.
+type Warmer struct {
+	sender  request.RequestSender
+	entries []*warmEntry
+
+	delay            time.Duration
+	fallbackInterval time.Duration
+	retryInterval    time.Duration
+	concurrency      int
+	progress         func(WarmProgress)
+
+	mu sync.Mutex
+}
+
+func NewWarmer(sender request.RequestSender, targets []WarmTarget, opts ...WarmerOption) *Warmer {
+	if sender == nil {
+		panic("no request sender provided")
+	}
+
+	w := &Warmer{
+		sender:           sender,
+		entries:          make([]*warmEntry, 0, len(targets)),
+		delay:            DefaultWarmDelay,
+		fallbackInterval: DefaultFallbackInterval,
+		retryInterval:    DefaultRetryInterval,
+		concurrency:      DefaultWarmConcurrency,
+	}
+	for _, target := range targets {
+		w.entries = append(w.entries, &warmEntry{target: target})
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Run fetches all targets, and keeps them fresh until the context is cancelled.
+// Run returns once all in-flight requests have finished, or right away if a target cannot be fetched at all.
+func (w *Warmer) Run(ctx context.Context) error {
+	for _, entry := range w.entries {
+		if entry.target.err != nil {
+			return entry.target.err
+		}
+	}
+
+	done := make(chan *warmEntry, len(w.entries))
+	inflight := 0
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		next, ok := w.dispatch(ctx, done, &inflight)
+		if ok {
+			timer.Reset(time.Until(next))
+		}
+
+		select {
+		case <-ctx.Done():
+			for ; inflight > 0; inflight-- {
+				w.finish(<-done)
+			}
+			return ctx.Err()
+		case entry := <-done:
+			inflight--
+			w.finish(entry)
+		case <-timer.C:
+		}
+	}
+}
+
+// dispatch starts fetching every due target, up to the configured concurrency,
+// and returns the time the next target becomes due.
+func (w *Warmer) dispatch(ctx context.Context, done chan<- *warmEntry, inflight *int) (time.Time, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	var next time.Time
+	found := false
+
+	for _, entry := range w.entries {
+		if entry.running {
+			continue
+		}
+		if !entry.next.After(now) {
+			if *inflight >= w.concurrency {
+				continue
+			}
+			entry.running = true
+			*inflight++
+
+			go func() {
+				w.warm(ctx, entry)
+				done <- entry
+			}()
+			continue
+		}
+		if !found || entry.next.Before(next) {
+			next = entry.next
+			found = true
+		}
+	}
+	return next, found
+}
+
+func (w *Warmer) finish(entry *warmEntry) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	entry.running = false
+}
+
+func (w *Warmer) warm(ctx context.Context, entry *warmEntry) {
+	pages, expires, err := w.fetch(ctx, entry.target)
+
+	now := time.Now()
+	next := now.Add(w.fallbackInterval)
+	switch {
+	case err != nil:
+		next = now.Add(w.retryInterval)
+	case !expires.IsZero():
+		next = expires.Add(w.delay)
+		if next.Before(now) {
+			next = now.Add(w.delay)
+		}
+	}
+
+	w.mu.Lock()
+	entry.next = next
+	w.mu.Unlock()
+
+	if w.progress != nil && ctx.Err() == nil {
+		w.progress(WarmProgress{
+			Target:  entry.target.Name,
+			Pages:   pages,
+			Expires: expires,
+			Next:    next,
+			Err:     err,
+		})
+	}
+}
+
+// fetch requests every page of a target, and returns the number of pages fetched and the earliest expiry.
+func (w *Warmer) fetch(ctx context.Context, target WarmTarget) (int, time.Time, error) {
+	var expires time.Time
+
+	pages := 1
+	for page := 1; page <= pages; page++ {
+		resp, err := target.fetch(ctx, w.sender, int32(page))
+		if err != nil {
+			return page - 1, expires, err
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			return page - 1, expires, fmt.Errorf("%w: %s", ErrWarmStatus, resp.Status)
+		}
+
+		if value, err := http.ParseTime(resp.Header.Get("Expires")); err == nil {
+			if expires.IsZero() || value.Before(expires) {
+				expires = value
+			}
+		}
+
+		if target.paginated && page == 1 {
+			if value, err := strconv.Atoi(resp.Header.Get(PagesHeader)); err == nil {
+				pages = value
+			}
+		}
+	}
+	return pages, expires, nil
+}
@@ -0,0 +1,183 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/middleware/cache"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+type pagedInput struct {
+	RegionId int64  `path:"region_id"`
+	Page     *int32 `query:"page"`
+}
+
+var (
+	getStatus = request.CreateStatic[struct{}](http.MethodGet, "/status")
+	getOrders = request.Create[pagedInput, []struct{}](http.MethodGet, "/markets/{region_id}/orders")
+)
+
+// newWarmerClient returns a client that sends every request to the given test server.
+func newWarmerClient(server *httptest.Server) *http.Client {
+	target, _ := url.Parse(server.URL)
+
+	return &http.Client{
+		Transport: middleware.MiddlewareFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+}
+
+func TestWarmer(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	requests := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.String()]++
+		mu.Unlock()
+
+		// Already expired, so the warmer fetches again after its delay.
+		w.Header().Set("Expires", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+		if r.URL.Path == "/markets/10000002/orders" {
+			w.Header().Set(cache.PagesHeader, "3")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("[]"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	progress := make(chan cache.WarmProgress, 16)
+	warmer := cache.NewWarmer(
+		newWarmerClient(server),
+		[]cache.WarmTarget{
+			cache.WarmStatic("status", getStatus),
+			cache.WarmPages("orders", getOrders, &pagedInput{RegionId: 10000002}),
+		},
+		cache.WithWarmDelay(10*time.Millisecond),
+		cache.WithWarmConcurrency(2),
+		cache.WithProgress(func(p cache.WarmProgress) {
+			select {
+			case progress <- p:
+			default:
+			}
+		}),
+	)
+
+	result := make(chan error, 1)
+	go func() {
+		result <- warmer.Run(ctx)
+	}()
+
+	seen := make(map[string]int)
+	for seen["status"] < 2 || seen["orders"] < 2 {
+		select {
+		case p := <-progress:
+			if p.Err != nil {
+				t.Fatalf("%s: unexpected error: %v", p.Target, p.Err)
+			}
+			if p.Target == "orders" && p.Pages != 3 {
+				t.Fatalf("expected 3 pages, got %d", p.Pages)
+			}
+			if p.Expires.IsZero() {
+				t.Fatalf("%s: expected expiry to be reported", p.Target)
+			}
+			seen[p.Target]++
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for progress, got %v", seen)
+		}
+	}
+
+	cancel()
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, path := range []string{
+		"/status",
+		"/markets/10000002/orders?page=1",
+		"/markets/10000002/orders?page=2",
+		"/markets/10000002/orders?page=3",
+	} {
+		if requests[path] < 2 {
+			t.Fatalf("expected %s to be fetched at least twice, got %d", path, requests[path])
+		}
+	}
+}
+
+func TestWarmer_retriesErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	progress := make(chan cache.WarmProgress, 1)
+	warmer := cache.NewWarmer(
+		newWarmerClient(server),
+		[]cache.WarmTarget{cache.WarmStatic("status", getStatus)},
+		cache.WithRetryInterval(time.Hour),
+		cache.WithProgress(func(p cache.WarmProgress) {
+			progress <- p
+		}),
+	)
+	go warmer.Run(ctx)
+
+	select {
+	case p := <-progress:
+		if !errors.Is(p.Err, cache.ErrWarmStatus) {
+			t.Fatalf("expected ErrWarmStatus, got %v", p.Err)
+		}
+		if until := time.Until(p.Next); until < 59*time.Minute {
+			t.Fatalf("expected retry after the retry interval, got %s", until)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for progress")
+	}
+}
+
+func TestWarmPages_requiresPageField(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		RegionId int64 `path:"region_id"`
+	}
+	fn := request.Create[input, []struct{}](http.MethodGet, "/markets/{region_id}/history")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	warmer := cache.NewWarmer(newWarmerClient(server), []cache.WarmTarget{
+		cache.WarmPages("history", fn, &input{RegionId: 1}),
+	})
+	if err := warmer.Run(t.Context()); !errors.Is(err, cache.ErrNoPageField) {
+		t.Fatalf("expected ErrNoPageField, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package ratelimiting
+
+import (
+	"context"
+
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+// Priority determines the order in which delayed requests are granted tokens.
+// Requests with a higher priority are always granted before requests with a lower priority.
+type Priority int
+
+const (
+	PriorityBackground Priority = iota - 1
+	PriorityNormal
+	PriorityInteractive
+)
+
+type requestPriorityCtx struct{}
+
+// WithPriority sets the priority of a request when it has to wait for the rate limit.
+// Requests without a priority use PriorityNormal.
+func WithPriority(priority Priority) request.RequestOption {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, requestPriorityCtx{}, priority)
+	}
+}
+
+// GetPriority extracts the Priority from the context, defaulting to PriorityNormal.
+func GetPriority(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(requestPriorityCtx{}).(Priority); ok {
+		return priority
+	}
+	return PriorityNormal
+}
@@ -26,3 +26,12 @@ func GetRequestBucket(req *http.Request) int64 {
 	// Request is shared across all applications from the same IP.
 	return int64(-1)
 }
+
+// GetRequestOwner returns the owner used to share a bucket fairly between requests.
+// This is the token owner for authenticated requests, even if the bucket is shared by the application.
+func GetRequestOwner(req *http.Request) int64 {
+	if token, ok := authentication.GetToken(req.Context()); ok {
+		return token.Owner()
+	}
+	return GetRequestBucket(req)
+}
@@ -3,60 +3,116 @@ package memory
 import (
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Bucket struct {
-	group         *Group
+	// The limits of the bucket, replaced as a whole when they change, see memoryRateLimiter.updateGroup.
+	group         *atomic.Pointer[Group]
 	currentTokens int
 	lastRequest   time.Time
+	blockedUntil  time.Time
 	mu            sync.RWMutex
+
+	// Requests waiting for tokens, granted by a single dispatcher goroutine.
+	queue       waitQueue
+	dispatching bool
+	wake        chan struct{}
+	queueMu     sync.Mutex
 }
 
 func NewBucket(group *Group, owner int64) *Bucket {
+	return newGroupBucket(fixedGroup(group))
+}
+
+// newGroupBucket returns a bucket that follows the limits of its group.
+func newGroupBucket(group *atomic.Pointer[Group]) *Bucket {
 	return &Bucket{
 		group:         group,
 		currentTokens: 0,
 		lastRequest:   time.Now(),
+		wake:          make(chan struct{}, 1),
 	}
 }
 
 func FakeBucket(group *Group, owner int64, currentTokens int, lastRequest time.Time) *Bucket {
 	return &Bucket{
-		group:         group,
+		group:         fixedGroup(group),
 		currentTokens: currentTokens,
 		lastRequest:   lastRequest,
+		wake:          make(chan struct{}, 1),
 	}
 }
 
 type bucketKey struct {
-	group *Group
+	group *atomic.Pointer[Group]
 	owner int64
 }
 
+func fixedGroup(group *Group) *atomic.Pointer[Group] {
+	p := new(atomic.Pointer[Group])
+	p.Store(group)
+	return p
+}
+
 // Calculate the amoount of tokens we should currently be on by accounting for the time since last request.
 func (b *Bucket) EffectiveTokens() int {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
+	group := b.group.Load()
 	now := time.Now()
 	elapsed := now.Sub(b.lastRequest).Seconds()
-	windowsElapsed := elapsed / b.group.WindowSize.Seconds()
-	tokensElapsed := float64(b.group.BucketSize) * windowsElapsed
+	windowsElapsed := elapsed / group.WindowSize.Seconds()
+	tokensElapsed := float64(group.BucketSize) * windowsElapsed
 
 	return int(math.Max(0, float64(b.currentTokens)-math.Floor(tokensElapsed)))
 }
 
 func (b *Bucket) CurrentUsage() float64 {
-	return float64(b.EffectiveTokens()) / float64(b.group.BucketSize)
+	return float64(b.EffectiveTokens()) / float64(b.group.Load().BucketSize)
 }
 
+// TimeUntil returns how long until the bucket is down to the given amount of tokens, and no longer blocked.
 func (b *Bucket) TimeUntil(tokens int) time.Duration {
+	blocked := max(0, time.Until(b.BlockedUntil()))
+
 	missing := b.EffectiveTokens() - tokens
 	if missing <= 0 {
-		return 0
+		return blocked
 	}
-	return b.group.TimePerToken() * time.Duration(missing)
+	return max(blocked, b.group.Load().TimePerToken()*time.Duration(missing))
+}
+
+// LastRequest returns the time tokens were last claimed or updated from a response.
+func (b *Bucket) LastRequest() time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastRequest
+}
+
+// Block prevents requests from being granted until the given time, e.g. after a rate limited response.
+// An earlier time than the current block is ignored.
+func (b *Bucket) Block(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// BlockedUntil returns the time until which the bucket is blocked, or the zero time if it never was.
+func (b *Bucket) BlockedUntil() time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.blockedUntil
+}
+
+// Blocked returns true if the bucket is currently blocked.
+func (b *Bucket) Blocked() bool {
+	return time.Now().Before(b.BlockedUntil())
 }
 
 func (b *Bucket) SetRemainingTokens(tokens int) int {
@@ -64,7 +120,7 @@ func (b *Bucket) SetRemainingTokens(tokens int) int {
 	defer b.mu.Unlock()
 
 	// Calculate how many tokens have been used in the window based on the remaining tokens.
-	b.currentTokens = b.group.BucketSize - tokens
+	b.currentTokens = b.group.Load().BucketSize - tokens
 	b.lastRequest = time.Now()
 
 	return b.currentTokens
@@ -78,3 +134,12 @@ func (b *Bucket) Claim(tokens int) int {
 
 	return b.currentTokens
 }
+
+// Refund gives back tokens claimed for a request that was cancelled before it was sent.
+func (b *Bucket) Refund(tokens int) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.currentTokens = max(0, b.currentTokens-tokens)
+
+	return b.currentTokens
+}
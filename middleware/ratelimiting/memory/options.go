@@ -0,0 +1,25 @@
+package memory
+
+import "github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+
+type config struct {
+	routeGroups []ratelimiting.RouteGroup
+}
+
+type Option func(*config)
+
+func NewConfig(opts ...Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithRouteGroups preloads the rate limit group of each route.
+// Requests to these routes are throttled from the first request, instead of after their first response.
+func WithRouteGroups(groups []ratelimiting.RouteGroup) Option {
+	return func(c *config) {
+		c.routeGroups = append(c.routeGroups, groups...)
+	}
+}
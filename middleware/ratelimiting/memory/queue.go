@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"slices"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+)
+
+// waiter is a request waiting for tokens in a bucket.
+// The ready channel is closed once tokens have been claimed on its behalf.
+type waiter struct {
+	priority ratelimiting.Priority
+	owner    int64
+	ready    chan struct{}
+}
+
+func newWaiter(priority ratelimiting.Priority, owner int64) *waiter {
+	return &waiter{
+		priority: priority,
+		owner:    owner,
+		ready:    make(chan struct{}),
+	}
+}
+
+// waitClass holds the waiters of a single priority, queued per owner.
+type waitClass struct {
+	owners map[int64][]*waiter
+
+	// Owners with waiters, in round-robin order.
+	order []int64
+}
+
+// waitQueue grants waiters in strict priority order, and round-robin across owners within a priority.
+type waitQueue struct {
+	classes map[ratelimiting.Priority]*waitClass
+	size    int
+}
+
+func (q *waitQueue) Len() int {
+	return q.size
+}
+
+func (q *waitQueue) Push(w *waiter) {
+	if q.classes == nil {
+		q.classes = make(map[ratelimiting.Priority]*waitClass)
+	}
+
+	class, ok := q.classes[w.priority]
+	if !ok {
+		class = &waitClass{owners: make(map[int64][]*waiter)}
+		q.classes[w.priority] = class
+	}
+
+	if len(class.owners[w.owner]) == 0 {
+		class.order = append(class.order, w.owner)
+	}
+	class.owners[w.owner] = append(class.owners[w.owner], w)
+	q.size++
+}
+
+// Pop removes and returns the next waiter, or nil if the queue is empty.
+func (q *waitQueue) Pop() *waiter {
+	var class *waitClass
+	var priority ratelimiting.Priority
+	for p, c := range q.classes {
+		if class == nil || p > priority {
+			class, priority = c, p
+		}
+	}
+	if class == nil {
+		return nil
+	}
+
+	owner := class.order[0]
+	waiters := class.owners[owner]
+	w := waiters[0]
+
+	class.order = class.order[1:]
+	if len(waiters) > 1 {
+		// The owner has more waiters, move it to the back of the line.
+		class.owners[owner] = waiters[1:]
+		class.order = append(class.order, owner)
+	} else {
+		delete(class.owners, owner)
+	}
+	if len(class.order) == 0 {
+		delete(q.classes, priority)
+	}
+
+	q.size--
+	return w
+}
+
+// Remove removes a waiter that is no longer waiting.
+// Returns false if the waiter was not queued, e.g. because it was already granted.
+func (q *waitQueue) Remove(w *waiter) bool {
+	class, ok := q.classes[w.priority]
+	if !ok {
+		return false
+	}
+
+	waiters := class.owners[w.owner]
+	index := slices.Index(waiters, w)
+	if index < 0 {
+		return false
+	}
+
+	waiters = slices.Delete(waiters, index, index+1)
+	if len(waiters) > 0 {
+		class.owners[w.owner] = waiters
+	} else {
+		delete(class.owners, w.owner)
+		class.order = slices.DeleteFunc(class.order, func(owner int64) bool {
+			return owner == w.owner
+		})
+	}
+	if len(class.order) == 0 {
+		delete(q.classes, w.priority)
+	}
+
+	q.size--
+	return true
+}
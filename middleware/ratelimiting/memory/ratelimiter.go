@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
@@ -21,10 +22,12 @@ type memoryRateLimiter struct {
 	targetPercentage          float64
 	estimatedTokensPerRequest int
 
-	groups  map[string]*Group
+	// The current limits of each group. Groups are never modified once stored, updates replace them,
+	// so buckets can read their group while the dispatcher is running.
+	groups  map[string]*atomic.Pointer[Group]
 	groupMu sync.Mutex
 
-	patternMap map[string]*Group
+	patternMap map[string]*atomic.Pointer[Group]
 	patternMu  sync.Mutex
 
 	buckets   map[bucketKey]*Bucket
@@ -38,43 +41,60 @@ type BucketInfo struct {
 	lastRequest     time.Time
 }
 
-func New() ratelimiting.RateLimiter {
-	return &memoryRateLimiter{
+func New(opts ...Option) ratelimiting.RateLimiter {
+	config := NewConfig(opts...)
+
+	r := &memoryRateLimiter{
 		targetPercentage:          ratelimiting.DefaultRateLimitTargetPercentage,
 		estimatedTokensPerRequest: ratelimiting.DefaultEstimatedTokensPerRequest,
 
-		groups:     make(map[string]*Group),
-		patternMap: make(map[string]*Group),
+		groups:     make(map[string]*atomic.Pointer[Group]),
+		patternMap: make(map[string]*atomic.Pointer[Group]),
 		buckets:    make(map[bucketKey]*Bucket),
 	}
+
+	for _, routeGroup := range config.routeGroups {
+		group := r.updateGroup(&Group{
+			Name:       routeGroup.Group,
+			BucketSize: routeGroup.BucketSize,
+			WindowSize: routeGroup.WindowSize,
+		})
+		r.setPathGroup(routeGroup.Route, group)
+	}
+
+	return r
 }
 
-func (r *memoryRateLimiter) getPathGroup(route string) *Group {
-	group, _ := r.patternMap[route]
-	return group
+func (r *memoryRateLimiter) getPathGroup(route string) *atomic.Pointer[Group] {
+	r.patternMu.Lock()
+	defer r.patternMu.Unlock()
+	return r.patternMap[route]
 }
 
-func (r *memoryRateLimiter) setPathGroup(route string, group *Group) {
+func (r *memoryRateLimiter) setPathGroup(route string, group *atomic.Pointer[Group]) {
 	r.patternMu.Lock()
 	defer r.patternMu.Unlock()
 	r.patternMap[route] = group
 }
 
-func (r *memoryRateLimiter) updateGroup(group *Group) *Group {
+// updateGroup stores the limits of a group, replacing the previous ones, and returns the group.
+// The group must not be modified afterwards.
+func (r *memoryRateLimiter) updateGroup(group *Group) *atomic.Pointer[Group] {
 	r.groupMu.Lock()
 	defer r.groupMu.Unlock()
 
-	if found, ok := r.groups[group.Name]; ok {
-		found.BucketSize = group.BucketSize
-		found.WindowSize = group.WindowSize
-		return found
+	found, ok := r.groups[group.Name]
+	if !ok {
+		found = new(atomic.Pointer[Group])
+		r.groups[group.Name] = found
 	}
-
-	r.groups[group.Name] = group
-	return group
+	if current := found.Load(); current == nil || current.BucketSize != group.BucketSize || current.WindowSize != group.WindowSize {
+		found.Store(group)
+	}
+	return found
 }
 
-func (r *memoryRateLimiter) getBucket(group *Group, owner int64) *Bucket {
+func (r *memoryRateLimiter) getBucket(group *atomic.Pointer[Group], owner int64) *Bucket {
 	if group == nil {
 		return nil
 	}
@@ -90,7 +110,7 @@ func (r *memoryRateLimiter) getBucket(group *Group, owner int64) *Bucket {
 
 	r.bucketsMu.Lock()
 	defer r.bucketsMu.Unlock()
-	bucket = NewBucket(group, owner)
+	bucket = newGroupBucket(group)
 	r.buckets[key] = bucket
 
 	return bucket
@@ -103,7 +123,7 @@ func (r *memoryRateLimiter) CleanupExpiredBuckets() {
 	r.bucketsMu.RLock()
 	for key, bucket := range r.buckets {
 		// If the bucket has not been used in the last two windows, we can safely assume it is back to 0 tokens.
-		if now.Sub(bucket.lastRequest) > (bucket.group.WindowSize * 2) {
+		if now.Sub(bucket.LastRequest()) > (bucket.group.Load().WindowSize*2) && !bucket.Blocked() {
 			keys = append(keys, key)
 		}
 	}
@@ -116,14 +136,82 @@ func (r *memoryRateLimiter) CleanupExpiredBuckets() {
 	}
 }
 
-func (r *memoryRateLimiter) delayRequest(ctx context.Context, bucket *Bucket) {
-	target := bucket.group.TargetSize(r.targetPercentage)
+// RemoveOwner removes all buckets of the owner. Requests already waiting on them are still granted.
+func (r *memoryRateLimiter) RemoveOwner(owner int64) error {
+	r.bucketsMu.Lock()
+	defer r.bucketsMu.Unlock()
+
+	for key := range r.buckets {
+		if key.owner == owner {
+			delete(r.buckets, key)
+		}
+	}
+	return nil
+}
+
+// delayRequest queues the request until the bucket is under the target usage again, and tokens have been
+// claimed on its behalf. Waiting requests are granted by priority, and round-robin across owners.
+// Returns the error of the context if it is cancelled first, without holding any tokens.
+func (r *memoryRateLimiter) delayRequest(ctx context.Context, bucket *Bucket, priority ratelimiting.Priority, owner int64) error {
+	w := newWaiter(priority, owner)
+
+	bucket.queueMu.Lock()
+	bucket.queue.Push(w)
+	if !bucket.dispatching {
+		bucket.dispatching = true
+		go r.dispatch(bucket)
+	}
+	bucket.queueMu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		// Context was cancelled, stop waiting.
+		bucket.queueMu.Lock()
+		granted := !bucket.queue.Remove(w)
+		bucket.queueMu.Unlock()
+		if granted {
+			// The dispatcher claimed tokens for the request just before it was cancelled.
+			r.refund(bucket)
+		}
+		return ctx.Err()
+	}
+}
+
+// refund gives back the tokens claimed for a request that is not sent, and lets the dispatcher grant them.
+func (r *memoryRateLimiter) refund(bucket *Bucket) {
+	bucket.Refund(r.estimatedTokensPerRequest)
+	select {
+	case bucket.wake <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch grants queued requests one at a time, whenever the bucket is under the target usage.
+// It runs until the queue is empty.
+func (r *memoryRateLimiter) dispatch(bucket *Bucket) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
 
 	for {
-		timeToWait := bucket.TimeUntil(target)
+		// Grant only while under the target, like requests that skip the queue in Schedule.
+		target := bucket.group.Load().TargetSize(r.targetPercentage)
+		timeToWait := bucket.TimeUntil(target - 1)
 		if timeToWait <= 0 {
-			// Done waiting.
-			break
+			bucket.queueMu.Lock()
+			w := bucket.queue.Pop()
+			if w == nil {
+				bucket.dispatching = false
+				bucket.queueMu.Unlock()
+				return
+			}
+
+			// Eagerly claim tokens for this request.
+			bucket.Claim(r.estimatedTokensPerRequest)
+			close(w.ready)
+			bucket.queueMu.Unlock()
+			continue
 		}
 
 		if timeToWait > maximumWaitTimePerAttempt {
@@ -135,43 +223,46 @@ func (r *memoryRateLimiter) delayRequest(ctx context.Context, bucket *Bucket) {
 			timeToWait = maximumWaitTimePerAttempt
 		}
 
+		timer.Reset(timeToWait)
 		select {
-		case <-ctx.Done():
-			// Context was cancelled, stop waiting.
-			return
-		case <-time.After(timeToWait):
-			// Try again to ensure another request hasn't been made in the meanwhile.
-			continue
+		case <-timer.C:
+		case <-bucket.wake:
+			// The bucket was updated from a response, recalculate the wait time.
 		}
 	}
-
-	// Eagerly claim tokens for this request.
-	bucket.Claim(r.estimatedTokensPerRequest)
 }
 
 func (r *memoryRateLimiter) processResponse(route string, owner int64, resp *http.Response) {
-	currentGroup := r.getPathGroup(route)
-
 	if resp == nil {
 		return
 	}
 
+	group := r.getPathGroup(route)
+
 	groupHeader := resp.Header.Get(ratelimiting.RateLimitGroupKey)
 	limitHeader := resp.Header.Get(ratelimiting.RateLimitLimitKey)
-	if groupHeader == "" || limitHeader == "" {
-		return
+	if newGroup, err := NewGroup(groupHeader, limitHeader); err == nil {
+		updated := r.updateGroup(newGroup)
+		if group != updated {
+			r.setPathGroup(route, updated)
+		}
+		group = updated
 	}
-
-	group, err := NewGroup(groupHeader, limitHeader)
-	if err != nil {
+	if group == nil {
+		// We don't know the group for this route yet, so there is no bucket to update.
 		return
 	}
-	group = r.updateGroup(group)
 
-	if currentGroup == nil {
-		r.setPathGroup(route, group)
-	} else if group.Name != currentGroup.Name {
-		r.setPathGroup(route, group)
+	bucket := r.getBucket(group, owner)
+	defer func() {
+		select {
+		case bucket.wake <- struct{}{}:
+		default:
+		}
+	}()
+
+	if until, blocked := ratelimiting.BlockedUntil(resp, time.Now()); blocked {
+		bucket.Block(until)
 	}
 
 	remainingHeader := resp.Header.Get(ratelimiting.RateLimitRemainingKey)
@@ -184,7 +275,6 @@ func (r *memoryRateLimiter) processResponse(route string, owner int64, resp *htt
 		return
 	}
 
-	bucket := r.getBucket(group, owner)
 	bucket.SetRemainingTokens(remaining)
 }
 
@@ -200,18 +290,33 @@ func (r *memoryRateLimiter) Schedule(req *http.Request) (func(*http.Response), e
 
 	group := r.getPathGroup(route)
 	owner := bucket.GetRequestBucket(req)
+	requestOwner := bucket.GetRequestOwner(req)
 
 	bucket := r.getBucket(group, owner)
 
 	if bucket != nil {
-		if bucket.CurrentUsage() >= r.targetPercentage {
-			// If we are over the target percentage, delay the request until we are under the target percentage.
-			r.delayRequest(ctx, bucket)
+		bucket.queueMu.Lock()
+		// Requests only skip the queue if nobody else is waiting.
+		mustWait := bucket.queue.Len() > 0 || bucket.CurrentUsage() >= r.targetPercentage || bucket.Blocked()
+		if !mustWait {
+			// Claim tokens right away, so a burst of requests can't overshoot the target before the first response.
+			bucket.Claim(r.estimatedTokensPerRequest)
+		}
+		bucket.queueMu.Unlock()
+
+		if mustWait {
+			// If we are over the target percentage or blocked, delay the request until we are under the target percentage.
+			if err := r.delayRequest(ctx, bucket, ratelimiting.GetPriority(ctx), requestOwner); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	// If the context is done, return early.
+	// If the context is done, return early, without keeping the tokens claimed for the request.
 	if err := ctx.Err(); err != nil {
+		if bucket != nil {
+			r.refund(bucket)
+		}
 		return nil, err
 	}
 
@@ -224,13 +329,24 @@ func (r *memoryRateLimiter) ListBuckets() []*ratelimiting.BucketStatistics {
 	r.bucketsMu.RLock()
 	defer r.bucketsMu.RUnlock()
 
-	info := make([]*ratelimiting.BucketStatistics, len(r.buckets))
+	now := time.Now()
+	info := make([]*ratelimiting.BucketStatistics, 0, len(r.buckets))
 	for key, bucket := range r.buckets {
+		// Blocks that have expired are reported as not blocked.
+		blockedUntil := bucket.BlockedUntil()
+		if !blockedUntil.After(now) {
+			blockedUntil = time.Time{}
+		}
+		bucket.queueMu.Lock()
+		waiting := bucket.queue.Len()
+		bucket.queueMu.Unlock()
 		info = append(info, &ratelimiting.BucketStatistics{
-			Group:           key.group.Name,
+			Group:           key.group.Load().Name,
 			Owner:           key.owner,
 			EffectiveTokens: bucket.EffectiveTokens(),
-			LastRequest:     bucket.lastRequest,
+			LastRequest:     bucket.LastRequest(),
+			BlockedUntil:    blockedUntil,
+			Waiting:         waiting,
 		})
 	}
 
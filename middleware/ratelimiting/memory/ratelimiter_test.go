@@ -0,0 +1,316 @@
+package memory_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting/memory"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+type staticToken int64
+
+func (t staticToken) Owner() int64  { return int64(t) }
+func (t staticToken) Token() string { return "token" }
+
+func newRequest(t *testing.T, ctx context.Context, opts ...request.RequestOption) *http.Request {
+	t.Helper()
+
+	ctx = request.WithRoute(ctx, http.MethodGet, "/universe/types/{type_id}")
+	for _, opt := range opts {
+		ctx = opt(ctx)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com/", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	return req
+}
+
+func rateLimitResponse(limit, remaining string) *http.Response {
+	header := make(http.Header)
+	header.Set(ratelimiting.RateLimitGroupKey, "universe")
+	header.Set(ratelimiting.RateLimitLimitKey, limit)
+	header.Set(ratelimiting.RateLimitRemainingKey, remaining)
+	return &http.Response{StatusCode: http.StatusOK, Header: header}
+}
+
+// waitForQueue waits until the given number of requests are queued across the buckets of the limiter.
+func waitForQueue(t *testing.T, limiter ratelimiting.RateLimiter, waiting int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		queued := 0
+		for _, bucket := range limiter.ListBuckets() {
+			queued += bucket.Waiting
+		}
+		if queued == waiting {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d queued requests, got %d", waiting, queued)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedule_priorityAndFairness(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New()
+
+	// Learn the group with an exhausted application bucket, so every following request has to wait.
+	done, err := limiter.Schedule(newRequest(t, t.Context(), authentication.WithToken(staticToken(1))))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("100/1s", "0"))
+
+	waiters := []struct {
+		name     string
+		owner    int64
+		priority ratelimiting.Priority
+	}{
+		{name: "background", owner: 1, priority: ratelimiting.PriorityBackground},
+		{name: "greedy 1", owner: 1, priority: ratelimiting.PriorityNormal},
+		{name: "greedy 2", owner: 1, priority: ratelimiting.PriorityNormal},
+		{name: "greedy 3", owner: 1, priority: ratelimiting.PriorityNormal},
+		{name: "other", owner: 2, priority: ratelimiting.PriorityNormal},
+		{name: "interactive", owner: 3, priority: ratelimiting.PriorityInteractive},
+	}
+
+	var mu sync.Mutex
+	var granted []string
+	var wg sync.WaitGroup
+	for i, w := range waiters {
+		req := newRequest(t, t.Context(),
+			authentication.WithToken(staticToken(w.owner)),
+			ratelimiting.WithPriority(w.priority),
+		)
+		wg.Go(func() {
+			if _, err := limiter.Schedule(req); err != nil {
+				t.Errorf("%s: failed to schedule: %v", w.name, err)
+				return
+			}
+			mu.Lock()
+			granted = append(granted, w.name)
+			mu.Unlock()
+		})
+		// Ensure the requests are queued in order.
+		waitForQueue(t, limiter, i+1)
+	}
+	wg.Wait()
+
+	expected := []string{"interactive", "greedy 1", "other", "greedy 2", "greedy 3", "background"}
+	if diff := cmp.Diff(expected, granted); diff != "" {
+		t.Fatalf("grant order mismatch (-want +got): %s", diff)
+	}
+}
+
+func TestSchedule_cancelledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New()
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("100/1000s", "0"))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.Schedule(newRequest(t, ctx)); err == nil {
+		t.Fatal("expected an error once the context is cancelled")
+	}
+
+	// A response for another route in the same group frees the bucket.
+	req, err := http.NewRequestWithContext(request.WithRoute(t.Context(), http.MethodGet, "/status"), http.MethodGet, "http://example.com/", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	done, err = limiter.Schedule(req)
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("100/1000s", "100"))
+
+	// The cancelled request no longer holds up the queue.
+	ctx, cancel = context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+	if _, err := limiter.Schedule(newRequest(t, ctx)); err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+}
+
+func TestSchedule_cancelledRefundsTokens(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New(memory.WithRouteGroups([]ratelimiting.RouteGroup{
+		{Route: "GET /universe/types/{type_id}", Group: "universe", BucketSize: 100, WindowSize: 1000 * time.Second},
+	}))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err := limiter.Schedule(newRequest(t, ctx)); err == nil {
+		t.Fatal("expected an error for a cancelled context")
+	}
+
+	// The request is not sent, so the tokens claimed for it are given back.
+	buckets := limiter.ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if buckets[0].EffectiveTokens != 0 {
+		t.Fatalf("expected no tokens to be used, got %d", buckets[0].EffectiveTokens)
+	}
+}
+
+func TestSchedule_blockedByRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New()
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	resp := rateLimitResponse("100/1s", "100")
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set(ratelimiting.RetryAfterKey, "60")
+	done(resp)
+
+	buckets := limiter.ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if until := time.Until(buckets[0].BlockedUntil); until < 59*time.Second || until > time.Minute {
+		t.Fatalf("expected the bucket to be blocked for a minute, got %s", until)
+	}
+
+	// The bucket has tokens available, but is blocked.
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Schedule(newRequest(t, ctx)); err == nil {
+		t.Fatal("expected the request to be blocked until the context is cancelled")
+	}
+}
+
+func TestListBuckets_expiredBlock(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New()
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	resp := rateLimitResponse("100/1s", "100")
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set(ratelimiting.RetryAfterKey, "0")
+	done(resp)
+
+	buckets := limiter.ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if !buckets[0].BlockedUntil.IsZero() {
+		t.Fatalf("expected an expired block to be reported as zero, got %s", buckets[0].BlockedUntil)
+	}
+}
+
+func TestSchedule_preloadedRouteGroups(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New(memory.WithRouteGroups([]ratelimiting.RouteGroup{
+		{Route: "GET /universe/types/{type_id}", Group: "universe", BucketSize: 100, WindowSize: 1000 * time.Second},
+	}))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	// The first burst is throttled, without waiting for a response to learn the group.
+	var scheduled atomic.Int64
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			if _, err := limiter.Schedule(newRequest(t, ctx)); err == nil {
+				scheduled.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+
+	// 75 tokens may be used before requests are delayed, at 5 tokens per request.
+	if got := scheduled.Load(); got != 15 {
+		t.Fatalf("expected 15 requests to be scheduled, got %d", got)
+	}
+}
+
+func TestRemoveOwner(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New()
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("100/1s", "90"))
+
+	if err := limiter.(ratelimiting.OwnerRemover).RemoveOwner(42); err != nil {
+		t.Fatal(err)
+	}
+	if buckets := limiter.ListBuckets(); len(buckets) != 1 {
+		t.Fatalf("expected the bucket of another owner to be kept, got %d buckets", len(buckets))
+	}
+
+	// Unauthenticated requests are bucketed to owner -1.
+	if err := limiter.(ratelimiting.OwnerRemover).RemoveOwner(-1); err != nil {
+		t.Fatal(err)
+	}
+	if buckets := limiter.ListBuckets(); len(buckets) != 0 {
+		t.Fatalf("expected the bucket to be removed, got %d buckets", len(buckets))
+	}
+}
+
+func TestSchedule_limitsChangeWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	limiter := memory.New()
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("100/1s", "0"))
+
+	// Responses change the limits of the group while the dispatcher reads them for waiting requests.
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			done, err := limiter.Schedule(newRequest(t, ctx))
+			if err != nil {
+				t.Errorf("failed to schedule: %v", err)
+				return
+			}
+			if i%2 == 0 {
+				done(rateLimitResponse("150/1s", "150"))
+			} else {
+				done(rateLimitResponse("100/1s", "100"))
+			}
+		})
+	}
+	wg.Wait()
+}
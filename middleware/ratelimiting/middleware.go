@@ -1,7 +1,9 @@
 package ratelimiting
 
 import (
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/xaroth/lib-esi-go/middleware"
 	"github.com/xaroth/lib-esi-go/request"
@@ -9,10 +11,11 @@ import (
 
 // Middleware automatically delays requests to ensure rate limits are respected.
 // This middleware is opt-in, and is not enabled by default.
-func Middleware(rateLimiter RateLimiter) middleware.Middleware {
+func Middleware(rateLimiter RateLimiter, opts ...Option) middleware.Middleware {
 	if rateLimiter == nil {
 		panic("no rate limiter backend provided")
 	}
+	config := NewConfig(opts...)
 
 	return func(next http.RoundTripper) http.RoundTripper {
 		return middleware.MiddlewareFunc(func(req *http.Request) (*http.Response, error) {
@@ -23,15 +26,65 @@ func Middleware(rateLimiter RateLimiter) middleware.Middleware {
 				return next.RoundTrip(req)
 			}
 
-			done, err := rateLimiter.Schedule(req)
+			resp, err := roundTrip(rateLimiter, next, req)
+			if err != nil || !config.replayBlocked {
+				return resp, err
+			}
+
+			until, blocked := BlockedUntil(resp, time.Now())
+			if !blocked {
+				return resp, nil
+			}
+
+			replay, err := replayRequest(req)
 			if err != nil {
-				return nil, err
+				// The request body cannot be replayed, return the rate limited response.
+				return resp, nil
 			}
 
-			resp, err := next.RoundTrip(req)
-			done(resp)
+			// Wait for the block to lift, the rate limiter delays the replay as well if it tracks blocks.
+			timer := time.NewTimer(time.Until(until))
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return resp, nil
+			case <-timer.C:
+			}
 
-			return resp, err
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+
+			return roundTrip(rateLimiter, next, replay)
 		})
 	}
 }
+
+func roundTrip(rateLimiter RateLimiter, next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	done, err := rateLimiter.Schedule(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next.RoundTrip(req)
+	done(resp)
+
+	return resp, err
+}
+
+// replayRequest clones the request with a fresh body, so it can be sent again.
+func replayRequest(req *http.Request) (*http.Request, error) {
+	replay := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return replay, nil
+	}
+	if req.GetBody == nil {
+		return nil, errNotReplayable
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	replay.Body = body
+	return replay, nil
+}
@@ -3,6 +3,7 @@ package ratelimiting_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/xaroth/lib-esi-go/middleware"
 	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
@@ -72,3 +73,95 @@ func TestMiddleware_nilLimiterPanics(t *testing.T) {
 	}()
 	_ = ratelimiting.Middleware(nil)
 }
+
+func TestBlockedUntil(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		expected   time.Time
+		blocked    bool
+	}{
+		{name: "ok", status: http.StatusOK, retryAfter: "10"},
+		{name: "too many requests", status: http.StatusTooManyRequests, retryAfter: "10", expected: now.Add(10 * time.Second), blocked: true},
+		{name: "error limited", status: ratelimiting.StatusErrorLimited, retryAfter: "30", expected: now.Add(30 * time.Second), blocked: true},
+		{name: "http date", status: http.StatusTooManyRequests, retryAfter: "Tue, 19 May 2026 12:01:00 GMT", expected: now.Add(time.Minute), blocked: true},
+		{name: "missing header", status: http.StatusTooManyRequests, expected: now.Add(ratelimiting.DefaultBlockDuration), blocked: true},
+		{name: "invalid header", status: http.StatusTooManyRequests, retryAfter: "soon", expected: now.Add(ratelimiting.DefaultBlockDuration), blocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{StatusCode: tt.status, Header: make(http.Header)}
+			if tt.retryAfter != "" {
+				resp.Header.Set(ratelimiting.RetryAfterKey, tt.retryAfter)
+			}
+
+			until, blocked := ratelimiting.BlockedUntil(resp, now)
+			if blocked != tt.blocked || !until.Equal(tt.expected) {
+				t.Fatalf("expected (%v, %v), got (%v, %v)", tt.expected, tt.blocked, until, blocked)
+			}
+		})
+	}
+}
+
+// blockingTransport returns a rate limited response for the first request, and 200 afterwards.
+type blockingTransport struct {
+	requests int
+}
+
+func (b *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b.requests++
+	resp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}
+	if b.requests == 1 {
+		resp.StatusCode = http.StatusTooManyRequests
+		resp.Header.Set(ratelimiting.RetryAfterKey, "0")
+	}
+	return resp, nil
+}
+
+func TestMiddleware_replaysBlocked(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		opts             []ratelimiting.Option
+		expectedStatus   int
+		expectedRequests int
+	}{
+		{name: "disabled", expectedStatus: http.StatusTooManyRequests, expectedRequests: 1},
+		{name: "enabled", opts: []ratelimiting.Option{ratelimiting.WithReplayBlocked(true)}, expectedStatus: http.StatusOK, expectedRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := &blockingTransport{}
+			rt := ratelimiting.Middleware(&fakeRateLimiter{}, tt.opts...)(transport)
+
+			req, err := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = req.WithContext(request.WithRoute(req.Context(), http.MethodGet, "/foo"))
+
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if transport.requests != tt.expectedRequests {
+				t.Fatalf("expected %d requests, got %d", tt.expectedRequests, transport.requests)
+			}
+		})
+	}
+}
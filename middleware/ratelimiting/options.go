@@ -0,0 +1,23 @@
+package ratelimiting
+
+type config struct {
+	replayBlocked bool
+}
+
+type Option func(*config)
+
+func NewConfig(opts ...Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithReplayBlocked makes the middleware wait until a rate limited (429 or 420) request is no longer blocked,
+// and replay it once. Requests with a body that cannot be replayed are returned as-is.
+func WithReplayBlocked(replay bool) Option {
+	return func(c *config) {
+		c.replayBlocked = replay
+	}
+}
@@ -3,6 +3,7 @@ package ratelimiting
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -10,13 +11,22 @@ const (
 	RateLimitGroupKey     = "X-Ratelimit-Group"
 	RateLimitLimitKey     = "X-Ratelimit-Limit"
 	RateLimitRemainingKey = "X-Ratelimit-Remaining"
+	RetryAfterKey         = "Retry-After"
+
+	// StatusErrorLimited is returned by ESI when the error limit has been exceeded.
+	StatusErrorLimited = 420
 
 	DefaultRateLimitTargetPercentage = 0.75
 	DefaultEstimatedTokensPerRequest = 5
+
+	// DefaultBlockDuration is used when a rate limited response does not include a valid Retry-After header.
+	DefaultBlockDuration = time.Minute
 )
 
 var (
 	ErrInvalidHeader = errors.New("invalid header")
+
+	errNotReplayable = errors.New("request body cannot be replayed")
 )
 
 type BucketStatistics struct {
@@ -32,6 +42,30 @@ type BucketStatistics struct {
 
 	// The time the last request was made
 	LastRequest time.Time
+
+	// The time until which the bucket is blocked after a rate limited response.
+	// Zero if the bucket is not blocked.
+	BlockedUntil time.Time
+
+	// The number of requests queued for tokens of the bucket.
+	// Zero for rate limiters that do not queue requests, like sqlstore.
+	Waiting int
+}
+
+// RouteGroup describes the rate limit group of a route ahead of its first response.
+// cmd/generate-request emits these from the x-rate-limit extension of the OpenAPI spec.
+type RouteGroup struct {
+	// The route, as returned by request.GetRoute
+	Route string
+
+	// The name of the rate limit group
+	Group string
+
+	// The number of tokens available in a window
+	BucketSize int
+
+	// The duration of a window
+	WindowSize time.Duration
 }
 
 type RateLimiter interface {
@@ -41,3 +75,26 @@ type RateLimiter interface {
 	// List all active buckets and their statistics.
 	ListBuckets() []*BucketStatistics
 }
+
+// OwnerRemover is implemented by rate limiters that can forget the buckets of a token owner.
+type OwnerRemover interface {
+	// Remove all buckets of a token owner, e.g. after their token was revoked.
+	RemoveOwner(owner int64) error
+}
+
+// BlockedUntil returns the time until which requests should be blocked if the response
+// is rate limited (429 or 420), based on its Retry-After header.
+func BlockedUntil(resp *http.Response, now time.Time) (time.Time, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != StatusErrorLimited) {
+		return time.Time{}, false
+	}
+
+	retryAfter := resp.Header.Get(RetryAfterKey)
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return now.Add(time.Duration(seconds) * time.Second), true
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return date, true
+	}
+	return now.Add(DefaultBlockDuration), true
+}
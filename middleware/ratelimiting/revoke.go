@@ -0,0 +1,19 @@
+package ratelimiting
+
+import (
+	"context"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+// RevokeHook removes the buckets of characters whose token was revoked, see authentication.Manager.OnRevoke.
+// Rate limiters that do not implement OwnerRemover are left alone.
+func RevokeHook(rateLimiter RateLimiter) authentication.RevokeHook {
+	return func(ctx context.Context, event authentication.RevokeEvent) error {
+		remover, ok := rateLimiter.(OwnerRemover)
+		if !ok {
+			return nil
+		}
+		return remover.RemoveOwner(event.CharacterID)
+	}
+}
@@ -0,0 +1,35 @@
+package ratelimiting_test
+
+import (
+	"testing"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+)
+
+type removingRateLimiter struct {
+	fakeRateLimiter
+	removed []int64
+}
+
+func (f *removingRateLimiter) RemoveOwner(owner int64) error {
+	f.removed = append(f.removed, owner)
+	return nil
+}
+
+func TestRevokeHook(t *testing.T) {
+	t.Parallel()
+
+	limiter := &removingRateLimiter{}
+	if err := ratelimiting.RevokeHook(limiter)(t.Context(), authentication.RevokeEvent{CharacterID: 42}); err != nil {
+		t.Fatal(err)
+	}
+	if len(limiter.removed) != 1 || limiter.removed[0] != 42 {
+		t.Fatalf("expected the buckets of 42 to be removed, got %v", limiter.removed)
+	}
+
+	// Rate limiters without RemoveOwner are left alone.
+	if err := ratelimiting.RevokeHook(&fakeRateLimiter{})(t.Context(), authentication.RevokeEvent{CharacterID: 42}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,65 @@
+package sqlstore
+
+import (
+	"database/sql"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+)
+
+type config struct {
+	db          *sql.DB
+	driver      string
+	dsn         string
+	tablePrefix string
+	routeGroups []ratelimiting.RouteGroup
+}
+
+type Option func(*config)
+
+func NewConfig(opts ...Option) *config {
+	c := &config{
+		driver:      DefaultDriver,
+		tablePrefix: DefaultTablePrefix,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithDB uses an existing database handle; the driver and dsn options are ignored.
+func WithDB(db *sql.DB) Option {
+	return func(c *config) {
+		c.db = db
+	}
+}
+
+func WithDriver(driver string) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+func WithDsn(dsn string) Option {
+	return func(c *config) {
+		c.dsn = dsn
+	}
+}
+
+func WithPath(path string) Option {
+	return WithDsn(path)
+}
+
+func WithTablePrefix(prefix string) Option {
+	return func(c *config) {
+		c.tablePrefix = prefix
+	}
+}
+
+// WithRouteGroups preloads the rate limit group of each route.
+// Groups and routes already learned from responses are kept.
+func WithRouteGroups(groups []ratelimiting.RouteGroup) Option {
+	return func(c *config) {
+		c.routeGroups = append(c.routeGroups, groups...)
+	}
+}
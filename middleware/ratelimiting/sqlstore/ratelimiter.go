@@ -0,0 +1,384 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting/internal/bucket"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting/memory"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+const (
+	DefaultDriver      = "sqlite"
+	DefaultTablePrefix = "ratelimit_"
+)
+
+var (
+	maximumWaitTimePerAttempt = 10 * time.Second
+)
+
+// sqlRateLimiter keeps groups and buckets in a database shared between processes.
+// Every claim and update is a single statement, so concurrent processes never act on a stale view of a bucket.
+type sqlRateLimiter struct {
+	db     *sql.DB
+	ownsDB bool
+	prefix string
+
+	targetPercentage          float64
+	estimatedTokensPerRequest int
+}
+
+// New creates a rate limiter backed by a database/sql database, creating its tables if needed.
+//
+// When using SQLite from multiple processes, configure a busy timeout (e.g. `?_pragma=busy_timeout(5000)`),
+// so concurrent writers wait for each other instead of failing.
+func New(opts ...Option) (ratelimiting.RateLimiter, error) {
+	config := NewConfig(opts...)
+
+	db := config.db
+	ownsDB := db == nil
+	if ownsDB {
+		var err error
+		db, err = sql.Open(config.driver, config.dsn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r := &sqlRateLimiter{
+		db:                        db,
+		ownsDB:                    ownsDB,
+		prefix:                    config.tablePrefix,
+		targetPercentage:          ratelimiting.DefaultRateLimitTargetPercentage,
+		estimatedTokensPerRequest: ratelimiting.DefaultEstimatedTokensPerRequest,
+	}
+	if err := r.initialize(); err != nil {
+		return nil, err
+	}
+	if err := r.preloadRouteGroups(config.routeGroups); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *sqlRateLimiter) initialize() error {
+	_, err := r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS ` + r.prefix + `groups (
+			name TEXT PRIMARY KEY,
+			bucket_size INTEGER NOT NULL,
+			window_size INTEGER NOT NULL
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS ` + r.prefix + `routes (
+			route TEXT PRIMARY KEY,
+			group_name TEXT NOT NULL
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS ` + r.prefix + `buckets (
+			group_name TEXT NOT NULL,
+			owner INTEGER NOT NULL,
+			tokens INTEGER NOT NULL,
+			last_request INTEGER NOT NULL,
+			blocked_until INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (group_name, owner)
+		)
+	`)
+	return err
+}
+
+// preloadRouteGroups stores the known route groups, without overwriting what was learned from responses.
+func (r *sqlRateLimiter) preloadRouteGroups(routeGroups []ratelimiting.RouteGroup) error {
+	for _, routeGroup := range routeGroups {
+		_, err := r.db.Exec(`
+			INSERT INTO `+r.prefix+`groups (name, bucket_size, window_size) VALUES (?, ?, ?)
+			ON CONFLICT (name) DO NOTHING
+		`, routeGroup.Group, routeGroup.BucketSize, int64(routeGroup.WindowSize))
+		if err != nil {
+			return err
+		}
+
+		_, err = r.db.Exec(`
+			INSERT INTO `+r.prefix+`routes (route, group_name) VALUES (?, ?)
+			ON CONFLICT (route) DO NOTHING
+		`, routeGroup.Route, routeGroup.Group)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *sqlRateLimiter) getPathGroup(ctx context.Context, route string) (*memory.Group, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT g.name, g.bucket_size, g.window_size
+		FROM `+r.prefix+`routes r
+		JOIN `+r.prefix+`groups g ON g.name = r.group_name
+		WHERE r.route = ?
+	`, route)
+
+	var group memory.Group
+	if err := row.Scan(&group.Name, &group.BucketSize, &group.WindowSize); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *sqlRateLimiter) updateGroup(ctx context.Context, route string, group *memory.Group) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO `+r.prefix+`groups (name, bucket_size, window_size) VALUES (?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET bucket_size = excluded.bucket_size, window_size = excluded.window_size
+	`, group.Name, group.BucketSize, int64(group.WindowSize))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO `+r.prefix+`routes (route, group_name) VALUES (?, ?)
+		ON CONFLICT (route) DO UPDATE SET group_name = excluded.group_name
+	`, route, group.Name)
+	return err
+}
+
+// effectiveTokens is the SQL equivalent of memory.Bucket.EffectiveTokens; the elapsed time is capped
+// at a single window (after which the bucket is empty anyway) to avoid overflowing the multiplication.
+const effectiveTokens = `MAX(0, tokens - (MAX(0, MIN(? - last_request, ?)) * ? / ?))`
+
+// claim atomically claims tokens in the bucket if its effective usage is below the target, and it is not blocked.
+// Returns true if the tokens were claimed.
+func (r *sqlRateLimiter) claim(ctx context.Context, group *memory.Group, owner int64) (bool, error) {
+	now := time.Now().UnixNano()
+	window := int64(group.WindowSize)
+	target := group.TargetSize(r.targetPercentage)
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO `+r.prefix+`buckets (group_name, owner, tokens, last_request) VALUES (?, ?, 0, ?)
+		ON CONFLICT (group_name, owner) DO NOTHING
+	`, group.Name, owner, now)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE `+r.prefix+`buckets
+		SET tokens = `+effectiveTokens+` + ?, last_request = ?
+		WHERE group_name = ? AND owner = ? AND blocked_until <= ? AND `+effectiveTokens+` < ?
+	`,
+		now, window, group.BucketSize, window, r.estimatedTokensPerRequest, now,
+		group.Name, owner, now, now, window, group.BucketSize, window, target,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// timeUntilTarget returns how long until the bucket is back under the target usage, and no longer blocked.
+func (r *sqlRateLimiter) timeUntilTarget(ctx context.Context, group *memory.Group, owner int64) (time.Duration, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT tokens, last_request, blocked_until FROM `+r.prefix+`buckets WHERE group_name = ? AND owner = ?
+	`, group.Name, owner)
+
+	var tokens int
+	var lastRequest, blockedUntil int64
+	if err := row.Scan(&tokens, &lastRequest, &blockedUntil); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	blocked := max(0, time.Until(time.Unix(0, blockedUntil)))
+	missing := bucketTokens(group, tokens, time.Unix(0, lastRequest)) - group.TargetSize(r.targetPercentage)
+	if missing < 0 {
+		return blocked, nil
+	}
+	return max(blocked, group.TimePerToken()*time.Duration(missing+1)), nil
+}
+
+func bucketTokens(group *memory.Group, tokens int, lastRequest time.Time) int {
+	return memory.FakeBucket(group, 0, tokens, lastRequest).EffectiveTokens()
+}
+
+func (r *sqlRateLimiter) delayRequest(ctx context.Context, group *memory.Group, owner int64) error {
+	for {
+		claimed, err := r.claim(ctx, group, owner)
+		if err != nil || claimed {
+			return err
+		}
+
+		timeToWait, err := r.timeUntilTarget(ctx, group, owner)
+		if err != nil {
+			return err
+		}
+		// Cap the wait time, another process may update the bucket in the meantime.
+		timeToWait = max(min(timeToWait, maximumWaitTimePerAttempt), group.TimePerToken())
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(timeToWait):
+		}
+	}
+}
+
+func (r *sqlRateLimiter) processResponse(ctx context.Context, route string, owner int64, resp *http.Response) error {
+	if resp == nil {
+		return nil
+	}
+
+	group, err := memory.NewGroup(
+		resp.Header.Get(ratelimiting.RateLimitGroupKey),
+		resp.Header.Get(ratelimiting.RateLimitLimitKey),
+	)
+	if err == nil {
+		if err := r.updateGroup(ctx, route, group); err != nil {
+			return err
+		}
+	} else if group, err = r.getPathGroup(ctx, route); err != nil || group == nil {
+		// We don't know the group for this route yet, so there is no bucket to update.
+		return err
+	}
+
+	now := time.Now()
+	if until, blocked := ratelimiting.BlockedUntil(resp, now); blocked {
+		_, err = r.db.ExecContext(ctx, `
+			INSERT INTO `+r.prefix+`buckets (group_name, owner, tokens, last_request, blocked_until) VALUES (?, ?, 0, ?, ?)
+			ON CONFLICT (group_name, owner) DO UPDATE SET blocked_until = MAX(blocked_until, excluded.blocked_until)
+		`, group.Name, owner, now.UnixNano(), until.UnixNano())
+		if err != nil {
+			return err
+		}
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get(ratelimiting.RateLimitRemainingKey))
+	if err != nil {
+		return nil
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO `+r.prefix+`buckets (group_name, owner, tokens, last_request) VALUES (?, ?, ?, ?)
+		ON CONFLICT (group_name, owner) DO UPDATE SET tokens = excluded.tokens, last_request = excluded.last_request
+	`, group.Name, owner, group.BucketSize-remaining, now.UnixNano())
+	return err
+}
+
+// Schedule handles rate limiting for a request. It claims tokens from the shared bucket,
+// and delays the request while the bucket is over the target usage.
+// Returns a callback that should be called with the response to update rate limit state.
+func (r *sqlRateLimiter) Schedule(req *http.Request) (func(*http.Response), error) {
+	ctx := req.Context()
+	route, ok := request.GetRoute(ctx)
+	if !ok {
+		return nil, errors.New("no route found")
+	}
+
+	owner := bucket.GetRequestBucket(req)
+
+	group, err := r.getPathGroup(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+	if group != nil {
+		if err := r.delayRequest(ctx, group, owner); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(resp *http.Response) {
+		// The response is processed even if the request context has since been cancelled.
+		_ = r.processResponse(context.WithoutCancel(ctx), route, owner, resp)
+	}, nil
+}
+
+func (r *sqlRateLimiter) ListBuckets() []*ratelimiting.BucketStatistics {
+	rows, err := r.db.Query(`
+		SELECT b.group_name, b.owner, b.tokens, b.last_request, b.blocked_until, g.bucket_size, g.window_size
+		FROM ` + r.prefix + `buckets b
+		JOIN ` + r.prefix + `groups g ON g.name = b.group_name
+		ORDER BY b.group_name, b.owner
+	`)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	info := make([]*ratelimiting.BucketStatistics, 0)
+	for rows.Next() {
+		var group memory.Group
+		var owner, lastRequest, blockedUntil int64
+		var tokens int
+		if err := rows.Scan(&group.Name, &owner, &tokens, &lastRequest, &blockedUntil, &group.BucketSize, &group.WindowSize); err != nil {
+			return info
+		}
+		// Blocks that have expired are reported as not blocked.
+		if blockedUntil <= now.UnixNano() {
+			blockedUntil = 0
+		}
+		info = append(info, &ratelimiting.BucketStatistics{
+			Group:           group.Name,
+			Owner:           owner,
+			EffectiveTokens: bucketTokens(&group, tokens, time.Unix(0, lastRequest)),
+			LastRequest:     time.Unix(0, lastRequest),
+			BlockedUntil:    unixTime(blockedUntil),
+		})
+	}
+	return info
+}
+
+// unixTime converts a stored timestamp to a time, keeping zero as the zero time.
+func unixTime(nanoseconds int64) time.Time {
+	if nanoseconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanoseconds)
+}
+
+// CleanupExpiredBuckets removes buckets that have not been used in the last two windows, and are not blocked.
+func (r *sqlRateLimiter) CleanupExpiredBuckets() error {
+	now := time.Now().UnixNano()
+	_, err := r.db.Exec(`
+		DELETE FROM `+r.prefix+`buckets
+		WHERE last_request < ? - 2 * (SELECT window_size FROM `+r.prefix+`groups g WHERE g.name = group_name)
+		AND blocked_until <= ?
+	`, now, now)
+	return err
+}
+
+// RemoveOwner removes all buckets of the owner.
+func (r *sqlRateLimiter) RemoveOwner(owner int64) error {
+	_, err := r.db.Exec(`DELETE FROM `+r.prefix+`buckets WHERE owner = ?`, owner)
+	return err
+}
+
+// Close closes the underlying database, unless it was provided using WithDB.
+func (r *sqlRateLimiter) Close() error {
+	if !r.ownsDB {
+		return nil
+	}
+	return r.db.Close()
+}
@@ -0,0 +1,224 @@
+package sqlstore_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	// This enables the sqlite driver so we can share a database file between rate limiters.
+	_ "github.com/glebarez/go-sqlite"
+
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting"
+	"github.com/xaroth/lib-esi-go/middleware/ratelimiting/sqlstore"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+func newRateLimiter(t *testing.T, path string) ratelimiting.RateLimiter {
+	t.Helper()
+
+	limiter, err := sqlstore.New(sqlstore.WithPath(path + "?_pragma=busy_timeout(10000)"))
+	if err != nil {
+		t.Fatalf("failed to create rate limiter: %v", err)
+	}
+	return limiter
+}
+
+func newRequest(t *testing.T, ctx context.Context) *http.Request {
+	t.Helper()
+
+	ctx = request.WithRoute(ctx, http.MethodGet, "/markets/{region_id}/orders")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com/", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	return req
+}
+
+func rateLimitResponse(remaining string) *http.Response {
+	header := make(http.Header)
+	header.Set(ratelimiting.RateLimitGroupKey, "market")
+	header.Set(ratelimiting.RateLimitLimitKey, "100/1000s")
+	header.Set(ratelimiting.RateLimitRemainingKey, remaining)
+	return &http.Response{StatusCode: http.StatusOK, Header: header}
+}
+
+func TestRateLimiter_learnsGroups(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(t, filepath.Join(t.TempDir(), "ratelimit.sqlite"))
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	if buckets := limiter.ListBuckets(); len(buckets) != 0 {
+		t.Fatalf("expected no buckets before the first response, got %d", len(buckets))
+	}
+	done(rateLimitResponse("90"))
+
+	buckets := limiter.ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if buckets[0].Group != "market" || buckets[0].Owner != -1 || buckets[0].EffectiveTokens != 10 {
+		t.Fatalf("unexpected bucket: %+v", buckets[0])
+	}
+
+	// The group is now known, so scheduling claims tokens up front.
+	if _, err := limiter.Schedule(newRequest(t, t.Context())); err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	if tokens := limiter.ListBuckets()[0].EffectiveTokens; tokens != 10+ratelimiting.DefaultEstimatedTokensPerRequest {
+		t.Fatalf("expected claimed tokens, got %d", tokens)
+	}
+}
+
+func TestRateLimiter_sharedBetweenProcesses(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "ratelimit.sqlite")
+	limiters := []ratelimiting.RateLimiter{
+		newRateLimiter(t, path),
+		newRateLimiter(t, path),
+	}
+
+	// Teach both limiters the group, with an empty bucket.
+	done, err := limiters[0].Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("100"))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 500*time.Millisecond)
+	defer cancel()
+
+	var scheduled atomic.Int64
+	var wg sync.WaitGroup
+	for i := range 40 {
+		wg.Go(func() {
+			if _, err := limiters[i%len(limiters)].Schedule(newRequest(t, ctx)); err == nil {
+				scheduled.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+
+	// 75 tokens may be used before requests are delayed, at 5 tokens per request.
+	if got := scheduled.Load(); got != 15 {
+		t.Fatalf("expected 15 requests to be scheduled across both limiters, got %d", got)
+	}
+}
+
+func TestRateLimiter_blockedByRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "ratelimit.sqlite")
+	limiters := []ratelimiting.RateLimiter{
+		newRateLimiter(t, path),
+		newRateLimiter(t, path),
+	}
+
+	done, err := limiters[0].Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	resp := rateLimitResponse("100")
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set(ratelimiting.RetryAfterKey, "60")
+	done(resp)
+
+	buckets := limiters[1].ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if until := time.Until(buckets[0].BlockedUntil); until < 59*time.Second || until > time.Minute {
+		t.Fatalf("expected the bucket to be blocked for a minute, got %s", until)
+	}
+
+	// The block is shared, even though the bucket has tokens available.
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := limiters[1].Schedule(newRequest(t, ctx)); err == nil {
+		t.Fatal("expected the request to be blocked until the context is cancelled")
+	}
+}
+
+func TestRateLimiter_expiredBlock(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(t, filepath.Join(t.TempDir(), "ratelimit.sqlite"))
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	resp := rateLimitResponse("100")
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set(ratelimiting.RetryAfterKey, "0")
+	done(resp)
+
+	buckets := limiter.ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if !buckets[0].BlockedUntil.IsZero() {
+		t.Fatalf("expected an expired block to be reported as zero, got %s", buckets[0].BlockedUntil)
+	}
+}
+
+func TestRateLimiter_preloadedRouteGroups(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := sqlstore.New(
+		sqlstore.WithPath(filepath.Join(t.TempDir(), "ratelimit.sqlite")+"?_pragma=busy_timeout(10000)"),
+		sqlstore.WithRouteGroups([]ratelimiting.RouteGroup{
+			{Route: "GET /markets/{region_id}/orders", Group: "market", BucketSize: 100, WindowSize: 1000 * time.Second},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create rate limiter: %v", err)
+	}
+
+	// The group is known up front, so the first request already claims tokens.
+	if _, err := limiter.Schedule(newRequest(t, t.Context())); err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	buckets := limiter.ListBuckets()
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if buckets[0].Group != "market" || buckets[0].EffectiveTokens != ratelimiting.DefaultEstimatedTokensPerRequest {
+		t.Fatalf("unexpected bucket: %+v", buckets[0])
+	}
+}
+
+func TestRateLimiter_RemoveOwner(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(t, filepath.Join(t.TempDir(), "ratelimit.sqlite"))
+
+	done, err := limiter.Schedule(newRequest(t, t.Context()))
+	if err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	done(rateLimitResponse("90"))
+
+	if err := limiter.(ratelimiting.OwnerRemover).RemoveOwner(42); err != nil {
+		t.Fatal(err)
+	}
+	if buckets := limiter.ListBuckets(); len(buckets) != 1 {
+		t.Fatalf("expected the bucket of another owner to be kept, got %d buckets", len(buckets))
+	}
+
+	// Unauthenticated requests are bucketed to owner -1.
+	if err := limiter.(ratelimiting.OwnerRemover).RemoveOwner(-1); err != nil {
+		t.Fatal(err)
+	}
+	if buckets := limiter.ListBuckets(); len(buckets) != 0 {
+		t.Fatalf("expected the bucket to be removed, got %d buckets", len(buckets))
+	}
+}
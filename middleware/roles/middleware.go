@@ -0,0 +1,62 @@
+package roles
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+// Middleware rejects requests whose token owner lacks the in-game corporation roles of the route,
+// before they are sent and cost a 403 from the error budget.
+//
+// requiredRoles maps routes, e.g. "GET /corporations/{corporation_id}/members", to the roles of which any one is needed.
+// The roles of each owner are fetched through sender, typically the client this middleware is part of,
+// and cached. Requests without a token, or whose token lacks the esi-characters.read_corporation_roles.v1 scope, are not checked.
+func Middleware(sender request.RequestSender, requiredRoles map[string][]string, opts ...Option) middleware.Middleware {
+	config := NewConfig(opts...)
+	cache := &roleCache{
+		sender:        sender,
+		cacheDuration: config.cacheDuration,
+		roles:         make(map[int64]cachedRoles),
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return middleware.MiddlewareFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+
+			route, ok := request.GetRoute(ctx)
+			if !ok {
+				return next.RoundTrip(req)
+			}
+			required := requiredRoles[route]
+			if len(required) == 0 {
+				return next.RoundTrip(req)
+			}
+
+			token, ok := authentication.GetToken(ctx)
+			if !ok {
+				return next.RoundTrip(req)
+			}
+			if scoped, ok := token.(authentication.ScopedToken); ok && !slices.Contains(scoped.Scopes(), RolesScope) {
+				return next.RoundTrip(req)
+			}
+
+			roles, err := cache.get(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+			if !hasAnyRole(roles, required) {
+				return nil, &MissingRoleError{
+					Route:       route,
+					CharacterID: token.Owner(),
+					Roles:       slices.Clone(required),
+				}
+			}
+
+			return next.RoundTrip(req)
+		})
+	}
+}
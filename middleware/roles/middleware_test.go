@@ -0,0 +1,188 @@
+package roles_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/middleware/authentication/mock"
+	"github.com/xaroth/lib-esi-go/middleware/roles"
+	"github.com/xaroth/lib-esi-go/request"
+	"go.uber.org/mock/gomock"
+)
+
+const walletsRoute = "GET /corporations/{corporation_id}/wallets"
+
+var requiredRoles = map[string][]string{
+	walletsRoute: {"Accountant", "Junior_Accountant"},
+}
+
+// rolesSender serves the roles of each character, as the roles endpoint would.
+type rolesSender struct {
+	roles    map[string][]string
+	requests atomic.Int64
+}
+
+func (s *rolesSender) Do(req *http.Request) (*http.Response, error) {
+	s.requests.Add(1)
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	roles, ok := s.roles[parts[len(parts)-2]]
+	if !ok {
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Status:     "403 Forbidden",
+			Body:       io.NopCloser(strings.NewReader(`{"error":"Character does not have required role(s)"}`)),
+			Request:    req,
+		}, nil
+	}
+	body, err := json.Marshal(map[string]any{"roles": roles})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		route            string
+		owner            int64
+		scopes           []string
+		expectedRequests int64
+		expectedError    error
+	}{
+		{
+			name:             "success: character has one of the roles",
+			route:            walletsRoute,
+			owner:            1,
+			scopes:           []string{roles.RolesScope},
+			expectedRequests: 1,
+		},
+		{
+			name:             "success: directors have every role",
+			route:            walletsRoute,
+			owner:            2,
+			scopes:           []string{roles.RolesScope},
+			expectedRequests: 1,
+		},
+		{
+			name:             "error: character has none of the roles",
+			route:            walletsRoute,
+			owner:            3,
+			scopes:           []string{roles.RolesScope},
+			expectedRequests: 1,
+			expectedError:    roles.ErrMissingRole,
+		},
+		{
+			name:             "error: roles are unavailable",
+			route:            walletsRoute,
+			owner:            4,
+			scopes:           []string{roles.RolesScope},
+			expectedRequests: 1,
+			expectedError:    roles.ErrRolesUnavailable,
+		},
+		{
+			name:   "success: token cannot read roles",
+			route:  walletsRoute,
+			owner:  3,
+			scopes: []string{"esi-wallet.read_corporation_wallets.v1"},
+		},
+		{
+			name:   "success: route needs no roles",
+			route:  "GET /corporations/{corporation_id}/members",
+			owner:  3,
+			scopes: []string{roles.RolesScope},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			sender := &rolesSender{roles: map[string][]string{
+				"1": {"Accountant", "Station_Manager"},
+				"2": {"Director"},
+				"3": {"Station_Manager"},
+			}}
+
+			ctrl := gomock.NewController(t)
+			token := mock.NewMockScopedToken(ctrl)
+			token.EXPECT().Owner().Return(testCase.owner).AnyTimes()
+			token.EXPECT().Scopes().Return(testCase.scopes).AnyTimes()
+			token.EXPECT().Token().Return("access").AnyTimes()
+
+			method, path, _ := strings.Cut(testCase.route, " ")
+			ctx := request.WithRoute(t.Context(), method, path)
+			ctx = authentication.WithToken(token)(ctx)
+
+			rt := roles.Middleware(sender, requiredRoles)(middleware.NewFakeMiddleware(t, nil))
+
+			// The second request uses the cached roles.
+			for range 2 {
+				req, err := http.NewRequestWithContext(ctx, method, "https://esi.evetech.net"+path, nil)
+				if err != nil {
+					t.Fatalf("failed to create request: %v", err)
+				}
+				_, err = rt.RoundTrip(req)
+				if !errors.Is(err, testCase.expectedError) {
+					t.Fatalf("expected error %v, got %v", testCase.expectedError, err)
+				}
+			}
+
+			expectedRequests := testCase.expectedRequests
+			if testCase.expectedError == roles.ErrRolesUnavailable {
+				// Failures are not cached.
+				expectedRequests *= 2
+			}
+			if got := sender.requests.Load(); got != expectedRequests {
+				t.Fatalf("expected %d roles requests, got %d", expectedRequests, got)
+			}
+		})
+	}
+}
+
+func TestMissingRoleError(t *testing.T) {
+	t.Parallel()
+
+	ctx := request.WithRoute(t.Context(), http.MethodGet, "/corporations/{corporation_id}/wallets")
+	token := mock.NewMockScopedToken(gomock.NewController(t))
+	token.EXPECT().Owner().Return(int64(3)).AnyTimes()
+	token.EXPECT().Scopes().Return([]string{roles.RolesScope}).AnyTimes()
+	token.EXPECT().Token().Return("access").AnyTimes()
+	ctx = authentication.WithToken(token)(ctx)
+
+	sender := &rolesSender{roles: map[string][]string{"3": {}}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://esi.evetech.net/corporations/1/wallets", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	_, err = roles.Middleware(sender, requiredRoles)(middleware.NewFakeMiddleware(t, nil)).RoundTrip(req)
+
+	var missing *roles.MissingRoleError
+	if !errors.As(err, &missing) {
+		t.Fatalf("expected a MissingRoleError, got %v", err)
+	}
+	expected := &roles.MissingRoleError{Route: walletsRoute, CharacterID: 3, Roles: []string{"Accountant", "Junior_Accountant"}}
+	if diff := cmp.Diff(expected, missing); diff != "" {
+		t.Fatalf("unexpected error (-want +got):\n%s", diff)
+	}
+	if !strings.Contains(err.Error(), "Accountant or Junior_Accountant") {
+		t.Fatalf("expected the error to name the roles, got %q", err)
+	}
+}
@@ -0,0 +1,26 @@
+package roles
+
+import "time"
+
+type config struct {
+	cacheDuration time.Duration
+}
+
+type Option func(*config)
+
+func NewConfig(opts ...Option) *config {
+	c := &config{
+		cacheDuration: DefaultCacheDuration,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithCacheDuration sets how long the roles of a character are used before they are fetched again.
+func WithCacheDuration(duration time.Duration) Option {
+	return func(c *config) {
+		c.cacheDuration = duration
+	}
+}
@@ -0,0 +1,105 @@
+package roles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/common/character"
+	"github.com/xaroth/lib-esi-go/esi/getcharacterscharacteridroles"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+const (
+	// DefaultCacheDuration matches how long ESI caches the roles of a character.
+	DefaultCacheDuration = time.Hour
+
+	// RoleDirector grants every other role.
+	RoleDirector = "Director"
+
+	// RolesScope is needed to read the roles of a character.
+	RolesScope = "esi-characters.read_corporation_roles.v1"
+)
+
+var (
+	ErrMissingRole      = errors.New("missing corporation role")
+	ErrRolesUnavailable = errors.New("corporation roles unavailable")
+)
+
+// MissingRoleError is returned when the token owner has none of the corporation roles a route requires.
+// It matches ErrMissingRole.
+type MissingRoleError struct {
+	Route       string
+	CharacterID int64
+
+	// The roles of which the character needs any one.
+	Roles []string
+}
+
+func (e *MissingRoleError) Error() string {
+	return fmt.Sprintf("%s: character %d needs %s for %s", ErrMissingRole, e.CharacterID, strings.Join(e.Roles, " or "), e.Route)
+}
+
+func (e *MissingRoleError) Is(target error) bool {
+	return target == ErrMissingRole
+}
+
+type cachedRoles struct {
+	roles     []string
+	expiresAt time.Time
+}
+
+// roleCache fetches the roles of token owners, and keeps them for the cache duration.
+type roleCache struct {
+	sender        request.RequestSender
+	cacheDuration time.Duration
+
+	mu    sync.Mutex
+	roles map[int64]cachedRoles
+}
+
+func (c *roleCache) get(ctx context.Context, token authentication.Token) ([]string, error) {
+	owner := token.Owner()
+
+	c.mu.Lock()
+	cached, ok := c.roles[owner]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.roles, nil
+	}
+
+	resp, err := getcharacterscharacteridroles.Request(ctx, c.sender, &getcharacterscharacteridroles.Input{
+		Character: character.Identifier(owner),
+	}, authentication.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRolesUnavailable, err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Data == nil {
+		return nil, fmt.Errorf("%w: %s", ErrRolesUnavailable, resp.Status)
+	}
+
+	c.mu.Lock()
+	c.roles[owner] = cachedRoles{roles: resp.Data.Roles, expiresAt: time.Now().Add(c.cacheDuration)}
+	c.mu.Unlock()
+
+	return resp.Data.Roles, nil
+}
+
+// hasAnyRole reports whether the character has any one of the required roles. Directors have every role.
+func hasAnyRole(roles []string, requiredRoles []string) bool {
+	if slices.Contains(roles, RoleDirector) {
+		return true
+	}
+	for _, role := range requiredRoles {
+		if slices.Contains(roles, role) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,8 @@ package request
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 )
 
 type requestInfoCtx struct{}
@@ -17,6 +19,8 @@ func BaseContext[T any](ctx context.Context, req *requestInfo, requestKey string
 	return ctx
 }
 
+// GetRequiredScope returns every scope named by the security requirements of the route.
+// See GetSecurityRequirements for which combinations grant access.
 func GetRequiredScope(ctx context.Context) []string {
 	if req, ok := ctx.Value(requestInfoCtx{}).(*requestInfo); ok {
 		return req.RequiredScope
@@ -24,6 +28,24 @@ func GetRequiredScope(ctx context.Context) []string {
 	return nil
 }
 
+// GetSecurityRequirements returns the security requirements of the route.
+// Any one requirement grants access, and needs all of its scopes.
+func GetSecurityRequirements(ctx context.Context) [][]string {
+	if req, ok := ctx.Value(requestInfoCtx{}).(*requestInfo); ok {
+		return req.Security
+	}
+	return nil
+}
+
+// GetCacheDuration returns how long ESI caches responses of the route, as set with WithCacheDuration, so work
+// can be scheduled before the first response arrives. It returns 0 if the route was created without it.
+func GetCacheDuration(ctx context.Context) time.Duration {
+	if req, ok := ctx.Value(requestInfoCtx{}).(*requestInfo); ok {
+		return req.CacheDuration
+	}
+	return 0
+}
+
 func GetRequestInput[T any](ctx context.Context) T {
 	if input, ok := ctx.Value(requestInputCtx{}).(T); ok {
 		return input
@@ -31,6 +53,27 @@ func GetRequestInput[T any](ctx context.Context) T {
 	return *new(T)
 }
 
+// GetPathParameter returns the value of the input field tagged as the given path parameter, e.g. `character_id`.
+func GetPathParameter(ctx context.Context, name string) (any, bool) {
+	input := reflect.ValueOf(GetRequestInput[any](ctx))
+	for input.Kind() == reflect.Pointer {
+		if input.IsNil() {
+			return nil, false
+		}
+		input = input.Elem()
+	}
+	if input.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	for i := range input.NumField() {
+		if tag, ok := input.Type().Field(i).Tag.Lookup("path"); ok && tag == name {
+			return input.Field(i).Interface(), true
+		}
+	}
+	return nil, false
+}
+
 func GetRequestKey(ctx context.Context) string {
 	if key, ok := ctx.Value(requestKeyCtx{}).(string); ok {
 		return key
@@ -0,0 +1,47 @@
+package request_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/request"
+)
+
+func TestGetCacheDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		opts     []request.CreateOption
+		expected time.Duration
+	}{
+		{name: "cached", opts: []request.CreateOption{request.WithCacheDuration(300 * time.Second)}, expected: 5 * time.Minute},
+		{name: "not cached", expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual time.Duration
+			sender := senderFunc(func(req *http.Request) (*http.Response, error) {
+				actual = request.GetCacheDuration(req.Context())
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+			})
+			create := request.CreateStatic[struct{}](http.MethodGet, "/status", tc.opts...)
+			if _, err := create(t.Context(), sender); err != nil {
+				t.Fatal(err)
+			}
+			if actual != tc.expected {
+				t.Errorf("GetCacheDuration() = %v, want %v", actual, tc.expected)
+			}
+		})
+	}
+
+	if actual := request.GetCacheDuration(context.Background()); actual != 0 {
+		t.Errorf("GetCacheDuration() without a route = %v, want 0", actual)
+	}
+}
@@ -3,6 +3,7 @@ package esierror
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type ErrorDetails struct {
@@ -38,3 +39,26 @@ func (e ErrorData) Error() string {
 func (e ErrorData) String() string {
 	return e.ErrorMessage
 }
+
+// ValidationError lists the fields of an input that violate the constraints of the spec.
+// It is returned before the request is sent, so the request does not count against the error limit.
+type ValidationError struct {
+	Details []ErrorDetails
+}
+
+func (e ValidationError) Error() string {
+	messages := make([]string, len(e.Details))
+	for i, detail := range e.Details {
+		messages[i] = detail.Error()
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the details, so errors.As finds the first ErrorDetails.
+func (e ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Details))
+	for i, detail := range e.Details {
+		errs[i] = detail
+	}
+	return errs
+}
@@ -27,11 +27,20 @@ func getRequestBodyJSON(val any) (io.Reader, error) {
 }
 
 func requestValue(val any) (string, error) {
+	// Optional parameters are generated as pointers; format the value they point to.
+	if rv := reflect.ValueOf(val); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		if _, ok := val.(fmt.Stringer); !ok {
+			val = rv.Elem().Interface()
+		}
+	}
+
 	switch value := val.(type) {
 	case string:
 		return value, nil
 	case int:
 		return strconv.Itoa(value), nil
+	case int32:
+		return strconv.FormatInt(int64(value), 10), nil
 	case int64:
 		return strconv.FormatInt(value, 10), nil
 	case fmt.Stringer:
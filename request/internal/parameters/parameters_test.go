@@ -156,6 +156,20 @@ func TestExtract(t *testing.T) {
 				"X-H": {"hdr-val"},
 			},
 		},
+		{
+			name: "optional query pointer value",
+			invoke: func() (map[string]any, url.Values, http.Header, io.Reader, error) {
+				type input struct {
+					Page *int32 `query:"page"`
+				}
+				page := int32(2)
+				in := &input{Page: &page}
+				return parameters.Extract(in)
+			},
+			expectedPath:   map[string]any{},
+			expectedQuery:  url.Values{"page": {"2"}},
+			expectedHeader: http.Header{},
+		},
 		{
 			name: "invalid query value type",
 			invoke: func() (map[string]any, url.Values, http.Header, io.Reader, error) {
@@ -173,7 +187,7 @@ func TestExtract(t *testing.T) {
 				type input struct {
 					H bool `header:"X-H"`
 				}
-				in := &input{H: false}
+				in := &input{H: true}
 				return parameters.Extract(in)
 			},
 			expectedErr: parameters.ErrInvalidValueType,
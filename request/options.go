@@ -1,13 +1,34 @@
 package request
 
-import "context"
+import (
+	"context"
+	"slices"
+	"time"
+)
 
 type CreateOption func(*requestInfo)
 
 type RequestOption func(context.Context) context.Context
 
+// WithRequiredScope adds a security requirement to the route, which needs all of the given scopes.
+// Adding multiple requirements lets any one of them grant access.
 func WithRequiredScope(scope ...string) CreateOption {
 	return func(info *requestInfo) {
-		info.RequiredScope = append(info.RequiredScope, scope...)
+		if len(scope) == 0 {
+			return
+		}
+		info.Security = append(info.Security, slices.Clone(scope))
+		for _, s := range scope {
+			if !slices.Contains(info.RequiredScope, s) {
+				info.RequiredScope = append(info.RequiredScope, s)
+			}
+		}
+	}
+}
+
+// WithCacheDuration sets how long ESI caches responses of the route, from its x-cached-seconds.
+func WithCacheDuration(duration time.Duration) CreateOption {
+	return func(info *requestInfo) {
+		info.CacheDuration = duration
 	}
 }
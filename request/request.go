@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+	"time"
 
 	defaults "github.com/xaroth/lib-esi-go"
 	"github.com/xaroth/lib-esi-go/request/esierror"
@@ -33,6 +34,12 @@ type requestInfo struct {
 	Path          string
 	Pattern       pattern.Pattern
 	RequiredScope []string
+
+	// The security requirements of the route; any one requirement grants access, and needs all of its scopes.
+	Security [][]string
+
+	// How long ESI caches responses of the route; 0 when they are not cached.
+	CacheDuration time.Duration
 }
 
 type RequestFunc[TInput any, TOutput any] func(ctx context.Context, sender RequestSender, input *TInput, opts ...RequestOption) (*Response[TOutput], error)
@@ -82,6 +89,13 @@ func Create[TInput any, TOutput any](method string, path string, opts ...CreateO
 	}
 
 	return func(bCtx context.Context, sender RequestSender, input *TInput, opts ...RequestOption) (*Response[TOutput], error) {
+		// Reject inputs ESI would reject as well, without spending the error limit on them.
+		if validator, ok := any(input).(interface{ Validate() error }); ok && input != nil {
+			if err := validator.Validate(); err != nil {
+				return nil, err
+			}
+		}
+
 		// Split the input parameters into path, query, header, and body parameters.
 		pathParameters, queryParameters, headerParameters, bodyParameters, err := parameters.Extract(input)
 		if err != nil {
@@ -91,6 +105,9 @@ func Create[TInput any, TOutput any](method string, path string, opts ...CreateO
 		requestKey := createRequestKey(pathParameters, queryParameters, headerParameters)
 
 		ctx := BaseContext(bCtx, req, requestKey, input)
+		for _, opt := range opts {
+			ctx = opt(ctx)
+		}
 
 		path, err := pattern.String(pathParameters)
 		if err != nil {
@@ -0,0 +1,25 @@
+package request
+
+import "reflect"
+
+// Route identifies a generated operation by its method and path, together with its input and output types.
+// Every generated package declares one, so tools like fake servers can refer to operations type-safely.
+type Route[TInput any, TOutput any] struct {
+	Method string
+	Path   string
+}
+
+// String returns the route formatted as returned by GetRoute, e.g. "GET /status".
+func (r Route[TInput, TOutput]) String() string {
+	return r.Method + " " + r.Path
+}
+
+// InputType returns the type of the input, struct{} for operations without input.
+func (r Route[TInput, TOutput]) InputType() reflect.Type {
+	return reflect.TypeFor[TInput]()
+}
+
+// OutputType returns the type the response body is decoded into.
+func (r Route[TInput, TOutput]) OutputType() reflect.Type {
+	return reflect.TypeFor[TOutput]()
+}
@@ -0,0 +1,104 @@
+package request
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/xaroth/lib-esi-go/request/esierror"
+)
+
+// Validator collects the constraint violations of an input, with the locations and messages ESI uses.
+// The generated Validate methods of the inputs use it.
+type Validator struct {
+	details []esierror.ErrorDetails
+}
+
+func (v *Validator) add(location, message, value string) {
+	v.details = append(v.details, esierror.ErrorDetails{Message: message, Location: location, Value: value})
+}
+
+// Minimum checks that a number is at least minimum.
+func (v *Validator) Minimum(location string, value, minimum float64) {
+	if value < minimum {
+		v.add(location, "expected number >= "+formatFloat(minimum), formatFloat(value))
+	}
+}
+
+// Maximum checks that a number is at most maximum.
+func (v *Validator) Maximum(location string, value, maximum float64) {
+	if value > maximum {
+		v.add(location, "expected number <= "+formatFloat(maximum), formatFloat(value))
+	}
+}
+
+// MinLength checks that a string has at least minimum characters.
+func (v *Validator) MinLength(location, value string, minimum int) {
+	if utf8.RuneCountInString(value) < minimum {
+		v.add(location, "expected length >= "+strconv.Itoa(minimum), value)
+	}
+}
+
+// MaxLength checks that a string has at most maximum characters.
+func (v *Validator) MaxLength(location, value string, maximum int) {
+	if utf8.RuneCountInString(value) > maximum {
+		v.add(location, "expected length <= "+strconv.Itoa(maximum), value)
+	}
+}
+
+var patterns sync.Map // string → *regexp.Regexp
+
+// Pattern checks that a string matches a regular expression. The generator only emits patterns that compile.
+func (v *Validator) Pattern(location, value, pattern string) {
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		re, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	if !re.(*regexp.Regexp).MatchString(value) {
+		v.add(location, "expected string to match pattern "+pattern, value)
+	}
+}
+
+// MinItems checks that an array has at least minimum items.
+func (v *Validator) MinItems(location string, items, minimum int) {
+	if items < minimum {
+		v.add(location, "expected array length >= "+strconv.Itoa(minimum), strconv.Itoa(items))
+	}
+}
+
+// MaxItems checks that an array has at most maximum items.
+func (v *Validator) MaxItems(location string, items, maximum int) {
+	if items > maximum {
+		v.add(location, "expected array length <= "+strconv.Itoa(maximum), strconv.Itoa(items))
+	}
+}
+
+// Err returns an esierror.ValidationError with the violations, or nil if there are none.
+func (v *Validator) Err() error {
+	if len(v.details) == 0 {
+		return nil
+	}
+	return esierror.ValidationError{Details: v.details}
+}
+
+// UniqueItems checks that an array does not contain an item more than once.
+func UniqueItems[T comparable](v *Validator, location string, items []T) {
+	seen := make(map[T]bool, len(items))
+	for i, item := range items {
+		if seen[item] {
+			v.add(Index(location, i), "expected array items to be unique", fmt.Sprint(item))
+		}
+		seen[item] = true
+	}
+}
+
+// Index returns the location of an item of an array, e.g. body[3].
+func Index(location string, index int) string {
+	return location + "[" + strconv.Itoa(index) + "]"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
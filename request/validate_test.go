@@ -0,0 +1,143 @@
+package request_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/request"
+	"github.com/xaroth/lib-esi-go/request/esierror"
+)
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		validate func(v *request.Validator)
+		expected []esierror.ErrorDetails
+	}{
+		{
+			name: "valid",
+			validate: func(v *request.Validator) {
+				v.Minimum("query.page", 1, 1)
+				v.Maximum("query.page", 1, 1)
+				v.MinLength("body.name", "Jita", 1)
+				v.MaxLength("body.name", "Ōkami", 5)
+				v.Pattern("body.name", "Jita", "^[A-Za-z]+$")
+				v.MinItems("body", 1, 1)
+				v.MaxItems("body", 500, 500)
+				request.UniqueItems(v, "body", []int64{1, 2, 3})
+			},
+		},
+		{
+			name: "numbers",
+			validate: func(v *request.Validator) {
+				v.Minimum("query.page", 0, 1)
+				v.Maximum("body.amount", 1.5, 1)
+			},
+			expected: []esierror.ErrorDetails{
+				{Message: "expected number >= 1", Location: "query.page", Value: "0"},
+				{Message: "expected number <= 1", Location: "body.amount", Value: "1.5"},
+			},
+		},
+		{
+			name: "strings",
+			validate: func(v *request.Validator) {
+				v.MinLength("body.name", "", 1)
+				v.MaxLength("body.name", "Ōkamis", 5)
+				v.Pattern("body.name", "Jita 4-4", "^[A-Za-z]+$")
+			},
+			expected: []esierror.ErrorDetails{
+				{Message: "expected length >= 1", Location: "body.name", Value: ""},
+				{Message: "expected length <= 5", Location: "body.name", Value: "Ōkamis"},
+				{Message: "expected string to match pattern ^[A-Za-z]+$", Location: "body.name", Value: "Jita 4-4"},
+			},
+		},
+		{
+			name: "arrays",
+			validate: func(v *request.Validator) {
+				v.MinItems("body", 0, 1)
+				v.MaxItems("body", 501, 500)
+				request.UniqueItems(v, "body", []string{"a", "b", "a"})
+			},
+			expected: []esierror.ErrorDetails{
+				{Message: "expected array length >= 1", Location: "body", Value: "0"},
+				{Message: "expected array length <= 500", Location: "body", Value: "501"},
+				{Message: "expected array items to be unique", Location: "body[2]", Value: "a"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var v request.Validator
+			testCase.validate(&v)
+			err := v.Err()
+			if testCase.expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var validationErr esierror.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+			if diff := cmp.Diff(testCase.expected, validationErr.Details); diff != "" {
+				t.Errorf("details mismatch (-want +got):\n%s", diff)
+			}
+			var detail esierror.ErrorDetails
+			if !errors.As(err, &detail) || detail != testCase.expected[0] {
+				t.Errorf("errors.As found %+v, want the first details", detail)
+			}
+		})
+	}
+}
+
+type validatedInput struct {
+	Body []int64 `body:"json"`
+}
+
+func (i validatedInput) Validate() error {
+	var v request.Validator
+	v.MaxItems("body", len(i.Body), 2)
+	return v.Err()
+}
+
+type senderFunc func(req *http.Request) (*http.Response, error)
+
+func (f senderFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCreate_validates(t *testing.T) {
+	t.Parallel()
+
+	sent := false
+	sender := senderFunc(func(req *http.Request) (*http.Response, error) {
+		sent = true
+		return nil, errors.New("not implemented")
+	})
+	post := request.Create[validatedInput, struct{}](http.MethodPost, "/characters/affiliation")
+
+	_, err := post(t.Context(), sender, &validatedInput{Body: []int64{1, 2, 3}})
+	var validationErr esierror.ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Details) != 1 {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if sent {
+		t.Error("expected the invalid input not to be sent")
+	}
+
+	if _, err := post(t.Context(), sender, &validatedInput{Body: []int64{1, 2}}); err == nil || errors.As(err, &validationErr) {
+		t.Errorf("expected the valid input to be sent, got %v", err)
+	}
+	if !sent {
+		t.Error("expected the valid input to be sent")
+	}
+}
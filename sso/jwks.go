@@ -0,0 +1,181 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// KeySet holds the public keys used to sign access tokens, by key ID.
+type KeySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+type jwk struct {
+	KeyID   string `json:"kid"`
+	KeyType string `json:"kty"`
+	Use     string `json:"use"`
+
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC keys
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// ParseKeySet parses a JWKS document. Keys that are not RSA or P-256 signing keys are skipped.
+func ParseKeySet(data []byte) (*KeySet, error) {
+	var document struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("invalid key set: %w", err)
+	}
+
+	set := &KeySet{keys: make(map[string]crypto.PublicKey)}
+	for _, key := range document.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		var (
+			publicKey crypto.PublicKey
+			err       error
+		)
+		switch key.KeyType {
+		case "RSA":
+			publicKey, err = parseRSAKey(key)
+		case "EC":
+			if key.Curve != "P-256" {
+				continue
+			}
+			publicKey, err = parseECKey(key)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", key.KeyID, err)
+		}
+		set.keys[key.KeyID] = publicKey
+	}
+	return set, nil
+}
+
+// Key returns the public key with the given ID.
+func (s *KeySet) Key(keyID string) (crypto.PublicKey, bool) {
+	key, ok := s.keys[keyID]
+	return key, ok
+}
+
+func parseRSAKey(key jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func parseECKey(key jwk) (*ecdsa.PublicKey, error) {
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := base64.RawURLEncoding.DecodeString(key.Y)
+	if err != nil {
+		return nil, err
+	}
+	if len(x) != 32 || len(y) != 32 {
+		return nil, fmt.Errorf("invalid coordinates")
+	}
+	point := append(append([]byte{4}, x...), y...)
+	return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+}
+
+// keyCache fetches the key set from a URL, and fetches it again when it expires,
+// or when a token is signed with a key it does not know yet.
+type keyCache struct {
+	url           string
+	httpClient    *http.Client
+	cacheDuration time.Duration
+	minRefetch    time.Duration
+
+	mu        sync.Mutex
+	keys      *KeySet
+	fetchedAt time.Time
+}
+
+func (c *keyCache) key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keys == nil || time.Since(c.fetchedAt) > c.cacheDuration {
+		if err := c.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if key, ok := c.keys.Key(keyID); ok {
+		return key, nil
+	}
+
+	// The keys may have been rotated, but don't let unknown key IDs hammer the endpoint.
+	if time.Since(c.fetchedAt) > c.minRefetch {
+		if err := c.fetch(ctx); err != nil {
+			return nil, err
+		}
+		if key, ok := c.keys.Key(keyID); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %w %q", ErrInvalidToken, ErrUnknownKey, keyID)
+}
+
+func (c *keyCache) fetch(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch key set: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch key set: unexpected status %s", resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("fetch key set: %w", err)
+	}
+
+	keys, err := ParseKeySet(body)
+	if err != nil {
+		return err
+	}
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,244 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultLoginTimeout  = 5 * time.Minute
+	DefaultCallbackPath  = "/callback"
+	DefaultListenAddress = "127.0.0.1:0"
+
+	// shutdownTimeout is how long the callback server waits for the browser to receive the last response.
+	shutdownTimeout = 5 * time.Second
+)
+
+var (
+	ErrLoginTimeout = errors.New("login timed out")
+	ErrLoginFailed  = errors.New("login failed")
+)
+
+type loopbackConfig struct {
+	listenAddress string
+	callbackPath  string
+	timeout       time.Duration
+	openURL       func(ctx context.Context, authorizeURL string) error
+}
+
+type LoopbackOption func(*loopbackConfig)
+
+// NewLoopbackConfig derives the listen address and callback path from the redirect URL of the client,
+// if that points at a loopback address, and listens on a random port otherwise.
+func NewLoopbackConfig(redirectURL string, opts ...LoopbackOption) *loopbackConfig {
+	c := &loopbackConfig{
+		listenAddress: DefaultListenAddress,
+		callbackPath:  DefaultCallbackPath,
+		timeout:       DefaultLoginTimeout,
+		openURL:       OpenBrowser,
+	}
+	if u, err := url.Parse(redirectURL); err == nil && isLoopback(u.Hostname()) {
+		port := u.Port()
+		if port == "" {
+			port = "0"
+		}
+		c.listenAddress = net.JoinHostPort(u.Hostname(), port)
+		if u.Path != "" {
+			c.callbackPath = u.Path
+		}
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithListenAddress sets the address the callback server listens on. Use port 0 for a random port.
+func WithListenAddress(address string) LoopbackOption {
+	return func(c *loopbackConfig) {
+		c.listenAddress = address
+	}
+}
+
+// WithCallbackPath sets the path the SSO redirects back to.
+func WithCallbackPath(path string) LoopbackOption {
+	return func(c *loopbackConfig) {
+		c.callbackPath = path
+	}
+}
+
+// WithLoginTimeout sets how long to wait for the user to log in.
+func WithLoginTimeout(timeout time.Duration) LoopbackOption {
+	return func(c *loopbackConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithOpenURL sets how the authorize URL is shown to the user. Defaults to OpenBrowser.
+func WithOpenURL(openURL func(ctx context.Context, authorizeURL string) error) LoopbackOption {
+	return func(c *loopbackConfig) {
+		c.openURL = openURL
+	}
+}
+
+// PrintURL prints the authorize URL to w, for environments without a browser.
+func PrintURL(w io.Writer) func(ctx context.Context, authorizeURL string) error {
+	return func(ctx context.Context, authorizeURL string) error {
+		_, err := fmt.Fprintf(w, "Open the following URL to log in:\n\n%s\n\n", authorizeURL)
+		return err
+	}
+}
+
+// OpenBrowser opens the authorize URL in the default browser, and prints it to stderr as well
+// in case no browser is available.
+func OpenBrowser(ctx context.Context, authorizeURL string) error {
+	if err := PrintURL(os.Stderr)(ctx, authorizeURL); err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.CommandContext(ctx, "rundll32", "url.dll,FileProtocolHandler", authorizeURL)
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "open", authorizeURL)
+	default:
+		cmd = exec.CommandContext(ctx, "xdg-open", authorizeURL)
+	}
+	// The URL was printed, so failing to start a browser is not an error.
+	if err := cmd.Start(); err == nil {
+		go func() { _ = cmd.Wait() }()
+	}
+	return nil
+}
+
+// callbackResult is the outcome of the first request to the callback path with a valid state.
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// LoopbackLogin logs a character in from a desktop or CLI application.
+// It starts a one-shot callback server on a loopback address, opens the authorize URL,
+// waits for the SSO to redirect back, verifies the state, and exchanges the code for a token.
+//
+// The redirect URL sent to the SSO uses the address the server actually listens on,
+// so it has to match the callback URL registered for the application.
+func (c *Client) LoopbackLogin(ctx context.Context, scopes []string, opts ...LoopbackOption) (*Token, error) {
+	config := NewLoopbackConfig(c.redirectURL, opts...)
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", config.listenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	login := *c
+	login.redirectURL = (&url.URL{
+		Scheme: "http",
+		Host:   loopbackHost(c.redirectURL, listener.Addr()),
+		Path:   config.callbackPath,
+	}).String()
+
+	auth, err := login.Authorize(scopes)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	results := make(chan callbackResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(config.callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		// Requests without the state of this login, e.g. from another local process, are rejected
+		// without ending the login.
+		if err := auth.VerifyState(query.Get("state")); err != nil {
+			http.Error(w, "Login failed: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		result := callback(query)
+		if result.err != nil {
+			http.Error(w, "Login failed: "+result.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = io.WriteString(w, "Login successful, you can close this window.\n")
+		}
+		// Only the first verified callback counts.
+		select {
+		case results <- result:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = server.Serve(listener) }()
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := config.openURL(ctx, auth.URL); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(config.timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-results:
+		if result.err != nil {
+			return nil, result.err
+		}
+		return login.Exchange(ctx, result.code, auth.Verifier)
+	case <-timer.C:
+		return nil, ErrLoginTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// callback returns the authorization code of the query the SSO redirected back with, once its state is verified.
+func callback(query url.Values) callbackResult {
+	if code := query.Get("error"); code != "" {
+		if description := query.Get("error_description"); description != "" {
+			return callbackResult{err: fmt.Errorf("%w: %s: %s", ErrLoginFailed, code, description)}
+		}
+		return callbackResult{err: fmt.Errorf("%w: %s", ErrLoginFailed, code)}
+	}
+	code := query.Get("code")
+	if code == "" {
+		return callbackResult{err: fmt.Errorf("%w: missing code", ErrLoginFailed)}
+	}
+	return callbackResult{code: code}
+}
+
+// loopbackHost keeps the host name of the configured redirect URL, e.g. localhost, with the port that is listened on.
+func loopbackHost(redirectURL string, addr net.Addr) string {
+	host := "127.0.0.1"
+	if u, err := url.Parse(redirectURL); err == nil && isLoopback(u.Hostname()) {
+		host = u.Hostname()
+	}
+	port := "0"
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		port = strconv.Itoa(tcpAddr.Port)
+	}
+	return net.JoinHostPort(host, port)
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,231 @@
+package sso_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/sso"
+)
+
+// authServer is a fake EVE SSO, with an authorize endpoint that redirects straight back with a code,
+// and a token endpoint that checks the PKCE verifier of that code.
+type authServer struct {
+	*httptest.Server
+
+	// redirect rewrites the callback query before redirecting back, e.g. to forge the state.
+	redirect func(query url.Values)
+
+	mu          sync.Mutex
+	redirectURI string
+	challenge   string
+}
+
+func newAuthServer(t *testing.T, redirect func(query url.Values)) *authServer {
+	t.Helper()
+
+	s := &authServer{redirect: redirect}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		s.mu.Lock()
+		s.redirectURI = query.Get("redirect_uri")
+		s.challenge = query.Get("code_challenge")
+		s.mu.Unlock()
+
+		callback := url.Values{"code": {"the-code"}, "state": {query.Get("state")}}
+		if s.redirect != nil {
+			s.redirect(callback)
+		}
+		http.Redirect(w, r, query.Get("redirect_uri")+"?"+callback.Encode(), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: %v", err)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "the-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != s.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  accessToken(t, 42, []string{"esi-skills.read_skills.v1"}),
+			"expires_in":    1199,
+			"refresh_token": "refresh-1",
+		})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *authServer) RedirectURI() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.redirectURI
+}
+
+// browser follows the authorize URL like a browser would, ending up at the callback server.
+func browser(ctx context.Context, authorizeURL string) error {
+	go func() {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, authorizeURL, nil)
+		if err != nil {
+			return
+		}
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	return nil
+}
+
+func TestLoopbackLogin(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		redirect      func(query url.Values)
+		openURL       func(ctx context.Context, authorizeURL string) error
+		expectedError error
+	}{
+		{
+			name:    "success",
+			openURL: browser,
+		},
+		{
+			name:          "error: forged state",
+			redirect:      func(query url.Values) { query.Set("state", "forged") },
+			openURL:       browser,
+			expectedError: sso.ErrLoginTimeout,
+		},
+		{
+			name: "error: login denied",
+			redirect: func(query url.Values) {
+				query.Del("code")
+				query.Set("error", "access_denied")
+			},
+			openURL:       browser,
+			expectedError: sso.ErrLoginFailed,
+		},
+		{
+			name:          "error: timeout",
+			openURL:       func(ctx context.Context, authorizeURL string) error { return nil },
+			expectedError: sso.ErrLoginTimeout,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newAuthServer(t, testCase.redirect)
+			client := sso.New("client-id", "http://localhost/callback",
+				sso.WithAuthorizeURL(server.URL+"/authorize"),
+				sso.WithTokenURL(server.URL+"/token"),
+			)
+
+			var authorizeURL string
+			token, err := client.LoopbackLogin(t.Context(), []string{"esi-skills.read_skills.v1"},
+				sso.WithLoginTimeout(time.Second),
+				sso.WithOpenURL(func(ctx context.Context, u string) error {
+					authorizeURL = u
+					return testCase.openURL(ctx, u)
+				}),
+			)
+			if !errors.Is(err, testCase.expectedError) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedError, err)
+			}
+
+			u, err := url.Parse(authorizeURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			redirectURI, err := url.Parse(u.Query().Get("redirect_uri"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if redirectURI.Hostname() != "localhost" || redirectURI.Port() == "" || redirectURI.Port() == "0" || redirectURI.Path != "/callback" {
+				t.Fatalf("unexpected redirect URI: %s", redirectURI)
+			}
+
+			// The callback server is shut down once the login completes.
+			if resp, err := http.Get(redirectURI.String()); err == nil {
+				_ = resp.Body.Close()
+				t.Fatal("expected the callback server to be shut down")
+			}
+
+			if testCase.expectedError != nil {
+				return
+			}
+			if server.RedirectURI() != redirectURI.String() {
+				t.Fatalf("expected the SSO to redirect to %s, got %s", redirectURI, server.RedirectURI())
+			}
+			if token.Owner() != 42 || token.RefreshToken() != "refresh-1" {
+				t.Fatalf("unexpected token for %d: %q", token.Owner(), token.RefreshToken())
+			}
+		})
+	}
+}
+
+func TestLoopbackLogin_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	client := sso.New("client-id", "http://127.0.0.1/callback")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	_, err := client.LoopbackLogin(ctx, nil, sso.WithOpenURL(func(ctx context.Context, authorizeURL string) error {
+		cancel()
+		return nil
+	}))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestLoopbackLogin_forgedStateFirst(t *testing.T) {
+	t.Parallel()
+
+	server := newAuthServer(t, nil)
+	client := sso.New("client-id", "http://localhost/callback",
+		sso.WithAuthorizeURL(server.URL+"/authorize"),
+		sso.WithTokenURL(server.URL+"/token"),
+	)
+
+	token, err := client.LoopbackLogin(t.Context(), []string{"esi-skills.read_skills.v1"},
+		sso.WithLoginTimeout(time.Second),
+		sso.WithOpenURL(func(ctx context.Context, authorizeURL string) error {
+			u, err := url.Parse(authorizeURL)
+			if err != nil {
+				return err
+			}
+			// A request with the wrong state is rejected, without ending the login.
+			resp, err := http.Get(u.Query().Get("redirect_uri") + "?code=forged&state=forged")
+			if err != nil {
+				return err
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("expected status %d for a forged state, got %d", http.StatusBadRequest, resp.StatusCode)
+			}
+			return browser(ctx, authorizeURL)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("failed to log in: %v", err)
+	}
+	if token.Owner() != 42 {
+		t.Fatalf("expected a token for 42, got %d", token.Owner())
+	}
+}
@@ -0,0 +1,79 @@
+package sso
+
+import (
+	"net/http"
+	"time"
+)
+
+type config struct {
+	clientSecret  string
+	authorizeURL  string
+	tokenURL      string
+	revokeURL     string
+	httpClient    *http.Client
+	refreshMargin time.Duration
+	verifier      *Verifier
+}
+
+type Option func(*config)
+
+func NewConfig(opts ...Option) *config {
+	c := &config{
+		authorizeURL:  DefaultAuthorizeURL,
+		tokenURL:      DefaultTokenURL,
+		revokeURL:     DefaultRevokeURL,
+		httpClient:    http.DefaultClient,
+		refreshMargin: DefaultRefreshMargin,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithClientSecret authenticates to the token endpoint as a confidential client.
+// Without a secret, the client relies on PKCE alone.
+func WithClientSecret(secret string) Option {
+	return func(c *config) {
+		c.clientSecret = secret
+	}
+}
+
+func WithAuthorizeURL(authorizeURL string) Option {
+	return func(c *config) {
+		c.authorizeURL = authorizeURL
+	}
+}
+
+func WithTokenURL(tokenURL string) Option {
+	return func(c *config) {
+		c.tokenURL = tokenURL
+	}
+}
+
+func WithRevokeURL(revokeURL string) Option {
+	return func(c *config) {
+		c.revokeURL = revokeURL
+	}
+}
+
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *config) {
+		c.httpClient = client
+	}
+}
+
+// WithRefreshMargin sets how long before it expires a token is refreshed.
+func WithRefreshMargin(margin time.Duration) Option {
+	return func(c *config) {
+		c.refreshMargin = margin
+	}
+}
+
+// WithVerifier verifies every access token received from the token endpoint,
+// instead of trusting its claims.
+func WithVerifier(verifier *Verifier) Option {
+	return func(c *config) {
+		c.verifier = verifier
+	}
+}
@@ -0,0 +1,262 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+const (
+	DefaultAuthorizeURL  = "https://login.eveonline.com/v2/oauth/authorize"
+	DefaultTokenURL      = "https://login.eveonline.com/v2/oauth/token"
+	DefaultRevokeURL     = "https://login.eveonline.com/v2/oauth/revoke"
+	DefaultRefreshMargin = time.Minute
+)
+
+var (
+	ErrInvalidState  = errors.New("invalid state")
+	ErrInvalidGrant  = authentication.ErrInvalidGrant
+	ErrTokenEndpoint = errors.New("token endpoint error")
+)
+
+var (
+	_ authentication.Refresher = (*Client)(nil)
+	_ authentication.Revoker   = (*Client)(nil)
+)
+
+// Client implements the EVE SSO OAuth2 authorization code flow with PKCE.
+type Client struct {
+	clientID    string
+	redirectURL string
+
+	clientSecret  string
+	authorizeURL  string
+	tokenURL      string
+	revokeURL     string
+	httpClient    *http.Client
+	refreshMargin time.Duration
+	verifier      *Verifier
+}
+
+// New creates an SSO client for the application with the given client ID and callback URL.
+func New(clientID, redirectURL string, opts ...Option) *Client {
+	config := NewConfig(opts...)
+
+	return &Client{
+		clientID:      clientID,
+		redirectURL:   redirectURL,
+		clientSecret:  config.clientSecret,
+		authorizeURL:  config.authorizeURL,
+		tokenURL:      config.tokenURL,
+		revokeURL:     config.revokeURL,
+		httpClient:    config.httpClient,
+		refreshMargin: config.refreshMargin,
+		verifier:      config.verifier,
+	}
+}
+
+// Authorization is a pending login. Keep it until the user returns to the callback URL,
+// then verify the returned state and exchange the code with its verifier.
+type Authorization struct {
+	// The URL to send the user to.
+	URL string
+
+	// The state that must be returned to the callback URL.
+	State string
+
+	// The PKCE code verifier, required to exchange the code.
+	Verifier string
+}
+
+// VerifyState returns ErrInvalidState if the state returned to the callback URL does not match.
+func (a *Authorization) VerifyState(state string) error {
+	if subtle.ConstantTimeCompare([]byte(a.State), []byte(state)) != 1 {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+// Authorize starts a login requesting the given scopes, with a random state and PKCE verifier.
+func (c *Client) Authorize(scopes []string) (*Authorization, error) {
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizeURL, err := url.Parse(c.authorizeURL)
+	if err != nil {
+		return nil, err
+	}
+	query := authorizeURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", c.clientID)
+	query.Set("redirect_uri", c.redirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	authorizeURL.RawQuery = query.Encode()
+
+	return &Authorization{
+		URL:      authorizeURL.String(),
+		State:    state,
+		Verifier: verifier,
+	}, nil
+}
+
+// Exchange exchanges the authorization code returned to the callback URL for a token.
+func (c *Client) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("code_verifier", verifier)
+
+	resp, err := c.requestToken(ctx, form)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{client: c}
+	if err := token.update(ctx, resp); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Restore creates a token from a stored refresh token, refreshing it right away
+// so the owner and scopes are known.
+func (c *Client) Restore(ctx context.Context, refreshToken string) (*Token, error) {
+	token := &Token{client: c, refreshToken: refreshToken}
+	if err := token.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// RefreshGrant refreshes a stored refresh token, so the client can be used as the Refresher of an authentication.Manager.
+func (c *Client) RefreshGrant(ctx context.Context, refreshToken string) (*authentication.Grant, error) {
+	token, err := c.Restore(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return token.Grant(), nil
+}
+
+// RevokeToken revokes a refresh token, so it can no longer be used to log in. Access tokens issued for it
+// remain valid until they expire. The client is a Revoker for an authentication.Manager.
+func (c *Client) RevokeToken(ctx context.Context, refreshToken string) error {
+	form := url.Values{}
+	form.Set("token_type_hint", "refresh_token")
+	form.Set("token", refreshToken)
+
+	_, err := c.post(ctx, c.revokeURL, form)
+	return err
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenError is an error response from the token endpoint.
+// It matches ErrInvalidGrant (which is authentication.ErrInvalidGrant) when the grant was rejected, e.g. because the refresh token was revoked.
+type TokenError struct {
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *TokenError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("%s: %d %s: %s", ErrTokenEndpoint, e.StatusCode, e.Code, e.Description)
+	}
+	return fmt.Sprintf("%s: %d %s", ErrTokenEndpoint, e.StatusCode, e.Code)
+}
+
+func (e *TokenError) Is(target error) bool {
+	return target == ErrTokenEndpoint || (target == ErrInvalidGrant && e.Code == "invalid_grant")
+}
+
+func (c *Client) requestToken(ctx context.Context, form url.Values) (*tokenResponse, error) {
+	body, err := c.post(ctx, c.tokenURL, form)
+	if err != nil {
+		return nil, err
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTokenEndpoint, err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("%w: missing access token", ErrTokenEndpoint)
+	}
+	return &token, nil
+}
+
+// post sends an authenticated form to an SSO endpoint, and returns a TokenError if it was not accepted.
+func (c *Client) post(ctx context.Context, endpoint string, form url.Values) ([]byte, error) {
+	if c.clientSecret == "" {
+		form.Set("client_id", c.clientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.clientSecret != "" {
+		req.SetBasicAuth(c.clientID, c.clientSecret)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		tokenErr := &TokenError{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(body, tokenErr)
+		return nil, tokenErr
+	}
+	return body, nil
+}
+
+// randomString returns 32 random bytes, base64url encoded; long enough for both state and PKCE verifiers.
+func randomString() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
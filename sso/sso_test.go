@@ -0,0 +1,253 @@
+package sso_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+	"github.com/xaroth/lib-esi-go/sso"
+)
+
+// accessToken builds an unsigned JWT with the claims of an EVE SSO access token.
+func accessToken(t *testing.T, owner int64, scopes any) string {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]any{
+		"sub": fmt.Sprintf("CHARACTER:EVE:%d", owner),
+		"scp": scopes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"RS256"}`)) + "." + encode(payload) + "." + encode([]byte("signature"))
+}
+
+// tokenServer is a stand-in for the SSO token endpoint.
+type tokenServer struct {
+	*httptest.Server
+	requests atomic.Int64
+}
+
+func newTokenServer(t *testing.T, handle func(t *testing.T, form url.Values) (int, any)) *tokenServer {
+	t.Helper()
+
+	s := &tokenServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: %v", err)
+		}
+		status, body := handle(t, r.PostForm)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	client := sso.New("client-id", "http://localhost/callback", sso.WithAuthorizeURL("https://login.example.com/authorize"))
+
+	auth, err := client.Authorize([]string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(auth.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte(auth.Verifier))
+	expected := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"client-id"},
+		"redirect_uri":          {"http://localhost/callback"},
+		"scope":                 {"esi-location.read_location.v1 esi-skills.read_skills.v1"},
+		"state":                 {auth.State},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}
+	if diff := cmp.Diff(expected, u.Query()); diff != "" {
+		t.Fatalf("authorize query mismatch (-want +got): %s", diff)
+	}
+	if u.Host != "login.example.com" || u.Path != "/authorize" {
+		t.Fatalf("unexpected authorize URL: %s", auth.URL)
+	}
+	if len(auth.Verifier) < 43 {
+		t.Fatalf("PKCE verifier too short: %q", auth.Verifier)
+	}
+
+	if err := auth.VerifyState(auth.State); err != nil {
+		t.Fatalf("expected state to verify: %v", err)
+	}
+	if err := auth.VerifyState("forged"); !errors.Is(err, sso.ErrInvalidState) {
+		t.Fatalf("expected ErrInvalidState, got %v", err)
+	}
+
+	other, err := client.Authorize(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.State == auth.State || other.Verifier == auth.Verifier {
+		t.Fatal("expected a fresh state and verifier for every authorization")
+	}
+}
+
+func TestExchange(t *testing.T) {
+	t.Parallel()
+
+	server := newTokenServer(t, func(t *testing.T, form url.Values) (int, any) {
+		expected := url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"the-code"},
+			"code_verifier": {"the-verifier"},
+			"client_id":     {"client-id"},
+		}
+		if diff := cmp.Diff(expected, form); diff != "" {
+			t.Errorf("token request mismatch (-want +got): %s", diff)
+		}
+		return http.StatusOK, map[string]any{
+			"access_token":  accessToken(t, 123456789, []string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"}),
+			"expires_in":    1199,
+			"token_type":    "Bearer",
+			"refresh_token": "refresh-1",
+		}
+	})
+
+	client := sso.New("client-id", "http://localhost/callback", sso.WithTokenURL(server.URL))
+	token, err := client.Exchange(t.Context(), "the-code", "the-verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var _ authentication.ScopedToken = token
+	if token.Owner() != 123456789 {
+		t.Fatalf("expected owner 123456789, got %d", token.Owner())
+	}
+	if diff := cmp.Diff([]string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"}, token.Scopes()); diff != "" {
+		t.Fatalf("scopes mismatch (-want +got): %s", diff)
+	}
+	if token.RefreshToken() != "refresh-1" {
+		t.Fatalf("expected refresh token, got %q", token.RefreshToken())
+	}
+	if until := time.Until(token.ExpiresAt()); until < 19*time.Minute || until > 20*time.Minute {
+		t.Fatalf("unexpected expiry in %s", until)
+	}
+}
+
+func TestExchange_confidentialClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client-id" || secret != "secret" {
+			t.Errorf("expected basic auth with the client credentials, got %q %q", id, secret)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": accessToken(t, 1, "publicData"),
+			"expires_in":   1199,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := sso.New("client-id", "http://localhost/callback", sso.WithTokenURL(server.URL), sso.WithClientSecret("secret"))
+	token, err := client.Exchange(t.Context(), "the-code", "the-verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"publicData"}, token.Scopes()); diff != "" {
+		t.Fatalf("scopes mismatch (-want +got): %s", diff)
+	}
+}
+
+func TestRefreshIfNeeded(t *testing.T) {
+	t.Parallel()
+
+	server := newTokenServer(t, func(t *testing.T, form url.Values) (int, any) {
+		switch form.Get("refresh_token") {
+		case "refresh-1":
+			// An access token that is already within the refresh margin.
+			return http.StatusOK, map[string]any{
+				"access_token":  accessToken(t, 1, []string{}),
+				"expires_in":    30,
+				"refresh_token": "refresh-2",
+			}
+		case "refresh-2":
+			return http.StatusOK, map[string]any{
+				"access_token":  accessToken(t, 1, []string{}),
+				"expires_in":    1199,
+				"refresh_token": "refresh-3",
+			}
+		default:
+			return http.StatusBadRequest, map[string]any{
+				"error":             "invalid_grant",
+				"error_description": "Invalid refresh token. Token missing/expired.",
+			}
+		}
+	})
+
+	client := sso.New("client-id", "http://localhost/callback", sso.WithTokenURL(server.URL))
+	token, err := client.Restore(t.Context(), "refresh-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The token expires within the refresh margin, so it is refreshed once with the rotated refresh token.
+	for range 3 {
+		if err := token.RefreshIfNeeded(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := server.requests.Load(); got != 2 {
+		t.Fatalf("expected 2 token requests, got %d", got)
+	}
+	if token.RefreshToken() != "refresh-3" {
+		t.Fatalf("expected the rotated refresh token, got %q", token.RefreshToken())
+	}
+
+	if _, err := client.Restore(t.Context(), "revoked"); !errors.Is(err, sso.ErrInvalidGrant) {
+		t.Fatalf("expected ErrInvalidGrant, got %v", err)
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Parallel()
+
+	server := newTokenServer(t, func(t *testing.T, form url.Values) (int, any) {
+		switch form.Get("token") {
+		case "refresh-1":
+			expected := url.Values{
+				"token_type_hint": {"refresh_token"},
+				"token":           {"refresh-1"},
+				"client_id":       {"client-id"},
+			}
+			if diff := cmp.Diff(expected, form); diff != "" {
+				t.Errorf("revoke request mismatch (-want +got): %s", diff)
+			}
+			return http.StatusOK, nil
+		default:
+			return http.StatusBadRequest, map[string]any{"error": "invalid_request"}
+		}
+	})
+
+	client := sso.New("client-id", "http://localhost/callback", sso.WithRevokeURL(server.URL))
+	if err := client.RevokeToken(t.Context(), "refresh-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RevokeToken(t.Context(), "unknown"); !errors.Is(err, sso.ErrTokenEndpoint) {
+		t.Fatalf("expected ErrTokenEndpoint, got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+var (
+	_ authentication.Token            = (*Token)(nil)
+	_ authentication.RefreshableToken = (*Token)(nil)
+	_ authentication.ScopedToken      = (*Token)(nil)
+)
+
+// Token is an EVE SSO token, refreshed just in time when used for a request.
+type Token struct {
+	client *Client
+
+	mu           sync.RWMutex
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+	owner        int64
+	scopes       []string
+}
+
+// Owner returns the character ID the token belongs to.
+func (t *Token) Owner() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.owner
+}
+
+// Token returns the current access token.
+func (t *Token) Token() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.accessToken
+}
+
+// Scopes returns the scopes granted to the token.
+func (t *Token) Scopes() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.scopes
+}
+
+// RefreshToken returns the current refresh token, which may change after every refresh.
+func (t *Token) RefreshToken() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.refreshToken
+}
+
+// ExpiresAt returns the time the current access token expires.
+func (t *Token) ExpiresAt() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.expiresAt
+}
+
+// Grant returns the current state of the token, e.g. to add it to an authentication.Manager.
+func (t *Token) Grant() *authentication.Grant {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return &authentication.Grant{
+		Owner:        t.owner,
+		Scopes:       t.scopes,
+		AccessToken:  t.accessToken,
+		RefreshToken: t.refreshToken,
+		ExpiresAt:    t.expiresAt,
+	}
+}
+
+// RefreshIfNeeded refreshes the token if it expires within the refresh margin.
+// Concurrent callers wait for a single refresh.
+func (t *Token) RefreshIfNeeded(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if time.Until(t.expiresAt) > t.client.refreshMargin {
+		return nil
+	}
+	return t.refresh(ctx)
+}
+
+// Refresh refreshes the token, regardless of when it expires.
+func (t *Token) Refresh(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.refresh(ctx)
+}
+
+// Revoke revokes the refresh token at the SSO, and clears the token so it is no longer used.
+func (t *Token) Revoke(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.client.RevokeToken(ctx, t.refreshToken); err != nil {
+		return err
+	}
+	t.accessToken = ""
+	t.refreshToken = ""
+	t.expiresAt = time.Time{}
+	return nil
+}
+
+func (t *Token) refresh(ctx context.Context) error {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", t.refreshToken)
+
+	resp, err := t.client.requestToken(ctx, form)
+	if err != nil {
+		return err
+	}
+	return t.apply(ctx, resp)
+}
+
+// update applies a token response while not holding the lock.
+func (t *Token) update(ctx context.Context, resp *tokenResponse) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.apply(ctx, resp)
+}
+
+func (t *Token) apply(ctx context.Context, resp *tokenResponse) error {
+	var (
+		claims *Claims
+		err    error
+	)
+	if t.client.verifier != nil {
+		claims, err = t.client.verifier.Verify(ctx, resp.AccessToken)
+	} else {
+		claims, err = parseClaims(resp.AccessToken)
+	}
+	if err != nil {
+		return err
+	}
+
+	t.accessToken = resp.AccessToken
+	if resp.RefreshToken != "" {
+		// The SSO rotates refresh tokens, the previous one may no longer be valid.
+		t.refreshToken = resp.RefreshToken
+	}
+	t.expiresAt = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	t.owner = claims.Owner
+	t.scopes = claims.Scopes
+	return nil
+}
+
+// parseClaims reads the claims from an access token without verifying its signature.
+// This is fine for tokens received directly from the token endpoint; use a Verifier for anything else.
+func parseClaims(accessToken string) (*Claims, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: access token is not a JWT", ErrTokenEndpoint)
+	}
+
+	var raw jwtClaims
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, fmt.Errorf("%w: invalid access token claims: %w", ErrTokenEndpoint, err)
+	}
+	return raw.claims()
+}
+
+// ParseSubject returns the character ID from a subject claim, e.g. `CHARACTER:EVE:123456789`.
+func ParseSubject(subject string) (int64, error) {
+	id, ok := strings.CutPrefix(subject, "CHARACTER:EVE:")
+	if !ok {
+		return 0, fmt.Errorf("%w: unexpected subject %q", ErrInvalidToken, subject)
+	}
+	owner, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unexpected subject %q", ErrInvalidToken, subject)
+	}
+	return owner, nil
+}
@@ -0,0 +1,336 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
+)
+
+const (
+	DefaultJWKSURL            = "https://login.eveonline.com/oauth/jwks"
+	DefaultAudience           = "EVE Online"
+	DefaultKeyCacheDuration   = time.Hour
+	DefaultKeyRefetchInterval = time.Minute
+	DefaultLeeway             = 5 * time.Second
+)
+
+// DefaultIssuers are the issuers used by EVE SSO access tokens.
+var DefaultIssuers = []string{"https://login.eveonline.com", "login.eveonline.com"}
+
+var (
+	ErrInvalidToken     = errors.New("invalid token")
+	ErrUnknownKey       = errors.New("unknown key")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrInvalidIssuer    = errors.New("invalid issuer")
+	ErrInvalidAudience  = errors.New("invalid audience")
+	ErrTokenExpired     = errors.New("token expired")
+)
+
+var (
+	_ authentication.Token       = (*VerifiedToken)(nil)
+	_ authentication.ScopedToken = (*VerifiedToken)(nil)
+)
+
+type verifierConfig struct {
+	jwksURL          string
+	httpClient       *http.Client
+	keyCacheDuration time.Duration
+	staticKeys       *KeySet
+	issuers          []string
+	audiences        []string
+	leeway           time.Duration
+}
+
+type VerifierOption func(*verifierConfig)
+
+func NewVerifierConfig(clientID string, opts ...VerifierOption) *verifierConfig {
+	c := &verifierConfig{
+		jwksURL:          DefaultJWKSURL,
+		httpClient:       http.DefaultClient,
+		keyCacheDuration: DefaultKeyCacheDuration,
+		issuers:          DefaultIssuers,
+		audiences:        []string{clientID, DefaultAudience},
+		leeway:           DefaultLeeway,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func WithJWKSURL(jwksURL string) VerifierOption {
+	return func(c *verifierConfig) {
+		c.jwksURL = jwksURL
+	}
+}
+
+func WithJWKSHTTPClient(client *http.Client) VerifierOption {
+	return func(c *verifierConfig) {
+		c.httpClient = client
+	}
+}
+
+// WithKeyCacheDuration sets how long a fetched key set is used before it is fetched again.
+func WithKeyCacheDuration(duration time.Duration) VerifierOption {
+	return func(c *verifierConfig) {
+		c.keyCacheDuration = duration
+	}
+}
+
+// WithStaticKeys verifies tokens against a fixed key set, without fetching the JWKS document.
+func WithStaticKeys(keys *KeySet) VerifierOption {
+	return func(c *verifierConfig) {
+		c.staticKeys = keys
+	}
+}
+
+// WithIssuers sets the accepted issuers.
+func WithIssuers(issuers ...string) VerifierOption {
+	return func(c *verifierConfig) {
+		c.issuers = issuers
+	}
+}
+
+// WithAudiences sets the audiences that must all be present in a token.
+func WithAudiences(audiences ...string) VerifierOption {
+	return func(c *verifierConfig) {
+		c.audiences = audiences
+	}
+}
+
+// WithLeeway sets how long after it expires a token is still accepted, to account for clock skew.
+func WithLeeway(leeway time.Duration) VerifierOption {
+	return func(c *verifierConfig) {
+		c.leeway = leeway
+	}
+}
+
+// Verifier verifies EVE SSO access tokens, which are JWTs signed with the keys in the SSO's JWKS document.
+type Verifier struct {
+	keys      func(ctx context.Context, keyID string) (crypto.PublicKey, error)
+	issuers   []string
+	audiences []string
+	leeway    time.Duration
+}
+
+// NewVerifier creates a verifier for access tokens issued to the application with the given client ID.
+func NewVerifier(clientID string, opts ...VerifierOption) *Verifier {
+	config := NewVerifierConfig(clientID, opts...)
+
+	v := &Verifier{
+		issuers:   config.issuers,
+		audiences: config.audiences,
+		leeway:    config.leeway,
+	}
+	if config.staticKeys != nil {
+		v.keys = func(_ context.Context, keyID string) (crypto.PublicKey, error) {
+			if key, ok := config.staticKeys.Key(keyID); ok {
+				return key, nil
+			}
+			return nil, fmt.Errorf("%w: %w %q", ErrInvalidToken, ErrUnknownKey, keyID)
+		}
+	} else {
+		cache := &keyCache{
+			url:           config.jwksURL,
+			httpClient:    config.httpClient,
+			cacheDuration: config.keyCacheDuration,
+			minRefetch:    DefaultKeyRefetchInterval,
+		}
+		v.keys = cache.key
+	}
+	return v
+}
+
+// Claims are the verified claims of an access token.
+type Claims struct {
+	// The character ID the token belongs to.
+	Owner int64
+
+	// The name of the character.
+	Name string
+
+	// The scopes granted to the token.
+	Scopes []string
+
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Name      string          `json:"name"`
+	Scopes    json.RawMessage `json:"scp"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+}
+
+// Verify checks the signature, issuer, audience, and expiry of an access token, and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, accessToken string) (*Claims, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: not a JWT", ErrInvalidToken)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %w", ErrInvalidToken, err)
+	}
+	key, err := v.keys(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %w", ErrInvalidToken, err)
+	}
+	if err := verifySignature(header.Algorithm, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var raw jwtClaims
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrInvalidToken, err)
+	}
+	claims, err := raw.claims()
+	if err != nil {
+		return nil, err
+	}
+
+	if raw.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: missing exp claim", ErrInvalidToken)
+	}
+	if time.Now().After(claims.ExpiresAt.Add(v.leeway)) {
+		return nil, fmt.Errorf("%w: %w at %s", ErrInvalidToken, ErrTokenExpired, claims.ExpiresAt)
+	}
+	if !slices.Contains(v.issuers, claims.Issuer) {
+		return nil, fmt.Errorf("%w: %w %q", ErrInvalidToken, ErrInvalidIssuer, claims.Issuer)
+	}
+	for _, audience := range v.audiences {
+		if !slices.Contains(claims.Audience, audience) {
+			return nil, fmt.Errorf("%w: %w, missing %q", ErrInvalidToken, ErrInvalidAudience, audience)
+		}
+	}
+	return claims, nil
+}
+
+// Token verifies an access token, and returns a token whose owner and scopes come from its verified claims.
+func (v *Verifier) Token(ctx context.Context, accessToken string) (*VerifiedToken, error) {
+	claims, err := v.Verify(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifiedToken{accessToken: accessToken, claims: claims}, nil
+}
+
+// VerifiedToken is an access token with verified claims.
+type VerifiedToken struct {
+	accessToken string
+	claims      *Claims
+}
+
+func (t *VerifiedToken) Owner() int64     { return t.claims.Owner }
+func (t *VerifiedToken) Token() string    { return t.accessToken }
+func (t *VerifiedToken) Scopes() []string { return t.claims.Scopes }
+func (t *VerifiedToken) Claims() *Claims  { return t.claims }
+
+func verifySignature(algorithm string, key crypto.PublicKey, signed string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signed))
+
+	switch algorithm {
+	case "RS256":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %w: key does not match %s", ErrInvalidToken, ErrInvalidSignature, algorithm)
+		}
+		if err := rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidToken, ErrInvalidSignature)
+		}
+		return nil
+	case "ES256":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(signature) != 64 {
+			return fmt.Errorf("%w: %w: key does not match %s", ErrInvalidToken, ErrInvalidSignature, algorithm)
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(ecKey, digest[:], r, s) {
+			return fmt.Errorf("%w: %w", ErrInvalidToken, ErrInvalidSignature)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, algorithm)
+	}
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (c jwtClaims) claims() (*Claims, error) {
+	owner, err := ParseSubject(c.Subject)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := stringOrArray(c.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: scp claim: %w", ErrInvalidToken, err)
+	}
+	audience, err := stringOrArray(c.Audience)
+	if err != nil {
+		return nil, fmt.Errorf("%w: aud claim: %w", ErrInvalidToken, err)
+	}
+
+	claims := &Claims{
+		Owner:    owner,
+		Name:     c.Name,
+		Scopes:   scopes,
+		Issuer:   c.Issuer,
+		Audience: audience,
+	}
+	if c.ExpiresAt != nil {
+		claims.ExpiresAt = time.Unix(int64(*c.ExpiresAt), 0)
+	}
+	return claims, nil
+}
+
+// stringOrArray reads a claim that is a string for a single value, and an array otherwise.
+func stringOrArray(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return []string{}, nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []string{value}, nil
+	}
+
+	values := []string{}
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,293 @@
+package sso_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xaroth/lib-esi-go/sso"
+)
+
+// signingKey is a private key with the ID it is published under.
+type signingKey struct {
+	id  string
+	key crypto.Signer
+}
+
+func newRSAKey(t *testing.T, id string) signingKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingKey{id: id, key: key}
+}
+
+func newECKey(t *testing.T, id string) signingKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingKey{id: id, key: key}
+}
+
+func (k signingKey) jwk() map[string]string {
+	encode := base64.RawURLEncoding.EncodeToString
+	switch key := k.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kid": k.id, "kty": "RSA", "use": "sig", "alg": "RS256",
+			"n": encode(key.N.Bytes()), "e": encode(big.NewInt(int64(key.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		point, _ := key.Bytes()
+		return map[string]string{"kid": k.id, "kty": "EC", "use": "sig", "alg": "ES256", "crv": "P-256",
+			"x": encode(point[1:33]), "y": encode(point[33:])}
+	}
+	return nil
+}
+
+func keySetDocument(t *testing.T, keys ...signingKey) []byte {
+	t.Helper()
+	jwks := make([]map[string]string, 0, len(keys))
+	for _, key := range keys {
+		jwks = append(jwks, key.jwk())
+	}
+	data, err := json.Marshal(map[string]any{"keys": jwks})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// sign creates a signed JWT with the given claims.
+func (k signingKey) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	algorithm := "RS256"
+	if _, ok := k.key.(*ecdsa.PrivateKey); ok {
+		algorithm = "ES256"
+	}
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "kid": k.id, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := base64.RawURLEncoding.EncodeToString
+	signed := encode(header) + "." + encode(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch key := k.key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, digest[:])
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + encode(signature)
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub":  "CHARACTER:EVE:123456789",
+		"name": "Some Pilot",
+		"scp":  []string{"esi-location.read_location.v1", "esi-skills.read_skills.v1"},
+		"iss":  "https://login.eveonline.com",
+		"aud":  []string{"client-id", "EVE Online"},
+		"exp":  time.Now().Add(20 * time.Minute).Unix(),
+	}
+}
+
+// jwksServer serves the current key set, and counts how often it is fetched.
+type jwksServer struct {
+	*httptest.Server
+	fetches atomic.Int64
+
+	mu       sync.Mutex
+	document []byte
+}
+
+func newJWKSServer(t *testing.T, keys ...signingKey) *jwksServer {
+	t.Helper()
+
+	s := &jwksServer{document: keySetDocument(t, keys...)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.fetches.Add(1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(s.document)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) rotate(t *testing.T, keys ...signingKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.document = keySetDocument(t, keys...)
+}
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	rsaKey := newRSAKey(t, "JWT-Signature-Key")
+	ecKey := newECKey(t, "JWT-Signature-Key-EC")
+	unknownKey := newRSAKey(t, "JWT-Signature-Key")
+
+	keys, err := sso.ParseKeySet(keySetDocument(t, rsaKey, ecKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := sso.NewVerifier("client-id", sso.WithStaticKeys(keys))
+
+	with := func(key string, value any) map[string]any {
+		claims := validClaims()
+		claims[key] = value
+		return claims
+	}
+
+	testCases := []struct {
+		name          string
+		token         string
+		expectedError error
+	}{
+		{name: "RS256", token: rsaKey.sign(t, validClaims())},
+		{name: "ES256", token: ecKey.sign(t, validClaims())},
+		{name: "single scope", token: rsaKey.sign(t, with("scp", "esi-location.read_location.v1"))},
+		{name: "legacy issuer", token: rsaKey.sign(t, with("iss", "login.eveonline.com"))},
+		{name: "not a JWT", token: "opaque", expectedError: sso.ErrInvalidToken},
+		{name: "wrong key", token: unknownKey.sign(t, validClaims()), expectedError: sso.ErrInvalidSignature},
+		{name: "unknown key ID", token: newRSAKey(t, "other").sign(t, validClaims()), expectedError: sso.ErrUnknownKey},
+		{name: "expired", token: rsaKey.sign(t, with("exp", time.Now().Add(-time.Minute).Unix())), expectedError: sso.ErrTokenExpired},
+		{name: "missing expiry", token: rsaKey.sign(t, with("exp", nil)), expectedError: sso.ErrInvalidToken},
+		{name: "wrong issuer", token: rsaKey.sign(t, with("iss", "https://evil.example.com")), expectedError: sso.ErrInvalidIssuer},
+		{name: "other application", token: rsaKey.sign(t, with("aud", []string{"other-client", "EVE Online"})), expectedError: sso.ErrInvalidAudience},
+		{name: "invalid subject", token: rsaKey.sign(t, with("sub", "CORPORATION:EVE:1")), expectedError: sso.ErrInvalidToken},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := verifier.Token(t.Context(), tc.token)
+			if tc.expectedError != nil {
+				if !errors.Is(err, tc.expectedError) || !errors.Is(err, sso.ErrInvalidToken) {
+					t.Fatalf("expected %v, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if token.Owner() != 123456789 || token.Claims().Name != "Some Pilot" {
+				t.Fatalf("unexpected claims: %+v", token.Claims())
+			}
+			if len(token.Scopes()) == 0 {
+				t.Fatal("expected scopes from the claims")
+			}
+			if token.Token() != tc.token {
+				t.Fatal("expected the access token to be passed through")
+			}
+		})
+	}
+}
+
+func TestVerifier_fetchesRotatedKeys(t *testing.T) {
+	t.Parallel()
+
+	oldKey := newRSAKey(t, "old")
+	newKey := newECKey(t, "new")
+	server := newJWKSServer(t, oldKey)
+
+	verifier := sso.NewVerifier("client-id", sso.WithJWKSURL(server.URL))
+
+	for range 3 {
+		if _, err := verifier.Verify(t.Context(), oldKey.sign(t, validClaims())); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := server.fetches.Load(); got != 1 {
+		t.Fatalf("expected the key set to be cached, got %d fetches", got)
+	}
+
+	// Recently fetched key sets are not fetched again for unknown keys.
+	server.rotate(t, oldKey, newKey)
+	if _, err := verifier.Verify(t.Context(), newKey.sign(t, validClaims())); !errors.Is(err, sso.ErrUnknownKey) {
+		t.Fatalf("expected ErrUnknownKey, got %v", err)
+	}
+
+	// Once the key set expires, the rotated keys are picked up.
+	verifier = sso.NewVerifier("client-id", sso.WithJWKSURL(server.URL), sso.WithKeyCacheDuration(0))
+	claims, err := verifier.Verify(t.Context(), newKey.sign(t, validClaims()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"client-id", "EVE Online"}, claims.Audience); diff != "" {
+		t.Fatalf("audience mismatch (-want +got): %s", diff)
+	}
+}
+
+func TestClient_withVerifier(t *testing.T) {
+	t.Parallel()
+
+	key := newRSAKey(t, "JWT-Signature-Key")
+	forger := newRSAKey(t, "JWT-Signature-Key")
+	keys, err := sso.ParseKeySet(keySetDocument(t, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := newTokenServer(t, func(t *testing.T, form url.Values) (int, any) {
+		// The second refresh returns a token that is not signed by the SSO.
+		signer := key
+		if form.Get("refresh_token") == "forged" {
+			signer = forger
+		}
+		return http.StatusOK, map[string]any{
+			"access_token":  signer.sign(t, validClaims()),
+			"expires_in":    1199,
+			"refresh_token": "forged",
+		}
+	})
+	client := sso.New("client-id", "http://localhost/callback",
+		sso.WithTokenURL(server.URL),
+		sso.WithVerifier(sso.NewVerifier("client-id", sso.WithStaticKeys(keys))),
+	)
+
+	token, err := client.Restore(t.Context(), "refresh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Owner() != 123456789 {
+		t.Fatalf("expected owner 123456789, got %d", token.Owner())
+	}
+
+	if err := token.Refresh(t.Context()); !errors.Is(err, sso.ErrInvalidSignature) {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+}
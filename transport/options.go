@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/xaroth/lib-esi-go/middleware"
+	"github.com/xaroth/lib-esi-go/middleware/authentication"
 )
 
 type Option func(*transportChain)
@@ -37,3 +38,11 @@ func WithTenant(tenant string) Option {
 		c.defaultTenant = tenant
 	}
 }
+
+// WithTokenProvider selects tokens for authenticated requests that were not given one.
+// See authentication.TokenProvider.
+func WithTokenProvider(provider authentication.TokenProvider) Option {
+	return func(c *transportChain) {
+		c.tokenProvider = provider
+	}
+}
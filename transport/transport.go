@@ -24,6 +24,7 @@ type transportChain struct {
 	defaultTenant   string
 	defaultLanguage string
 	defaultTimeout  time.Duration
+	tokenProvider   authentication.TokenProvider
 }
 
 func (c *transportChain) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -78,8 +79,11 @@ func New(applicationName, applicationVersion string, contact []string, compatibi
 		compatibilitydate.Middleware(compatibilityDate),
 		language.Middleware(chain.defaultLanguage),
 		tenant.Middleware(chain.defaultTenant),
-		authentication.Middleware,
 	}
+	if chain.tokenProvider != nil {
+		middlewares = append(middlewares, authentication.ProviderMiddleware(chain.tokenProvider))
+	}
+	middlewares = append(middlewares, authentication.Middleware)
 	middlewares = append(middlewares, chain.middlewares...)
 	chain.middlewares = middlewares
 